@@ -0,0 +1,481 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"charm.land/log/v2"
+	"github.com/cli/go-gh/v2/pkg/repository"
+	"github.com/spf13/cobra"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+const (
+	exportFormatJSON  = "json"
+	exportFormatCSV   = "csv"
+	exportFormatTable = "table"
+)
+
+var (
+	exportFormat  string
+	exportView    string
+	exportSection string
+)
+
+// exportCmd represents the export command
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Print the rows of the configured sections as JSON, CSV or a table",
+	Long: `Fetch the PR or issue sections defined in your configuration and print their rows without opening the dashboard.
+Filters go through the same template and smart filtering logic as the dashboard, each section's limit is honored, and only the layout columns that aren't hidden are printed.`,
+	Example: `
+# Print the PR sections as a table
+gh dash export
+
+# Dump the issue sections as JSON
+gh dash export --view issues --format json
+
+# Export a single section as CSV
+gh dash export --section "My Pull Requests" --format csv > prs.csv
+	`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		log.SetLevel(log.ErrorLevel)
+
+		switch exportFormat {
+		case exportFormatJSON, exportFormatCSV, exportFormatTable:
+		default:
+			return fmt.Errorf(
+				"invalid format %q, must be one of: json, csv, table",
+				exportFormat,
+			)
+		}
+
+		var gitRepoPath string
+		gitRepo, ghRepo, err := getCurrentGitAndGitHubRepos()
+		if err != nil {
+			log.Debug("error while determining git and github repos", "err", err)
+		}
+		if gitRepo != nil {
+			gitRepoPath = gitRepo.Path()
+		}
+
+		cfg, err := config.ParseConfig(
+			config.Location{RepoPath: gitRepoPath, ConfigFlag: cfgFlag},
+		)
+		if err != nil {
+			return err
+		}
+		data.SetFetchProjectItems(cfg.HasProjectFieldColumns())
+
+		view := cfg.Defaults.View
+		if exportView != "" {
+			view = config.ViewType(exportView)
+			if view != config.PRsView && view != config.IssuesView {
+				return fmt.Errorf("invalid view %q, must be one of: prs, issues", exportView)
+			}
+		}
+		// Only PR and issue sections can be exported
+		if view != config.IssuesView {
+			view = config.PRsView
+		}
+
+		sections, err := fetchExportSections(&cfg, &ghRepo, view, exportSection)
+		if err != nil {
+			return err
+		}
+
+		return writeExport(os.Stdout, exportFormat, sections)
+	},
+}
+
+// exportColumn is a single layout column that can be exported.
+type exportColumn[T any] struct {
+	name   string
+	layout config.ColumnConfig
+	value  func(T) any
+}
+
+// exportedSection holds the rows fetched for a single configured section.
+type exportedSection struct {
+	Title      string           `json:"title"`
+	Query      string           `json:"query"`
+	TotalCount int              `json:"totalCount"`
+	Columns    []string         `json:"columns"`
+	Rows       []map[string]any `json:"rows"`
+}
+
+func fetchExportSections(
+	cfg *config.Config,
+	repo *repository.Repository,
+	view config.ViewType,
+	title string,
+) ([]exportedSection, error) {
+	sections := make([]exportedSection, 0)
+	found := title == ""
+	if view == config.IssuesView {
+		for _, sCfg := range cfg.IssuesSections {
+			if title != "" && !strings.EqualFold(sCfg.Title, title) {
+				continue
+			}
+			found = true
+			query := cfg.ResolveSectionSearchValue(sCfg.ToSectionConfig(), repo)
+			limit := cfg.Defaults.IssuesLimit
+			if sCfg.Limit != nil {
+				limit = *sCfg.Limit
+			}
+			res, err := data.FetchIssues(query, limit, nil)
+			if err != nil {
+				return nil, fmt.Errorf("fetching section %q: %w", sCfg.Title, err)
+			}
+			columns := issueExportColumns(cfg.Defaults.Layout.Issues, sCfg.Layout)
+			sections = append(sections, buildExportedSection(
+				sCfg.Title, query, res.TotalCount, columns, res.Issues))
+		}
+	} else {
+		for _, sCfg := range cfg.PRSections {
+			if title != "" && !strings.EqualFold(sCfg.Title, title) {
+				continue
+			}
+			found = true
+			query := cfg.ResolveSectionSearchValue(sCfg.ToSectionConfig(), repo)
+			limit := cfg.Defaults.PrsLimit
+			if sCfg.Limit != nil {
+				limit = *sCfg.Limit
+			}
+			res, err := data.FetchPullRequests(query, limit, nil)
+			if err != nil {
+				return nil, fmt.Errorf("fetching section %q: %w", sCfg.Title, err)
+			}
+			columns := prExportColumns(cfg.Defaults.Layout.Prs, sCfg.Layout)
+			sections = append(sections, buildExportedSection(
+				sCfg.Title, query, res.TotalCount, columns, res.Prs))
+		}
+	}
+
+	if !found {
+		return nil, fmt.Errorf("no %s section titled %q in the configuration", view, title)
+	}
+	return sections, nil
+}
+
+func buildExportedSection[T any](
+	title string,
+	query string,
+	totalCount int,
+	columns []exportColumn[T],
+	items []T,
+) exportedSection {
+	s := exportedSection{
+		Title:      title,
+		Query:      query,
+		TotalCount: totalCount,
+		Columns:    make([]string, 0, len(columns)),
+		Rows:       make([]map[string]any, 0, len(items)),
+	}
+	visible := make([]exportColumn[T], 0, len(columns))
+	for _, col := range columns {
+		if col.layout.Hidden != nil && *col.layout.Hidden {
+			continue
+		}
+		visible = append(visible, col)
+		s.Columns = append(s.Columns, col.name)
+	}
+	for _, item := range items {
+		row := make(map[string]any, len(visible))
+		for _, col := range visible {
+			row[col.name] = col.value(item)
+		}
+		s.Rows = append(s.Rows, row)
+	}
+	return s
+}
+
+// prExportColumns lists the PR columns in the order they are exported. The
+// number and url are always included so rows can be traced back to GitHub.
+func prExportColumns(
+	defaults config.PrsLayoutConfig,
+	layout config.PrsLayoutConfig,
+) []exportColumn[data.PullRequestData] {
+	merge := config.MergeColumnConfigs
+	return []exportColumn[data.PullRequestData]{
+		{name: "number", value: func(pr data.PullRequestData) any { return pr.Number }},
+		{
+			name:   "repo",
+			layout: merge(defaults.Repo, layout.Repo),
+			value:  func(pr data.PullRequestData) any { return pr.Repository.NameWithOwner },
+		},
+		{
+			name:   "title",
+			layout: merge(defaults.Title, layout.Title),
+			value:  func(pr data.PullRequestData) any { return pr.Title },
+		},
+		{
+			name:   "author",
+			layout: merge(defaults.Author, layout.Author),
+			value:  func(pr data.PullRequestData) any { return pr.Author.Login },
+		},
+		{
+			name:   "state",
+			layout: merge(defaults.State, layout.State),
+			value: func(pr data.PullRequestData) any {
+				if pr.IsDraft && pr.State == "OPEN" {
+					return "DRAFT"
+				}
+				return pr.State
+			},
+		},
+		{
+			name:   "base",
+			layout: merge(defaults.Base, layout.Base),
+			value:  func(pr data.PullRequestData) any { return pr.BaseRefName },
+		},
+		{
+			name:   "labels",
+			layout: merge(defaults.Labels, layout.Labels),
+			value: func(pr data.PullRequestData) any {
+				return labelNames(pr.Labels.Nodes)
+			},
+		},
+		{
+			name:   "assignees",
+			layout: merge(defaults.Assignees, layout.Assignees),
+			value: func(pr data.PullRequestData) any {
+				return assigneeLogins(pr.Assignees.Nodes)
+			},
+		},
+		{
+			name:   "reviewStatus",
+			layout: merge(defaults.ReviewStatus, layout.ReviewStatus),
+			value:  func(pr data.PullRequestData) any { return pr.ReviewDecision },
+		},
+		{
+			name:   "ci",
+			layout: merge(defaults.Ci, layout.Ci),
+			value: func(pr data.PullRequestData) any {
+				if len(pr.Commits.Nodes) == 0 {
+					return ""
+				}
+				return string(pr.Commits.Nodes[0].Commit.StatusCheckRollup.State)
+			},
+		},
+		{
+			name:   "lines",
+			layout: merge(defaults.Lines, layout.Lines),
+			value: func(pr data.PullRequestData) any {
+				return fmt.Sprintf("+%d -%d", pr.Additions, pr.Deletions)
+			},
+		},
+		{
+			name:   "numComments",
+			layout: merge(defaults.NumComments, layout.NumComments),
+			value: func(pr data.PullRequestData) any {
+				return pr.Comments.TotalCount + pr.ReviewThreads.TotalCount
+			},
+		},
+		{
+			name:   "createdAt",
+			layout: merge(defaults.CreatedAt, layout.CreatedAt),
+			value:  func(pr data.PullRequestData) any { return pr.CreatedAt },
+		},
+		{
+			name:   "updatedAt",
+			layout: merge(defaults.UpdatedAt, layout.UpdatedAt),
+			value:  func(pr data.PullRequestData) any { return pr.UpdatedAt },
+		},
+		{name: "url", value: func(pr data.PullRequestData) any { return pr.Url }},
+	}
+}
+
+// issueExportColumns lists the issue columns in the order they are exported.
+// The number and url are always included so rows can be traced back to GitHub.
+func issueExportColumns(
+	defaults config.IssuesLayoutConfig,
+	layout config.IssuesLayoutConfig,
+) []exportColumn[data.IssueData] {
+	merge := config.MergeColumnConfigs
+	return []exportColumn[data.IssueData]{
+		{name: "number", value: func(issue data.IssueData) any { return issue.Number }},
+		{
+			name:   "repo",
+			layout: merge(defaults.Repo, layout.Repo),
+			value:  func(issue data.IssueData) any { return issue.Repository.NameWithOwner },
+		},
+		{
+			name:   "title",
+			layout: merge(defaults.Title, layout.Title),
+			value:  func(issue data.IssueData) any { return issue.Title },
+		},
+		{
+			name:   "creator",
+			layout: merge(defaults.Creator, layout.Creator),
+			value:  func(issue data.IssueData) any { return issue.Author.Login },
+		},
+		{
+			name:   "state",
+			layout: merge(defaults.State, layout.State),
+			value:  func(issue data.IssueData) any { return issue.State },
+		},
+		{
+			name:   "assignees",
+			layout: merge(defaults.Assignees, layout.Assignees),
+			value: func(issue data.IssueData) any {
+				return assigneeLogins(issue.Assignees.Nodes)
+			},
+		},
+		{
+			name:   "comments",
+			layout: merge(defaults.Comments, layout.Comments),
+			value:  func(issue data.IssueData) any { return issue.Comments.TotalCount },
+		},
+		{
+			name:   "reactions",
+			layout: merge(defaults.Reactions, layout.Reactions),
+			value:  func(issue data.IssueData) any { return issue.Reactions.TotalCount },
+		},
+		{
+			name:   "createdAt",
+			layout: merge(defaults.CreatedAt, layout.CreatedAt),
+			value:  func(issue data.IssueData) any { return issue.CreatedAt },
+		},
+		{
+			name:   "updatedAt",
+			layout: merge(defaults.UpdatedAt, layout.UpdatedAt),
+			value:  func(issue data.IssueData) any { return issue.UpdatedAt },
+		},
+		{name: "url", value: func(issue data.IssueData) any { return issue.Url }},
+	}
+}
+
+func labelNames(labels []data.Label) []string {
+	names := make([]string, 0, len(labels))
+	for _, label := range labels {
+		names = append(names, label.Name)
+	}
+	return names
+}
+
+func assigneeLogins(assignees []data.Assignee) []string {
+	logins := make([]string, 0, len(assignees))
+	for _, assignee := range assignees {
+		logins = append(logins, assignee.Login)
+	}
+	return logins
+}
+
+func writeExport(w io.Writer, format string, sections []exportedSection) error {
+	switch format {
+	case exportFormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(sections)
+	case exportFormatCSV:
+		return writeExportCSV(w, sections)
+	default:
+		return writeExportTable(w, sections)
+	}
+}
+
+// writeExportCSV writes all sections as a single CSV document. Since
+// sections may hide different columns, the header is the union of the
+// visible columns, prefixed with the section title.
+func writeExportCSV(w io.Writer, sections []exportedSection) error {
+	header := []string{"section"}
+	seen := map[string]bool{}
+	for _, s := range sections {
+		for _, col := range s.Columns {
+			if !seen[col] {
+				seen[col] = true
+				header = append(header, col)
+			}
+		}
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for _, s := range sections {
+		for _, row := range s.Rows {
+			record := make([]string, 0, len(header))
+			record = append(record, s.Title)
+			for _, col := range header[1:] {
+				record = append(record, formatExportValue(row[col]))
+			}
+			if err := cw.Write(record); err != nil {
+				return err
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func writeExportTable(w io.Writer, sections []exportedSection) error {
+	for i, s := range sections {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "%s (%d/%d)\n", s.Title, len(s.Rows), s.TotalCount)
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, strings.ToUpper(strings.Join(s.Columns, "\t")))
+		for _, row := range s.Rows {
+			values := make([]string, 0, len(s.Columns))
+			for _, col := range s.Columns {
+				values = append(values, formatExportValue(row[col]))
+			}
+			fmt.Fprintln(tw, strings.Join(values, "\t"))
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func formatExportValue(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []string:
+		return strings.Join(v, ", ")
+	case time.Time:
+		return v.Format(time.RFC3339)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func init() {
+	exportCmd.Flags().StringVarP(
+		&exportFormat,
+		"format",
+		"f",
+		exportFormatTable,
+		"output format, one of: json, csv, table",
+	)
+	exportCmd.Flags().StringVar(
+		&exportView,
+		"view",
+		"",
+		"which sections to export, one of: prs, issues (defaults to the configured default view)",
+	)
+	exportCmd.Flags().StringVarP(
+		&exportSection,
+		"section",
+		"s",
+		"",
+		"only export the section with this title",
+	)
+	rootCmd.AddCommand(exportCmd)
+}
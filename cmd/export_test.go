@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+func testPrs() []data.PullRequestData {
+	pr := data.PullRequestData{
+		Number: 42,
+		Title:  "Add export",
+		State:  "OPEN",
+		Url:    "https://github.com/o/r/pull/42",
+	}
+	pr.Author.Login = "octocat"
+	pr.Repository.NameWithOwner = "o/r"
+	pr.Labels.Nodes = []data.Label{{Name: "bug"}, {Name: "ui"}}
+	return []data.PullRequestData{pr}
+}
+
+func TestBuildExportedSectionHonorsLayout(t *testing.T) {
+	defaults := config.PrsLayoutConfig{
+		Lines: config.ColumnConfig{Hidden: utils.BoolPtr(true)},
+	}
+	layout := config.PrsLayoutConfig{
+		Author: config.ColumnConfig{Hidden: utils.BoolPtr(true)},
+		Lines:  config.ColumnConfig{Hidden: utils.BoolPtr(false)},
+	}
+
+	s := buildExportedSection("Mine", "is:open", 1, prExportColumns(defaults, layout), testPrs())
+
+	if slices.Contains(s.Columns, "author") {
+		t.Error("hidden author column should not be exported")
+	}
+	if !slices.Contains(s.Columns, "lines") {
+		t.Error("section layout should override the hidden default for lines")
+	}
+	if s.Columns[0] != "number" || s.Columns[len(s.Columns)-1] != "url" {
+		t.Errorf("number and url should always be exported, got %v", s.Columns)
+	}
+	if got := s.Rows[0]["labels"]; !slices.Equal(got.([]string), []string{"bug", "ui"}) {
+		t.Errorf("unexpected labels %v", got)
+	}
+}
+
+func TestWriteExportFormats(t *testing.T) {
+	prs := buildExportedSection(
+		"Mine",
+		"is:open",
+		1,
+		prExportColumns(config.PrsLayoutConfig{}, config.PrsLayoutConfig{}),
+		testPrs(),
+	)
+	sections := []exportedSection{prs}
+
+	t.Run("json", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := writeExport(&buf, exportFormatJSON, sections); err != nil {
+			t.Fatal(err)
+		}
+		var decoded []exportedSection
+		if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+			t.Fatalf("invalid json: %v", err)
+		}
+		if len(decoded) != 1 || decoded[0].Title != "Mine" || len(decoded[0].Rows) != 1 {
+			t.Errorf("unexpected decoded export %+v", decoded)
+		}
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := writeExport(&buf, exportFormatCSV, sections); err != nil {
+			t.Fatal(err)
+		}
+		records, err := csv.NewReader(&buf).ReadAll()
+		if err != nil {
+			t.Fatalf("invalid csv: %v", err)
+		}
+		if len(records) != 2 {
+			t.Fatalf("expected header and one row, got %d records", len(records))
+		}
+		if records[0][0] != "section" || records[1][0] != "Mine" {
+			t.Errorf("expected section column first, got %v / %v", records[0], records[1])
+		}
+		labelsIdx := slices.Index(records[0], "labels")
+		if records[1][labelsIdx] != "bug, ui" {
+			t.Errorf("unexpected labels cell %q", records[1][labelsIdx])
+		}
+	})
+
+	t.Run("table", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := writeExport(&buf, exportFormatTable, sections); err != nil {
+			t.Fatal(err)
+		}
+		out := buf.String()
+		if !strings.HasPrefix(out, "Mine (1/1)\n") {
+			t.Errorf("expected section heading, got %q", out)
+		}
+		if !strings.Contains(out, "Add export") || !strings.Contains(out, "TITLE") {
+			t.Errorf("expected header and row in table output, got %q", out)
+		}
+	})
+}
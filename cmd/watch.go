@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"charm.land/log/v2"
+	"github.com/spf13/cobra"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/watcher"
+)
+
+var watchInterval int
+
+// watchCmd represents the watch command
+var watchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Send desktop notifications for the watch rules in your configuration",
+	Long: `Poll GitHub in the foreground and send a desktop notification for each event matching the watch rules of your configuration, such as a new review request, a mention, the checks of one of your PRs failing or a new result in one of your sections.
+Events that happened before the watcher started are not notified. Events already notified by the dashboard, or by a previous run of this command, are not notified again.`,
+	Example: `
+# Watch with the interval set in your configuration
+gh dash watch
+
+# Poll every minute
+gh dash watch --interval 1
+	`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		log.SetLevel(log.ErrorLevel)
+
+		var gitRepoPath string
+		gitRepo, ghRepo, err := getCurrentGitAndGitHubRepos()
+		if err != nil {
+			log.Debug("error while determining git and github repos", "err", err)
+		}
+		if gitRepo != nil {
+			gitRepoPath = gitRepo.Path()
+		}
+
+		cfg, err := config.ParseConfig(
+			config.Location{RepoPath: gitRepoPath, ConfigFlag: cfgFlag},
+		)
+		if err != nil {
+			return err
+		}
+		data.SetFetchProjectItems(cfg.HasProjectFieldColumns())
+		if len(cfg.Watch.Rules) == 0 {
+			return errors.New("no watch rules in the configuration")
+		}
+
+		interval := time.Duration(cfg.Watch.IntervalMinutes) * time.Minute
+		if watchInterval > 0 {
+			interval = time.Duration(watchInterval) * time.Minute
+		}
+
+		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+		defer stop()
+
+		w := watcher.New(
+			cfg.Watch, watcher.ConfigSections(&cfg, &ghRepo), data.GetNotifiedStore())
+		fmt.Fprintf(os.Stdout, "Watching for %s every %s\n",
+			watchedEvents(cfg.Watch), interval)
+		runWatch(ctx, w, interval, os.Stdout)
+		return data.GetNotifiedStore().Flush()
+	},
+}
+
+// runWatch polls until the context is done, printing the notified events to out.
+func runWatch(ctx context.Context, w *watcher.Watcher, interval time.Duration, out io.Writer) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		events, err := w.Poll()
+		if err != nil {
+			fmt.Fprintf(out, "%s error: %v\n", time.Now().Format(time.Kitchen), err)
+		}
+		writeWatchEvents(out, time.Now(), events)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func writeWatchEvents(out io.Writer, now time.Time, events []watcher.Event) {
+	for _, event := range events {
+		body := strings.ReplaceAll(event.Body, "\n", " - ")
+		fmt.Fprintf(out, "%s %s: %s (%s)\n",
+			now.Format(time.Kitchen), event.Rule, event.Title, body)
+	}
+}
+
+func watchedEvents(cfg config.WatchConfig) string {
+	events := make([]string, 0, len(cfg.Rules))
+	for _, rule := range cfg.Rules {
+		events = append(events, rule.Event)
+	}
+	return strings.Join(events, ", ")
+}
+
+func init() {
+	watchCmd.Flags().IntVarP(
+		&watchInterval,
+		"interval",
+		"i",
+		0,
+		"minutes between polls (defaults to watch.intervalMinutes of the configuration)",
+	)
+	rootCmd.AddCommand(watchCmd)
+}
@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/watcher"
+)
+
+func TestWriteWatchEvents(t *testing.T) {
+	var buf bytes.Buffer
+	now := time.Date(2024, 1, 15, 15, 4, 0, 0, time.UTC)
+
+	writeWatchEvents(&buf, now, []watcher.Event{{
+		Rule:  config.WatchEventCIFailed,
+		Title: "gh-dash: Add watcher",
+		Body:  "PR #42 in dlvhdr/gh-dash\n❌ Checks have failed",
+	}})
+
+	want := "3:04PM ciFailed: gh-dash: Add watcher " +
+		"(PR #42 in dlvhdr/gh-dash - ❌ Checks have failed)\n"
+	if got := buf.String(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestWatchedEvents(t *testing.T) {
+	cfg := config.WatchConfig{Rules: []config.WatchRuleConfig{
+		{Event: config.WatchEventReviewRequested},
+		{Event: config.WatchEventCIFailed},
+	}}
+
+	if got := watchedEvents(cfg); got != "reviewRequested, ciFailed" {
+		t.Errorf("unexpected watched events %q", got)
+	}
+}
@@ -74,7 +74,13 @@ export default defineConfig({
             "configuration/pr-section",
             "configuration/issue-section",
             "configuration/notification-section",
+            "configuration/discussion-section",
+            "configuration/release-section",
+            "configuration/project-section",
             "configuration/repo-paths",
+            "configuration/merge-strategies",
+            "configuration/watch",
+            "configuration/worktrees",
             "configuration/keybindings",
             "configuration/theme",
             "configuration/reusing",
@@ -91,6 +91,66 @@ export function GET() {
             },
           },
         },
+        mergeStrategies: {
+          title: "Merge Strategy Map",
+          description:
+            "Key-value pairs that match repositories to the strategy preselected when merging their PRs.",
+          type: "object",
+          examples: [
+            {
+              "dlvhdr/*": "squash",
+              "dlvhdr/gh-dash": "rebase",
+            },
+          ],
+          additionalProperties: {
+            type: "string",
+            enum: ["merge", "squash", "rebase"],
+          },
+        },
+        watch: {
+          title: "Watch Rules",
+          description:
+            "Desktop notifications sent in the background for new review requests, mentions and failing checks.",
+          type: "object",
+          properties: {
+            enabled: {
+              title: "Watch in the Dashboard",
+              description:
+                "Whether the dashboard watches the rules while it's open. `gh dash watch` always does.",
+              type: "boolean",
+              default: false,
+            },
+            intervalMinutes: {
+              title: "Poll Interval",
+              description: "Minutes between two polls of GitHub.",
+              type: "integer",
+              minimum: 1,
+              default: 5,
+            },
+            rules: {
+              title: "Rules",
+              description: "The events to send a desktop notification for.",
+              type: "array",
+              items: {
+                type: "object",
+                required: ["event"],
+                properties: {
+                  event: {
+                    title: "Event",
+                    type: "string",
+                    enum: ["reviewRequested", "mention", "ciFailed", "newResult"],
+                  },
+                  filters: {
+                    title: "PR Filters",
+                    description:
+                      "The search query of the PRs whose checks a `ciFailed` rule watches.",
+                    type: "string",
+                  },
+                },
+              },
+            },
+          },
+        },
         keybindings: {
           title: "Keybindings",
           description: "Define keybindings to run shell commands.",
@@ -162,6 +222,12 @@ export function GET() {
               ],
               default: "less",
             },
+            inline: {
+              title: "Inline Diff",
+              description:
+                "Show diffs in the preview pane instead of the diff pager. Defaults to true unless a diff pager is set.",
+              type: "boolean",
+            },
           },
         },
         showAuthorIcons: {
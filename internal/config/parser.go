@@ -67,6 +67,12 @@ func (a *ViewType) UnmarshalJSON(b []byte) error {
 		*a = IssuesView
 	case "repo":
 		*a = RepoView
+	case "discussions":
+		*a = DiscussionsView
+	case "releases":
+		*a = ReleasesView
+	case "projects":
+		*a = ProjectsView
 	}
 
 	return nil
@@ -77,6 +83,9 @@ const (
 	PRsView           ViewType = "prs"
 	IssuesView        ViewType = "issues"
 	RepoView          ViewType = "repo"
+	DiscussionsView   ViewType = "discussions"
+	ReleasesView      ViewType = "releases"
+	ProjectsView      ViewType = "projects"
 )
 
 type SectionConfig struct {
@@ -87,18 +96,46 @@ type SectionConfig struct {
 }
 
 type PrsSectionConfig struct {
+	Title         string
+	Filters       string
+	Limit         *int            `yaml:"limit,omitempty"`
+	Layout        PrsLayoutConfig `yaml:"layout,omitempty"`
+	Type          *ViewType       `yaml:"type,omitempty"`
+	SortBy        string          `yaml:"sortBy,omitempty"        validate:"omitempty,oneof=updatedAt createdAt repo author title base state reviewStatus ci lines numComments"`
+	SortDirection string          `yaml:"sortDirection,omitempty" validate:"omitempty,oneof=asc desc"`
+	GroupBy       string          `yaml:"groupBy,omitempty"       validate:"omitempty,oneof=repo author label"`
+}
+
+type IssuesSectionConfig struct {
+	Title         string
+	Filters       string
+	Limit         *int               `yaml:"limit,omitempty"`
+	Layout        IssuesLayoutConfig `yaml:"layout,omitempty"`
+	SortBy        string             `yaml:"sortBy,omitempty"        validate:"omitempty,oneof=updatedAt createdAt repo creator title state comments reactions"`
+	SortDirection string             `yaml:"sortDirection,omitempty" validate:"omitempty,oneof=asc desc"`
+	GroupBy       string             `yaml:"groupBy,omitempty"       validate:"omitempty,oneof=repo creator label"`
+}
+
+type DiscussionsSectionConfig struct {
 	Title   string
 	Filters string
-	Limit   *int            `yaml:"limit,omitempty"`
-	Layout  PrsLayoutConfig `yaml:"layout,omitempty"`
-	Type    *ViewType       `yaml:"type,omitempty"`
+	Limit   *int                    `yaml:"limit,omitempty"`
+	Layout  DiscussionsLayoutConfig `yaml:"layout,omitempty"`
 }
 
-type IssuesSectionConfig struct {
+type ReleasesSectionConfig struct {
 	Title   string
 	Filters string
-	Limit   *int               `yaml:"limit,omitempty"`
-	Layout  IssuesLayoutConfig `yaml:"layout,omitempty"`
+	Limit   *int                 `yaml:"limit,omitempty"`
+	Layout  ReleasesLayoutConfig `yaml:"layout,omitempty"`
+}
+
+type ProjectsSectionConfig struct {
+	Title   string
+	Filters string
+	Limit   *int `yaml:"limit,omitempty"`
+	// StatusField is the single select field whose options are the columns of the board
+	StatusField string `yaml:"statusField,omitempty"`
 }
 
 type NotificationsSectionConfig struct {
@@ -156,6 +193,7 @@ type PrsLayoutConfig struct {
 	AuthorIcon   ColumnConfig `yaml:"authorIcon,omitempty"`
 	Labels       ColumnConfig `yaml:"labels,omitempty"`
 	Assignees    ColumnConfig `yaml:"assignees,omitempty"`
+	Milestone    ColumnConfig `yaml:"milestone,omitempty"`
 	Title        ColumnConfig `yaml:"title,omitempty"`
 	Base         ColumnConfig `yaml:"base,omitempty"`
 	ReviewStatus ColumnConfig `yaml:"reviewStatus,omitempty"`
@@ -163,6 +201,8 @@ type PrsLayoutConfig struct {
 	Ci           ColumnConfig `yaml:"ci,omitempty"`
 	Lines        ColumnConfig `yaml:"lines,omitempty"`
 	NumComments  ColumnConfig `yaml:"numComments,omitempty"`
+	// ProjectFields are shown after the other columns, in order
+	ProjectFields []ProjectFieldColumnConfig `yaml:"projectFields,omitempty" validate:"dive"`
 }
 
 type IssuesLayoutConfig struct {
@@ -174,13 +214,49 @@ type IssuesLayoutConfig struct {
 	Creator     ColumnConfig `yaml:"creator,omitempty"`
 	CreatorIcon ColumnConfig `yaml:"creatorIcon,omitempty"`
 	Assignees   ColumnConfig `yaml:"assignees,omitempty"`
+	Milestone   ColumnConfig `yaml:"milestone,omitempty"`
 	Comments    ColumnConfig `yaml:"comments,omitempty"`
 	Reactions   ColumnConfig `yaml:"reactions,omitempty"`
+	// ProjectFields are shown after the other columns, in order
+	ProjectFields []ProjectFieldColumnConfig `yaml:"projectFields,omitempty" validate:"dive"`
+}
+
+// ProjectFieldColumnConfig is a column showing the value of a field of the GitHub Projects an
+// issue or PR is in, like its "Priority" or "Iteration".
+type ProjectFieldColumnConfig struct {
+	Field  string `yaml:"field"            validate:"required"`
+	Width  *int   `yaml:"width,omitempty"  validate:"omitempty,gt=0"`
+	Hidden *bool  `yaml:"hidden,omitempty"`
+}
+
+type DiscussionsLayoutConfig struct {
+	UpdatedAt  ColumnConfig `yaml:"updatedAt,omitempty"`
+	CreatedAt  ColumnConfig `yaml:"createdAt,omitempty"`
+	State      ColumnConfig `yaml:"state,omitempty"`
+	Repo       ColumnConfig `yaml:"repo,omitempty"`
+	Title      ColumnConfig `yaml:"title,omitempty"`
+	Category   ColumnConfig `yaml:"category,omitempty"`
+	Author     ColumnConfig `yaml:"author,omitempty"`
+	AuthorIcon ColumnConfig `yaml:"authorIcon,omitempty"`
+	Comments   ColumnConfig `yaml:"comments,omitempty"`
+	Upvotes    ColumnConfig `yaml:"upvotes,omitempty"`
+}
+
+type ReleasesLayoutConfig struct {
+	State       ColumnConfig `yaml:"state,omitempty"`
+	Repo        ColumnConfig `yaml:"repo,omitempty"`
+	Name        ColumnConfig `yaml:"name,omitempty"`
+	Tag         ColumnConfig `yaml:"tag,omitempty"`
+	Author      ColumnConfig `yaml:"author,omitempty"`
+	Assets      ColumnConfig `yaml:"assets,omitempty"`
+	PublishedAt ColumnConfig `yaml:"publishedAt,omitempty"`
 }
 
 type LayoutConfig struct {
-	Prs    PrsLayoutConfig    `yaml:"prs,omitempty"`
-	Issues IssuesLayoutConfig `yaml:"issues,omitempty"`
+	Prs         PrsLayoutConfig         `yaml:"prs,omitempty"`
+	Issues      IssuesLayoutConfig      `yaml:"issues,omitempty"`
+	Discussions DiscussionsLayoutConfig `yaml:"discussions,omitempty"`
+	Releases    ReleasesLayoutConfig    `yaml:"releases,omitempty"`
 }
 
 type Defaults struct {
@@ -189,6 +265,9 @@ type Defaults struct {
 	PrApproveComment       string        `yaml:"prApproveComment,omitempty"`
 	IssuesLimit            int           `yaml:"issuesLimit"`
 	NotificationsLimit     int           `yaml:"notificationsLimit"`
+	DiscussionsLimit       int           `yaml:"discussionsLimit"`
+	ReleasesLimit          int           `yaml:"releasesLimit"`
+	ProjectsLimit          int           `yaml:"projectsLimit"`
 	View                   ViewType      `yaml:"view"`
 	Layout                 LayoutConfig  `yaml:"layout,omitempty"`
 	RefetchIntervalMinutes int           `yaml:"refetchIntervalMinutes,omitempty"`
@@ -200,6 +279,42 @@ type RepoConfig struct {
 	PrsRefetchIntervalSeconds      int `yaml:"prsRefetchIntervalSeconds,omitempty"`
 }
 
+const (
+	WatchEventReviewRequested = "reviewRequested"
+	WatchEventMention         = "mention"
+	WatchEventCIFailed        = "ciFailed"
+	WatchEventNewResult       = "newResult"
+)
+
+// WatchRuleConfig is an event the watcher fires a desktop notification for.
+type WatchRuleConfig struct {
+	Event string `yaml:"event" validate:"oneof=reviewRequested mention ciFailed newResult"`
+	// Filters is the search query of the PRs whose checks are watched by a ciFailed rule.
+	Filters string `yaml:"filters,omitempty"`
+}
+
+type WatchConfig struct {
+	Enabled         bool              `yaml:"enabled"`
+	IntervalMinutes int               `yaml:"intervalMinutes" validate:"gt=0"`
+	Rules           []WatchRuleConfig `yaml:"rules"           validate:"dive"`
+}
+
+// WorktreesConfig checks PRs out into a git worktree of their own instead of switching the branch
+// of the repoPaths directory.
+type WorktreesConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// Root is the directory the worktrees are created in, as <root>/<owner>/<repo>/pr-<number>
+	Root string `yaml:"root" validate:"required"`
+}
+
+// CloneConfig clones the repos missing from repoPaths when a checkout or a keybinding needs their
+// local path.
+type CloneConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// Path is a template of where repos are cloned to, given the .Owner and .Name of the repo
+	Path string `yaml:"path" validate:"required"`
+}
+
 type Keybinding struct {
 	Key     string `yaml:"key"`
 	Command string `yaml:"command,omitempty"`
@@ -229,11 +344,17 @@ type Keybindings struct {
 	Prs           []Keybinding `yaml:"prs,omitempty"`
 	Branches      []Keybinding `yaml:"branches,omitempty"`
 	Notifications []Keybinding `yaml:"notifications,omitempty"`
+	Discussions   []Keybinding `yaml:"discussions,omitempty"`
+	Releases      []Keybinding `yaml:"releases,omitempty"`
+	Projects      []Keybinding `yaml:"projects,omitempty"`
 	Cmp           []Keybinding `yaml:"completions,omitempty"`
 }
 
 type Pager struct {
 	Diff string `yaml:"diff"`
+	// Inline shows diffs in the preview pane instead of running the Diff pager. When unset,
+	// diffs are only shown inline if no Diff pager is set.
+	Inline *bool `yaml:"inline,omitempty"`
 }
 
 type Color string
@@ -318,15 +439,22 @@ type ThemeConfig struct {
 
 type Config struct {
 	Include                  []string                     `yaml:"include,omitempty"`
-	PRSections               []PrsSectionConfig           `yaml:"prSections"`
-	IssuesSections           []IssuesSectionConfig        `yaml:"issuesSections"`
+	PRSections               []PrsSectionConfig           `yaml:"prSections"                validate:"dive"`
+	IssuesSections           []IssuesSectionConfig        `yaml:"issuesSections"            validate:"dive"`
 	NotificationsSections    []NotificationsSectionConfig `yaml:"notificationsSections"`
+	DiscussionsSections      []DiscussionsSectionConfig   `yaml:"discussionsSections"       validate:"dive"`
+	ReleasesSections         []ReleasesSectionConfig      `yaml:"releasesSections"`
+	ProjectsSections         []ProjectsSectionConfig      `yaml:"projectsSections"`
 	Repo                     RepoConfig                   `yaml:"repo,omitempty"`
 	Defaults                 Defaults                     `yaml:"defaults"`
 	Keybindings              Keybindings                  `yaml:"keybindings"`
 	RepoPaths                map[string]string            `yaml:"repoPaths"`
+	MergeStrategies          map[string]string            `yaml:"mergeStrategies"           validate:"dive,oneof=merge squash rebase"`
 	Theme                    *ThemeConfig                 `yaml:"theme,omitempty"           validate:"omitempty"`
 	Pager                    Pager                        `yaml:"pager"`
+	Watch                    WatchConfig                  `yaml:"watch"`
+	Worktrees                WorktreesConfig              `yaml:"worktrees"`
+	Clone                    CloneConfig                  `yaml:"clone"`
 	ConfirmQuit              bool                         `yaml:"confirmQuit"`
 	ShowAuthorIcons          bool                         `yaml:"showAuthorIcons,omitempty"`
 	SmartFilteringAtLaunch   bool                         `yaml:"smartFilteringAtLaunch"                         default:"true"`
@@ -356,6 +484,9 @@ func (parser ConfigParser) getDefaultConfig() Config {
 			PrApproveComment:       "LGTM",
 			IssuesLimit:            20,
 			NotificationsLimit:     20,
+			DiscussionsLimit:       20,
+			ReleasesLimit:          20,
+			ProjectsLimit:          100,
 			View:                   PRsView,
 			RefetchIntervalMinutes: 30,
 			Layout: LayoutConfig{
@@ -383,6 +514,10 @@ func (parser ConfigParser) getDefaultConfig() Config {
 						Width:  utils.IntPtr(20),
 						Hidden: utils.BoolPtr(true),
 					},
+					Milestone: ColumnConfig{
+						Width:  utils.IntPtr(15),
+						Hidden: utils.BoolPtr(true),
+					},
 					Base: ColumnConfig{
 						Width:  utils.IntPtr(15),
 						Hidden: utils.BoolPtr(true),
@@ -411,6 +546,44 @@ func (parser ConfigParser) getDefaultConfig() Config {
 						Width:  utils.IntPtr(20),
 						Hidden: utils.BoolPtr(true),
 					},
+					Milestone: ColumnConfig{
+						Width:  utils.IntPtr(15),
+						Hidden: utils.BoolPtr(true),
+					},
+				},
+				Discussions: DiscussionsLayoutConfig{
+					UpdatedAt: ColumnConfig{
+						Width: utils.IntPtr(lipgloss.Width("2mo  ")),
+					},
+					CreatedAt: ColumnConfig{
+						Width: utils.IntPtr(lipgloss.Width("2mo  ")),
+					},
+					Repo: ColumnConfig{
+						Width: utils.IntPtr(15),
+					},
+					Category: ColumnConfig{
+						Width: utils.IntPtr(15),
+					},
+					Author: ColumnConfig{
+						Width: utils.IntPtr(10),
+					},
+					AuthorIcon: ColumnConfig{
+						Hidden: utils.BoolPtr(false),
+					},
+				},
+				Releases: ReleasesLayoutConfig{
+					Repo: ColumnConfig{
+						Width: utils.IntPtr(15),
+					},
+					Tag: ColumnConfig{
+						Width: utils.IntPtr(15),
+					},
+					Author: ColumnConfig{
+						Width: utils.IntPtr(10),
+					},
+					PublishedAt: ColumnConfig{
+						Width: utils.IntPtr(lipgloss.Width("2mo  ")),
+					},
 				},
 			},
 		},
@@ -485,7 +658,18 @@ func (parser ConfigParser) getDefaultConfig() Config {
 			Issues:    []Keybinding{},
 			Prs:       []Keybinding{},
 		},
-		RepoPaths: map[string]string{},
+		RepoPaths:       map[string]string{},
+		MergeStrategies: map[string]string{},
+		Watch: WatchConfig{
+			IntervalMinutes: 5,
+			Rules: []WatchRuleConfig{
+				{Event: WatchEventReviewRequested},
+				{Event: WatchEventMention},
+				{Event: WatchEventCIFailed, Filters: "is:open author:@me"},
+			},
+		},
+		Worktrees: WorktreesConfig{Root: "~/.local/share/gh-dash/worktrees"},
+		Clone:     CloneConfig{Path: "~/src/{{.Owner}}/{{.Name}}"},
 		Theme: &ThemeConfig{
 			Ui: UIThemeConfig{
 				SectionsShowCount: true,
@@ -637,7 +821,10 @@ func (parser ConfigParser) getProvidedConfigPath(location Location) string {
 var keybindingTypes = []string{"universal", "prs", "issues", "completions"}
 
 // sectionTypes are replaced wholesale by any layer that defines them.
-var sectionTypes = []string{"prSections", "issuesSections", "notificationsSections"}
+var sectionTypes = []string{
+	"prSections", "issuesSections", "notificationsSections", "discussionsSections",
+	"releasesSections", "projectsSections",
+}
 
 func mergeOption() koanf.Option {
 	return koanf.WithMergeFunc(func(overrides, dest map[string]any) error {
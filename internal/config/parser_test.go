@@ -186,6 +186,130 @@ func TestParser(t *testing.T) {
 		require.ElementsMatch(t, []string{"a", "b", "c"}, keys)
 	})
 
+	t.Run("Should read the sorting and grouping of sections", func(t *testing.T) {
+		dir, err := os.MkdirTemp("", "config")
+		testutils.AssertNoError(t, err)
+		defer os.RemoveAll(dir)
+
+		configPath := path.Join(dir, "config.yml")
+		err = os.WriteFile(configPath, []byte(`prSections:
+  - title: Mine
+    filters: author:@me
+    sortBy: ci
+    groupBy: repo
+issuesSections:
+  - title: Assigned
+    filters: assignee:@me
+    sortBy: createdAt
+    sortDirection: asc
+    groupBy: label
+`), 0o600)
+		testutils.AssertNoError(t, err)
+
+		parsed, err := ParseConfig(Location{
+			ConfigFlag:       configPath,
+			SkipGlobalConfig: true,
+		})
+
+		testutils.AssertNoError(t, err)
+		require.Equal(t, "ci", parsed.PRSections[0].SortBy)
+		require.Equal(t, "repo", parsed.PRSections[0].GroupBy)
+		require.Equal(t, "createdAt", parsed.IssuesSections[0].SortBy)
+		require.Equal(t, "asc", parsed.IssuesSections[0].SortDirection)
+		require.Equal(t, "label", parsed.IssuesSections[0].GroupBy)
+	})
+
+	t.Run("Should reject sorting by an unknown column", func(t *testing.T) {
+		dir, err := os.MkdirTemp("", "config")
+		testutils.AssertNoError(t, err)
+		defer os.RemoveAll(dir)
+
+		configPath := path.Join(dir, "config.yml")
+		err = os.WriteFile(configPath, []byte(`prSections:
+  - title: Mine
+    filters: author:@me
+    sortBy: reactions
+`), 0o600)
+		testutils.AssertNoError(t, err)
+
+		_, err = ParseConfig(Location{
+			ConfigFlag:       configPath,
+			SkipGlobalConfig: true,
+		})
+
+		require.Error(t, err)
+	})
+
+	t.Run("Should read the watch rules", func(t *testing.T) {
+		dir, err := os.MkdirTemp("", "config")
+		testutils.AssertNoError(t, err)
+		defer os.RemoveAll(dir)
+
+		configPath := path.Join(dir, "config.yml")
+		err = os.WriteFile(configPath, []byte(`watch:
+  enabled: true
+  intervalMinutes: 2
+  rules:
+    - event: ciFailed
+      filters: is:open author:@me org:dlvhdr
+`), 0o600)
+		testutils.AssertNoError(t, err)
+
+		parsed, err := ParseConfig(Location{
+			ConfigFlag:       configPath,
+			SkipGlobalConfig: true,
+		})
+
+		testutils.AssertNoError(t, err)
+		require.True(t, parsed.Watch.Enabled)
+		require.Equal(t, 2, parsed.Watch.IntervalMinutes)
+		require.Equal(t, []WatchRuleConfig{{
+			Event:   WatchEventCIFailed,
+			Filters: "is:open author:@me org:dlvhdr",
+		}}, parsed.Watch.Rules)
+	})
+
+	t.Run("Should parse the worktrees config", func(t *testing.T) {
+		dir, err := os.MkdirTemp("", "config")
+		testutils.AssertNoError(t, err)
+		defer os.RemoveAll(dir)
+
+		configPath := path.Join(dir, "config.yml")
+		err = os.WriteFile(configPath, []byte(`worktrees:
+  enabled: true
+  root: ~/code/worktrees
+`), 0o600)
+		testutils.AssertNoError(t, err)
+
+		parsed, err := ParseConfig(Location{
+			ConfigFlag:       configPath,
+			SkipGlobalConfig: true,
+		})
+
+		testutils.AssertNoError(t, err)
+		require.Equal(t, WorktreesConfig{Enabled: true, Root: "~/code/worktrees"}, parsed.Worktrees)
+	})
+
+	t.Run("Should reject an unknown watch event", func(t *testing.T) {
+		dir, err := os.MkdirTemp("", "config")
+		testutils.AssertNoError(t, err)
+		defer os.RemoveAll(dir)
+
+		configPath := path.Join(dir, "config.yml")
+		err = os.WriteFile(configPath, []byte(`watch:
+  rules:
+    - event: newRelease
+`), 0o600)
+		testutils.AssertNoError(t, err)
+
+		_, err = ParseConfig(Location{
+			ConfigFlag:       configPath,
+			SkipGlobalConfig: true,
+		})
+
+		require.Error(t, err)
+	})
+
 	t.Run("Should accept ANSI color indices in theme", func(t *testing.T) {
 		cwd := Testwd(t)
 		parsed, err := ParseConfig(Location{
@@ -258,6 +382,24 @@ func TestValidateColor(t *testing.T) {
 	}
 }
 
+func TestIsInlineDiff(t *testing.T) {
+	inline, external := true, false
+	tests := map[string]struct {
+		pager Pager
+		want  bool
+	}{
+		"defaults to inline":              {pager: Pager{}, want: true},
+		"keeps a configured diff pager":   {pager: Pager{Diff: "delta"}, want: false},
+		"inline overrides the diff pager": {pager: Pager{Diff: "delta", Inline: &inline}, want: true},
+		"inline can be turned off":        {pager: Pager{Inline: &external}, want: false},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tt.want, Config{Pager: tt.pager}.IsInlineDiff())
+		})
+	}
+}
+
 func setupConfigEnvVar(t *testing.T) func() {
 	t.Helper()
 	cwd := Testwd(t)
@@ -0,0 +1,120 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"strings"
+	"text/template"
+	"time"
+
+	"charm.land/log/v2"
+	"github.com/cli/go-gh/v2/pkg/repository"
+	"github.com/go-sprout/sprout"
+	timeregistry "github.com/go-sprout/sprout/registry/time"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+func hasRepo(repo *repository.Repository) bool {
+	return repo != nil && *repo != (repository.Repository{})
+}
+
+func repoFilter(repo *repository.Repository) string {
+	return fmt.Sprintf("repo:%s/%s", repo.Owner, repo.Name)
+}
+
+// AddCurrentRepoFilter adds the repo: filter of the current repo to filters that don't filter
+// by repo yet, when smart filtering is enabled at launch.
+func (cfg Config) AddCurrentRepoFilter(filters string, repo *repository.Repository) string {
+	if !cfg.SmartFilteringAtLaunch {
+		return filters
+	}
+	if !hasRepo(repo) {
+		return filters
+	}
+	for token := range strings.FieldsSeq(filters) {
+		if strings.HasPrefix(token, "repo:") {
+			return filters
+		}
+	}
+	return fmt.Sprintf("%s %s", repoFilter(repo), filters)
+}
+
+// IsFilteredByRepo reports whether filters has the repo: filter of repo.
+func IsFilteredByRepo(filters string, repo *repository.Repository) bool {
+	if !hasRepo(repo) {
+		return false
+	}
+	currentCloneFilter := repoFilter(repo)
+	for token := range strings.FieldsSeq(filters) {
+		if token == currentCloneFilter {
+			return true
+		}
+	}
+	return false
+}
+
+// ResolveSearchValue expands the template variables in searchValue and adds or removes the
+// repo: filter of the current repo, the same way a section does before fetching its rows.
+func ResolveSearchValue(
+	searchValue string,
+	repo *repository.Repository,
+	isFilteredByCurrentRemote bool,
+) string {
+	searchValue = enrichSearchWithTemplateVars(searchValue)
+	if !hasRepo(repo) {
+		return searchValue
+	}
+
+	currentCloneFilter := repoFilter(repo)
+	var searchValueWithoutCurrentCloneFilter []string
+	for token := range strings.FieldsSeq(searchValue) {
+		if token != currentCloneFilter {
+			searchValueWithoutCurrentCloneFilter = append(
+				searchValueWithoutCurrentCloneFilter,
+				token,
+			)
+		}
+	}
+	if isFilteredByCurrentRemote {
+		return fmt.Sprintf("%s %s", currentCloneFilter,
+			strings.Join(searchValueWithoutCurrentCloneFilter, " "))
+	}
+	return strings.Join(searchValueWithoutCurrentCloneFilter, " ")
+}
+
+// ResolveSectionSearchValue returns the search value a section created from section would
+// fetch with at launch.
+func (cfg Config) ResolveSectionSearchValue(
+	section SectionConfig,
+	repo *repository.Repository,
+) string {
+	filters := cfg.AddCurrentRepoFilter(section.Filters, repo)
+	return ResolveSearchValue(filters, repo, IsFilteredByRepo(filters, repo))
+}
+
+func enrichSearchWithTemplateVars(searchValue string) string {
+	searchVars := struct{ Now time.Time }{
+		Now: time.Now(),
+	}
+	sl := slog.New(log.Default())
+	handler := sprout.New(
+		sprout.WithRegistries(timeregistry.NewRegistry(), utils.NewRegistry()),
+		sprout.WithLogger(sl),
+	)
+	funcs := handler.Build()
+
+	tmpl, err := template.New("search").Funcs(funcs).Parse(searchValue)
+	if err != nil {
+		log.Error("bad template", "err", err)
+		return searchValue
+	}
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, searchVars)
+	if err != nil {
+		return searchValue
+	}
+
+	return buf.String()
+}
@@ -0,0 +1,52 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/cli/go-gh/v2/pkg/repository"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveSectionSearchValue(t *testing.T) {
+	repo := &repository.Repository{Owner: "dlvhdr", Name: "gh-dash"}
+	tests := map[string]struct {
+		smartFiltering bool
+		repo           *repository.Repository
+		filters        string
+		want           string
+	}{
+		"adds the current repo": {
+			smartFiltering: true,
+			repo:           repo,
+			filters:        "is:open",
+			want:           "repo:dlvhdr/gh-dash is:open",
+		},
+		"keeps another repo": {
+			smartFiltering: true,
+			repo:           repo,
+			filters:        "repo:cli/cli is:open",
+			want:           "repo:cli/cli is:open",
+		},
+		"without smart filtering": {
+			repo:    repo,
+			filters: "is:open",
+			want:    "is:open",
+		},
+		"outside of a repo": {
+			smartFiltering: true,
+			filters:        "is:open",
+			want:           "is:open",
+		},
+		"expands templates": {
+			filters: `is:open label:{{ "bug" }}`,
+			want:    "is:open label:bug",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			cfg := Config{SmartFilteringAtLaunch: tt.smartFiltering}
+			got := cfg.ResolveSectionSearchValue(SectionConfig{Filters: tt.filters}, tt.repo)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -3,9 +3,19 @@ package config
 import (
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 )
 
+// IsInlineDiff reports whether diffs are shown in the preview pane rather than in the diff
+// pager. Configs that set a diff pager keep using it unless inline diffs are enabled.
+func (cfg Config) IsInlineDiff() bool {
+	if cfg.Pager.Inline != nil {
+		return *cfg.Pager.Inline
+	}
+	return cfg.Pager.Diff == ""
+}
+
 func (cfg Config) GetFullScreenDiffPagerEnv() []string {
 	diff := cfg.Pager.Diff
 	if diff == "" {
@@ -45,6 +55,30 @@ func (cfg IssuesSectionConfig) ToSectionConfig() SectionConfig {
 	}
 }
 
+func (cfg DiscussionsSectionConfig) ToSectionConfig() SectionConfig {
+	return SectionConfig{
+		Title:   cfg.Title,
+		Filters: cfg.Filters,
+		Limit:   cfg.Limit,
+	}
+}
+
+func (cfg ReleasesSectionConfig) ToSectionConfig() SectionConfig {
+	return SectionConfig{
+		Title:   cfg.Title,
+		Filters: cfg.Filters,
+		Limit:   cfg.Limit,
+	}
+}
+
+func (cfg ProjectsSectionConfig) ToSectionConfig() SectionConfig {
+	return SectionConfig{
+		Title:   cfg.Title,
+		Filters: cfg.Filters,
+		Limit:   cfg.Limit,
+	}
+}
+
 func (cfg NotificationsSectionConfig) ToSectionConfig() SectionConfig {
 	return SectionConfig{
 		Title:   cfg.Title,
@@ -64,6 +98,56 @@ func MergeColumnConfigs(defaultCfg, sectionCfg ColumnConfig) ColumnConfig {
 	return colCfg
 }
 
+// MergeProjectFieldColumns overrides the default project field columns with the section's columns
+// of the same field. The section's other columns are shown after the default ones.
+func MergeProjectFieldColumns(
+	defaultCols, sectionCols []ProjectFieldColumnConfig,
+) []ProjectFieldColumnConfig {
+	cols := slices.Clone(defaultCols)
+	for _, sectionCol := range sectionCols {
+		i := slices.IndexFunc(cols, func(col ProjectFieldColumnConfig) bool {
+			return strings.EqualFold(col.Field, sectionCol.Field)
+		})
+		if i < 0 {
+			cols = append(cols, sectionCol)
+			continue
+		}
+		if sectionCol.Width != nil {
+			cols[i].Width = sectionCol.Width
+		}
+		if sectionCol.Hidden != nil {
+			cols[i].Hidden = sectionCol.Hidden
+		}
+	}
+	return cols
+}
+
+// HasProjectFieldColumns reports whether a PR or issue section shows project fields, which are
+// then fetched with the PRs and issues.
+func (cfg Config) HasProjectFieldColumns() bool {
+	// The search sections only use the default layouts
+	layouts := [][]ProjectFieldColumnConfig{
+		cfg.Defaults.Layout.Prs.ProjectFields,
+		cfg.Defaults.Layout.Issues.ProjectFields,
+	}
+	for _, section := range cfg.PRSections {
+		layouts = append(layouts, MergeProjectFieldColumns(
+			cfg.Defaults.Layout.Prs.ProjectFields, section.Layout.ProjectFields))
+	}
+	for _, section := range cfg.IssuesSections {
+		layouts = append(layouts, MergeProjectFieldColumns(
+			cfg.Defaults.Layout.Issues.ProjectFields, section.Layout.ProjectFields))
+	}
+	for _, cols := range layouts {
+		for _, col := range cols {
+			if col.Hidden == nil || !*col.Hidden {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func TruncateCommand(cmd string) string {
 	cmd = strings.ReplaceAll(cmd, "\n", "")
 	if len(cmd) > 30 {
@@ -0,0 +1,91 @@
+package data
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"charm.land/log/v2"
+)
+
+const (
+	AnnotationLevelNotice  = "notice"
+	AnnotationLevelWarning = "warning"
+	AnnotationLevelFailure = "failure"
+)
+
+// CheckAnnotation is a message a check run left on a line of code, e.g. a failed assertion.
+type CheckAnnotation struct {
+	Path            string `json:"path"`
+	StartLine       int    `json:"start_line"`
+	AnnotationLevel string `json:"annotation_level"`
+	Title           string `json:"title"`
+	Message         string `json:"message"`
+}
+
+// FetchJobLogs fetches the plain text log of a GitHub Actions job.
+func FetchJobLogs(repo string, jobId int64) (string, error) {
+	client, err := getRESTClient()
+	if err != nil {
+		return "", err
+	}
+
+	// The endpoint redirects to a short-lived URL serving the log
+	path := fmt.Sprintf("repos/%s/actions/jobs/%d/logs", repo, jobId)
+	log.Debug("Fetching job logs", "repo", repo, "jobId", jobId)
+	resp, err := client.Request(http.MethodGet, path, nil)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
+
+// FetchCheckRunAnnotations fetches the annotations of a check run, in the order they were
+// reported.
+func FetchCheckRunAnnotations(repo string, checkRunId int64) ([]CheckAnnotation, error) {
+	client, err := getRESTClient()
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("repos/%s/check-runs/%d/annotations?per_page=50", repo, checkRunId)
+	log.Debug("Fetching check run annotations", "repo", repo, "checkRunId", checkRunId)
+	annotations := make([]CheckAnnotation, 0)
+	if err := client.Get(path, &annotations); err != nil {
+		return nil, err
+	}
+	return annotations, nil
+}
+
+// RerunFailedJobs re-runs the failed and cancelled jobs of a workflow run, and the jobs
+// depending on them.
+func RerunFailedJobs(repo string, runId int64) error {
+	return postWorkflowRun(repo, runId, "rerun-failed-jobs")
+}
+
+// RerunWorkflowRun re-runs all the jobs of a workflow run.
+func RerunWorkflowRun(repo string, runId int64) error {
+	return postWorkflowRun(repo, runId, "rerun")
+}
+
+// CancelWorkflowRun cancels a workflow run that is queued or in progress.
+func CancelWorkflowRun(repo string, runId int64) error {
+	return postWorkflowRun(repo, runId, "cancel")
+}
+
+func postWorkflowRun(repo string, runId int64, action string) error {
+	client, err := getRESTClient()
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("repos/%s/actions/runs/%d/%s", repo, runId, action)
+	log.Debug("Posting workflow run action", "repo", repo, "runId", runId, "action", action)
+	return client.Post(path, nil, nil)
+}
@@ -0,0 +1,134 @@
+package data
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"charm.land/log/v2"
+)
+
+// CloneStore persists where the repos missing from repoPaths were cloned to on demand, so
+// later lookups of their local paths resolve.
+type CloneStore struct {
+	mu       sync.RWMutex
+	paths    map[string]string
+	filePath string
+}
+
+func newCloneStore(filename string) *CloneStore {
+	store := &CloneStore{paths: make(map[string]string)}
+	filePath, err := getStateFilePath(filename)
+	if err != nil {
+		log.Error("Failed to get state file path for cloned repos", "err", err)
+	}
+	store.filePath = filePath
+	if err := store.load(); err != nil {
+		log.Error("Failed to load cloned repos", "err", err)
+	}
+	return store
+}
+
+func (s *CloneStore) load() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.filePath == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(s.filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	if err := json.Unmarshal(data, &s.paths); err != nil {
+		s.paths = make(map[string]string)
+		return err
+	}
+	log.Debug("Loaded cloned repos", "count", len(s.paths))
+	return nil
+}
+
+func (s *CloneStore) save() error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.filePath == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(s.paths, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(s.filePath)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	tmpFile, err := os.CreateTemp(dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	tmpPath := tmpFile.Name()
+	if _, err := tmpFile.Write(data); err != nil {
+		tmpFile.Close()
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := os.Rename(tmpPath, s.filePath); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+
+	log.Debug("Saved cloned repos", "count", len(s.paths))
+	return nil
+}
+
+// Get returns where the repo was cloned to. Clones that were deleted since aren't returned.
+func (s *CloneStore) Get(repo string) (string, bool) {
+	s.mu.RLock()
+	path, ok := s.paths[strings.ToLower(repo)]
+	s.mu.RUnlock()
+	if !ok {
+		return "", false
+	}
+	if _, err := os.Stat(path); err != nil {
+		return "", false
+	}
+	return path, true
+}
+
+// Set records where the repo was cloned to and saves the store.
+func (s *CloneStore) Set(repo string, path string) error {
+	s.mu.Lock()
+	s.paths[strings.ToLower(repo)] = path
+	s.mu.Unlock()
+	return s.save()
+}
+
+// Singleton
+
+var (
+	cloneStore     *CloneStore
+	cloneStoreOnce sync.Once
+)
+
+// GetCloneStore returns the singleton clone store.
+func GetCloneStore() *CloneStore {
+	cloneStoreOnce.Do(func() {
+		cloneStore = newCloneStore("cloned-repos.json")
+	})
+	return cloneStore
+}
@@ -0,0 +1,38 @@
+package data
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCloneStore(t *testing.T) {
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "cloned-repos.json")
+	store := NewCloneStoreForTesting(filePath)
+
+	clonePath := filepath.Join(dir, "dlvhdr", "gh-dash")
+	if err := os.MkdirAll(clonePath, 0o755); err != nil {
+		t.Fatalf("Failed to create clone dir: %v", err)
+	}
+	if err := store.Set("dlvhdr/gh-dash", clonePath); err != nil {
+		t.Fatalf("Failed to save cloned repo: %v", err)
+	}
+	if err := store.Set("dlvhdr/jb", filepath.Join(dir, "dlvhdr", "jb")); err != nil {
+		t.Fatalf("Failed to save cloned repo: %v", err)
+	}
+
+	reloaded := NewCloneStoreForTesting(filePath)
+	if err := reloaded.load(); err != nil {
+		t.Fatalf("Failed to load cloned repos: %v", err)
+	}
+	if path, ok := reloaded.Get("DLVHDR/gh-dash"); !ok || path != clonePath {
+		t.Errorf("Expected %s to be resolved after reloading, got %q", clonePath, path)
+	}
+	if _, ok := reloaded.Get("dlvhdr/jb"); ok {
+		t.Error("Expected a deleted clone not to be resolved")
+	}
+	if _, ok := reloaded.Get("dlvhdr/harbor"); ok {
+		t.Error("Expected a repo that wasn't cloned not to be resolved")
+	}
+}
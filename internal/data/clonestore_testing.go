@@ -0,0 +1,16 @@
+package data
+
+// NewCloneStoreForTesting creates a CloneStore backed by the given file path.
+func NewCloneStoreForTesting(filePath string) *CloneStore {
+	return &CloneStore{paths: make(map[string]string), filePath: filePath}
+}
+
+// OverrideCloneStoreForTesting replaces the singleton CloneStore with the
+// given store. It returns a function that restores the original store.
+func OverrideCloneStoreForTesting(store *CloneStore) func() {
+	// Ensure the singleton is initialized so sync.Once has fired.
+	GetCloneStore()
+	old := cloneStore
+	cloneStore = store
+	return func() { cloneStore = old }
+}
@@ -1,9 +1,12 @@
 package data
 
 import (
+	"net/url"
+
 	"charm.land/log/v2"
 	gh "github.com/cli/go-gh/v2/pkg/api"
 	graphql "github.com/cli/shurcooL-graphql"
+	"github.com/shurcooL/githubv4"
 )
 
 type VersionResponse struct {
@@ -81,3 +84,47 @@ func FetchSponsors() (SponsorsResponse, error) {
 
 	return queryResult, nil
 }
+
+// TitleAndBody is the title and body of an issue or a PR, along with its node id.
+type TitleAndBody struct {
+	Id    string
+	Title string
+	Body  string
+}
+
+// FetchTitleAndBody fetches the node id and the current title and body of the issue or PR at
+// the url.
+func FetchTitleAndBody(resourceUrl string) (TitleAndBody, error) {
+	var err error
+	if client == nil {
+		client, err = gh.DefaultGraphQLClient()
+		if err != nil {
+			return TitleAndBody{}, err
+		}
+	}
+
+	var queryResult struct {
+		Resource struct {
+			Issue       TitleAndBody `graphql:"... on Issue"`
+			PullRequest TitleAndBody `graphql:"... on PullRequest"`
+		} `graphql:"resource(url: $url)"`
+	}
+	parsedUrl, err := url.Parse(resourceUrl)
+	if err != nil {
+		return TitleAndBody{}, err
+	}
+	variables := map[string]any{
+		"url": githubv4.URI{URL: parsedUrl},
+	}
+	log.Debug("Fetching title and body", "url", resourceUrl)
+	err = client.Query("FetchTitleAndBody", &queryResult, variables)
+	if err != nil {
+		return TitleAndBody{}, err
+	}
+	log.Info("Successfully fetched title and body", "url", resourceUrl)
+
+	if queryResult.Resource.PullRequest.Id != "" {
+		return queryResult.Resource.PullRequest, nil
+	}
+	return queryResult.Resource.Issue, nil
+}
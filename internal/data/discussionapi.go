@@ -0,0 +1,156 @@
+package data
+
+import (
+	"fmt"
+	"time"
+
+	"charm.land/log/v2"
+	gh "github.com/cli/go-gh/v2/pkg/api"
+	graphql "github.com/cli/shurcooL-graphql"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/theme"
+)
+
+type DiscussionData struct {
+	Id     string
+	Number int
+	Title  string
+	Body   string
+	Author struct {
+		Login string
+	}
+	AuthorAssociation string
+	UpdatedAt         time.Time
+	CreatedAt         time.Time
+	Url               string
+	Closed            bool
+	IsAnswered        bool
+	UpvoteCount       int
+	Category          DiscussionCategory
+	Repository        Repository
+	Answer            *DiscussionComment
+	Comments          DiscussionComments `graphql:"comments(last: 15)"`
+	Labels            IssueLabels        `graphql:"labels(first: 20)"`
+}
+
+type DiscussionCategory struct {
+	Name         string
+	IsAnswerable bool
+}
+
+type DiscussionComments struct {
+	Nodes      []DiscussionComment
+	TotalCount int
+}
+
+type DiscussionComment struct {
+	Id     string
+	Author struct {
+		Login string
+	}
+	Body        string
+	UpdatedAt   time.Time
+	IsAnswer    bool
+	UpvoteCount int
+	Replies     struct {
+		TotalCount int
+	} `graphql:"replies(first: 1)"`
+}
+
+func (data DiscussionData) GetAuthor(theme theme.Theme, showAuthorIcons bool) string {
+	author := data.Author.Login
+	if showAuthorIcons {
+		author += fmt.Sprintf(" %s", GetAuthorRoleIcon(data.AuthorAssociation, theme))
+	}
+	return author
+}
+
+func (data DiscussionData) GetTitle() string {
+	return data.Title
+}
+
+func (data DiscussionData) GetRepoNameWithOwner() string {
+	return data.Repository.NameWithOwner
+}
+
+func (data DiscussionData) GetRepoNameAndOwner() (owner, repoName string) {
+	return data.Repository.Owner.Login, data.Repository.Name
+}
+
+func (data DiscussionData) GetNumber() int {
+	return data.Number
+}
+
+func (data DiscussionData) GetUrl() string {
+	return data.Url
+}
+
+func (data DiscussionData) GetUpdatedAt() time.Time {
+	return data.UpdatedAt
+}
+
+func (data DiscussionData) GetCreatedAt() time.Time {
+	return data.CreatedAt
+}
+
+func makeDiscussionsQuery(query string) string {
+	return fmt.Sprintf("archived:false %s sort:updated", query)
+}
+
+func FetchDiscussions(
+	query string,
+	limit int,
+	pageInfo *PageInfo,
+) (DiscussionsResponse, error) {
+	var err error
+	if client == nil {
+		client, err = gh.DefaultGraphQLClient()
+	}
+
+	if err != nil {
+		return DiscussionsResponse{}, err
+	}
+
+	var queryResult struct {
+		Search struct {
+			Nodes []struct {
+				Discussion DiscussionData `graphql:"... on Discussion"`
+			}
+			DiscussionCount int
+			PageInfo        PageInfo
+		} `graphql:"search(type: DISCUSSION, first: $limit, after: $endCursor, query: $query)"`
+	}
+	var endCursor *string
+	if pageInfo != nil {
+		endCursor = &pageInfo.EndCursor
+	}
+	variables := map[string]any{
+		"query":     graphql.String(makeDiscussionsQuery(query)),
+		"limit":     graphql.Int(limit),
+		"endCursor": (*graphql.String)(endCursor),
+	}
+	log.Debug("Fetching discussions", "query", query, "limit", limit, "endCursor", endCursor)
+	err = client.Query("SearchDiscussions", &queryResult, variables)
+	if err != nil {
+		return DiscussionsResponse{}, err
+	}
+	log.Info("Successfully fetched discussions", "query", query,
+		"count", queryResult.Search.DiscussionCount)
+
+	discussions := make([]DiscussionData, 0, len(queryResult.Search.Nodes))
+	for _, node := range queryResult.Search.Nodes {
+		discussions = append(discussions, node.Discussion)
+	}
+
+	return DiscussionsResponse{
+		Discussions: discussions,
+		TotalCount:  queryResult.Search.DiscussionCount,
+		PageInfo:    queryResult.Search.PageInfo,
+	}, nil
+}
+
+type DiscussionsResponse struct {
+	Discussions []DiscussionData
+	TotalCount  int
+	PageInfo    PageInfo
+}
@@ -200,3 +200,19 @@ func GetDoneStore() *DoneStore {
 	})
 	return doneStore
 }
+
+var (
+	notifiedStore     *DoneStore
+	notifiedStoreOnce sync.Once
+)
+
+// GetNotifiedStore returns the singleton store of the events the watcher has
+// already sent a desktop notification for. It is kept apart from the done
+// notifications so that notifying about an event doesn't hide it from the
+// notifications view.
+func GetNotifiedStore() *DoneStore {
+	notifiedStoreOnce.Do(func() {
+		notifiedStore = newDoneStore("notified.json")
+	})
+	return notifiedStore
+}
@@ -14,6 +14,7 @@ import (
 )
 
 type IssueData struct {
+	Id     string
 	Number int
 	Title  string
 	Body   string
@@ -26,10 +27,14 @@ type IssueData struct {
 	CreatedAt         time.Time
 	Url               string
 	Repository        Repository
+	Milestone         *Milestone
 	Assignees         Assignees      `graphql:"assignees(first: 3)"`
 	Comments          IssueComments  `graphql:"comments(last: 15)"`
 	Reactions         IssueReactions `graphql:"reactions(first: 1)"`
 	Labels            IssueLabels    `graphql:"labels(first: 20)"`
+	ProjectItems      ProjectItems   `graphql:"projectItems(first: 5) @include(if: $withProjectItems)"`
+	ReactionGroups    ReactionGroups
+	TimelineItems     TimelineEvents `graphql:"timelineItems(last: 20, itemTypes: [LABELED_EVENT, UNLABELED_EVENT, CROSS_REFERENCED_EVENT, CLOSED_EVENT, REOPENED_EVENT])"`
 }
 
 type IssueComments struct {
@@ -38,11 +43,14 @@ type IssueComments struct {
 }
 
 type IssueComment struct {
+	Id     string
 	Author struct {
 		Login string
 	}
-	Body      string
-	UpdatedAt time.Time
+	Body           string
+	UpdatedAt      time.Time
+	ReactionGroups ReactionGroups
+	CommentMeta
 }
 
 type IssueReactions struct {
@@ -126,6 +134,8 @@ func FetchIssues(query string, limit int, pageInfo *PageInfo) (IssuesResponse, e
 		"query":     graphql.String(makeIssuesQuery(query)),
 		"limit":     graphql.Int(limit),
 		"endCursor": (*graphql.String)(endCursor),
+		// Project items are only fetched when a layout shows project fields
+		"withProjectItems": graphql.Boolean(fetchProjectItems),
 	}
 	log.Debug("Fetching issues", "query", query, "limit", limit, "endCursor", endCursor)
 	err = client.Query("SearchIssues", &queryResult, variables)
@@ -172,7 +182,8 @@ func FetchIssue(issueUrl string) (IssueData, error) {
 		return IssueData{}, err
 	}
 	variables := map[string]any{
-		"url": githubv4.URI{URL: parsedUrl},
+		"url":              githubv4.URI{URL: parsedUrl},
+		"withProjectItems": graphql.Boolean(fetchProjectItems),
 	}
 	log.Debug("Fetching Issue", "url", issueUrl)
 	err = client.Query("FetchIssue", &queryResult, variables)
@@ -181,5 +192,7 @@ func FetchIssue(issueUrl string) (IssueData, error) {
 	}
 	log.Info("Successfully fetched Issue", "url", issueUrl)
 
-	return queryResult.Resource.Issue, nil
+	issue := queryResult.Resource.Issue
+	withProjectItems(issueUrl, &issue.ProjectItems)
+	return issue, nil
 }
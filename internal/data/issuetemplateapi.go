@@ -0,0 +1,239 @@
+package data
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	"charm.land/log/v2"
+	gh "github.com/cli/go-gh/v2/pkg/api"
+	"gopkg.in/yaml.v3"
+)
+
+const issueTemplatesDir = ".github/ISSUE_TEMPLATE"
+
+// IssueTemplate is an issue template of a repository, either a markdown template or an issue
+// form. The body of issue forms is the markdown GitHub submits for them, with the default
+// values of their fields.
+type IssueTemplate struct {
+	Name      string
+	About     string
+	Title     string
+	Body      string
+	Labels    []string
+	Assignees []string
+}
+
+// templateList is a list of labels or assignees, which templates write either as a YAML list
+// or as a comma separated string.
+type templateList []string
+
+func (l *templateList) UnmarshalYAML(value *yaml.Node) error {
+	var items []string
+	if value.Kind == yaml.ScalarNode {
+		items = strings.Split(value.Value, ",")
+	} else if err := value.Decode(&items); err != nil {
+		return err
+	}
+	*l = nil
+	for _, item := range items {
+		if item = strings.TrimSpace(item); item != "" {
+			*l = append(*l, item)
+		}
+	}
+	return nil
+}
+
+type issueTemplateHeader struct {
+	Name        string       `yaml:"name"`
+	About       string       `yaml:"about"`
+	Description string       `yaml:"description"`
+	Title       string       `yaml:"title"`
+	Labels      templateList `yaml:"labels"`
+	Assignees   templateList `yaml:"assignees"`
+}
+
+// issueFormOption is an option of a dropdown, a string, or of a checkboxes field, an object
+// with a label.
+type issueFormOption struct {
+	Label string
+}
+
+func (o *issueFormOption) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		o.Label = value.Value
+		return nil
+	}
+	var option struct {
+		Label string `yaml:"label"`
+	}
+	err := value.Decode(&option)
+	o.Label = option.Label
+	return err
+}
+
+type issueForm struct {
+	issueTemplateHeader `yaml:",inline"`
+	Body                []struct {
+		Type       string `yaml:"type"`
+		Attributes struct {
+			Label   string            `yaml:"label"`
+			Value   string            `yaml:"value"`
+			Options []issueFormOption `yaml:"options"`
+			Default *int              `yaml:"default"`
+		} `yaml:"attributes"`
+	} `yaml:"body"`
+}
+
+// markdownBody returns the body GitHub submits for the form, a heading per field followed by
+// its default value. Markdown fields only show in the form and aren't submitted.
+func (form issueForm) markdownBody() string {
+	sections := make([]string, 0, len(form.Body))
+	for _, field := range form.Body {
+		attrs := field.Attributes
+		var value string
+		switch field.Type {
+		case "markdown":
+			continue
+		case "dropdown":
+			if attrs.Default != nil && *attrs.Default >= 0 && *attrs.Default < len(attrs.Options) {
+				value = attrs.Options[*attrs.Default].Label
+			}
+		case "checkboxes":
+			boxes := make([]string, 0, len(attrs.Options))
+			for _, option := range attrs.Options {
+				boxes = append(boxes, "- [ ] "+option.Label)
+			}
+			value = strings.Join(boxes, "\n")
+		default:
+			value = strings.TrimSpace(attrs.Value)
+		}
+		sections = append(sections, strings.TrimSpace("### "+attrs.Label+"\n\n"+value))
+	}
+	return strings.Join(sections, "\n\n")
+}
+
+// ParseIssueTemplate parses a file of the issue templates directory of a repository. Markdown
+// templates describe themselves in a YAML front matter, issue forms are YAML files. It returns
+// false for files that aren't templates, like the config.yml of the template chooser.
+func ParseIssueTemplate(fileName string, content []byte) (IssueTemplate, bool, error) {
+	if !isIssueTemplateFile(fileName) {
+		return IssueTemplate{}, false, nil
+	}
+
+	fallbackName := strings.TrimSuffix(fileName, path.Ext(fileName))
+	if strings.ToLower(path.Ext(fileName)) == ".md" {
+		return parseMarkdownIssueTemplate(fallbackName, string(content))
+	}
+	var form issueForm
+	if err := yaml.Unmarshal(content, &form); err != nil {
+		return IssueTemplate{}, false, fmt.Errorf("parsing issue form %s: %w", fileName, err)
+	}
+	template := form.issueTemplateHeader.toTemplate(fallbackName)
+	template.Body = form.markdownBody()
+	return template, true, nil
+}
+
+func isIssueTemplateFile(fileName string) bool {
+	ext := strings.ToLower(path.Ext(fileName))
+	name := strings.ToLower(strings.TrimSuffix(fileName, path.Ext(fileName)))
+	return ext == ".md" || (ext == ".yml" || ext == ".yaml") && name != "config"
+}
+
+func parseMarkdownIssueTemplate(name string, content string) (IssueTemplate, bool, error) {
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	rest, ok := strings.CutPrefix(content, "---\n")
+	if !ok {
+		return IssueTemplate{Name: name, Body: strings.TrimSpace(content)}, true, nil
+	}
+	frontMatter, body, ok := strings.Cut(rest, "\n---")
+	if !ok {
+		return IssueTemplate{Name: name, Body: strings.TrimSpace(content)}, true, nil
+	}
+
+	var header issueTemplateHeader
+	if err := yaml.Unmarshal([]byte(frontMatter), &header); err != nil {
+		return IssueTemplate{}, false, fmt.Errorf("parsing issue template %s: %w", name, err)
+	}
+	template := header.toTemplate(name)
+	template.Body = strings.TrimSpace(body)
+	return template, true, nil
+}
+
+func (header issueTemplateHeader) toTemplate(fallbackName string) IssueTemplate {
+	template := IssueTemplate{
+		Name:      header.Name,
+		About:     header.About,
+		Title:     header.Title,
+		Labels:    header.Labels,
+		Assignees: header.Assignees,
+	}
+	if template.Name == "" {
+		template.Name = fallbackName
+	}
+	if template.About == "" {
+		template.About = header.Description
+	}
+	return template
+}
+
+type repoContent struct {
+	Name    string `json:"name"`
+	Path    string `json:"path"`
+	Type    string `json:"type"`
+	Content string `json:"content"`
+}
+
+// FetchIssueTemplates fetches the markdown templates and issue forms of the
+// .github/ISSUE_TEMPLATE directory of a repository, in the order of their file names. A
+// repository without templates has none, and templates that fail to parse are skipped.
+func FetchIssueTemplates(repoNameWithOwner string) ([]IssueTemplate, error) {
+	client, err := getRESTClient()
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("Fetching issue templates", "repo", repoNameWithOwner)
+	var entries []repoContent
+	err = client.Get(fmt.Sprintf("repos/%s/contents/%s", repoNameWithOwner, issueTemplatesDir),
+		&entries)
+	var httpErr *gh.HTTPError
+	if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+		return []IssueTemplate{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make([]IssueTemplate, 0, len(entries))
+	for _, entry := range entries {
+		if entry.Type != "file" || !isIssueTemplateFile(entry.Name) {
+			continue
+		}
+
+		var file repoContent
+		err := client.Get(fmt.Sprintf("repos/%s/contents/%s", repoNameWithOwner, entry.Path),
+			&file)
+		if err != nil {
+			return nil, err
+		}
+		content, err := base64.StdEncoding.DecodeString(
+			strings.ReplaceAll(file.Content, "\n", ""))
+		if err != nil {
+			return nil, err
+		}
+		template, _, err := ParseIssueTemplate(entry.Name, content)
+		if err != nil {
+			log.Warn("Skipping issue template", "repo", repoNameWithOwner, "err", err)
+			continue
+		}
+		templates = append(templates, template)
+	}
+	log.Info("Successfully fetched issue templates", "repo", repoNameWithOwner,
+		"count", len(templates))
+
+	return templates, nil
+}
@@ -0,0 +1,83 @@
+package data
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIssueTemplate_Markdown(t *testing.T) {
+	content := "---\r\nname: Bug report\r\nabout: Something isn't working\r\ntitle: '[BUG] '\r\n" +
+		"labels: bug, needs triage\r\nassignees: ''\r\n---\r\n\r\n**Describe the bug**\r\n"
+
+	template, ok, err := ParseIssueTemplate("bug_report.md", []byte(content))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, IssueTemplate{
+		Name:   "Bug report",
+		About:  "Something isn't working",
+		Title:  "[BUG] ",
+		Body:   "**Describe the bug**",
+		Labels: []string{"bug", "needs triage"},
+	}, template)
+
+	template, ok, err = ParseIssueTemplate("plain.md", []byte("Just a body\n"))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, IssueTemplate{Name: "plain", Body: "Just a body"}, template,
+		"templates without a front matter are named after their file")
+}
+
+func TestParseIssueTemplate_Form(t *testing.T) {
+	content := `name: Feature request
+description: Suggest an idea
+title: "[Feature]: "
+labels: ["enhancement"]
+assignees:
+  - dlvhdr
+body:
+  - type: markdown
+    attributes:
+      value: Thanks for taking the time!
+  - type: textarea
+    id: problem
+    attributes:
+      label: Problem
+      value: "I'm always frustrated when..."
+  - type: dropdown
+    attributes:
+      label: Area
+      options: [PRs, Issues]
+      default: 1
+  - type: input
+    attributes:
+      label: Version
+  - type: checkboxes
+    attributes:
+      label: Checklist
+      options:
+        - label: I searched the existing issues
+          required: true
+`
+
+	template, ok, err := ParseIssueTemplate("feature.yml", []byte(content))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "Feature request", template.Name)
+	require.Equal(t, "Suggest an idea", template.About)
+	require.Equal(t, "[Feature]: ", template.Title)
+	require.Equal(t, []string{"enhancement"}, template.Labels)
+	require.Equal(t, []string{"dlvhdr"}, template.Assignees)
+	require.Equal(t, "### Problem\n\nI'm always frustrated when...\n\n"+
+		"### Area\n\nIssues\n\n"+
+		"### Version\n\n"+
+		"### Checklist\n\n- [ ] I searched the existing issues", template.Body)
+}
+
+func TestParseIssueTemplate_SkipsOtherFiles(t *testing.T) {
+	for _, fileName := range []string{"config.yml", "CONFIG.yaml", "README.txt"} {
+		_, ok, err := ParseIssueTemplate(fileName, []byte("blank_issues_enabled: false"))
+		require.NoError(t, err)
+		require.False(t, ok, fileName)
+	}
+}
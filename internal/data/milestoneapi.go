@@ -0,0 +1,109 @@
+package data
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"charm.land/log/v2"
+	gh "github.com/cli/go-gh/v2/pkg/api"
+	graphql "github.com/cli/shurcooL-graphql"
+)
+
+var (
+	repoMilestoneCache = make(map[string][]Milestone)
+	milestoneCacheMu   sync.RWMutex
+)
+
+type Milestone struct {
+	Number             int
+	Title              string
+	Description        string
+	State              string
+	DueOn              *time.Time
+	ProgressPercentage float64
+}
+
+type RepoMilestonesResponse struct {
+	Repository struct {
+		Milestones struct {
+			Nodes []Milestone
+		} `graphql:"milestones(first: 100, states: [OPEN], orderBy: {field: DUE_DATE, direction: ASC})"`
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+func CachedRepoMilestones(repoNameWithOwner string) ([]Milestone, bool) {
+	milestoneCacheMu.RLock()
+	defer milestoneCacheMu.RUnlock()
+	milestones, ok := repoMilestoneCache[repoNameWithOwner]
+	return milestones, ok
+}
+
+// FetchRepoMilestones fetches the open milestones of a repository, the ones due first first.
+func FetchRepoMilestones(repoNameWithOwner string) ([]Milestone, error) {
+	if cachedMilestones, ok := CachedRepoMilestones(repoNameWithOwner); ok {
+		return cachedMilestones, nil
+	}
+
+	log.Debug("Fetching repo milestones", "repoNameWithOwner", repoNameWithOwner)
+
+	if client == nil {
+		var err error
+		client, err = gh.DefaultGraphQLClient()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	owner, name, _ := strings.Cut(repoNameWithOwner, "/")
+	var result RepoMilestonesResponse
+	variables := map[string]any{
+		"owner": graphql.String(owner),
+		"name":  graphql.String(name),
+	}
+	err := client.Query("GetRepoMilestones", &result, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	milestones := result.Repository.Milestones.Nodes
+	if milestones == nil {
+		milestones = []Milestone{}
+	}
+
+	milestoneCacheMu.Lock()
+	defer milestoneCacheMu.Unlock()
+
+	if cached, ok := repoMilestoneCache[repoNameWithOwner]; ok {
+		return cached, nil
+	}
+
+	repoMilestoneCache[repoNameWithOwner] = milestones
+	log.Debug(
+		"Successfully fetched repo milestones",
+		"repoNameWithOwner",
+		repoNameWithOwner,
+		"len",
+		len(milestones),
+	)
+	return milestones, nil
+}
+
+func ClearRepoMilestoneCache(repoNameWithOwner string) {
+	milestoneCacheMu.Lock()
+	defer milestoneCacheMu.Unlock()
+	delete(repoMilestoneCache, repoNameWithOwner)
+}
+
+// FindMilestone returns the milestone of the repo with the given title from the cached
+// milestones, or a milestone with only a title when it isn't cached.
+func FindMilestone(repoNameWithOwner string, title string) Milestone {
+	if cached, ok := CachedRepoMilestones(repoNameWithOwner); ok {
+		for _, milestone := range cached {
+			if strings.EqualFold(milestone.Title, title) {
+				return milestone
+			}
+		}
+	}
+	return Milestone{Title: title, State: "OPEN"}
+}
@@ -0,0 +1,23 @@
+package data
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindMilestone(t *testing.T) {
+	repo := "dlvhdr/gh-dash"
+	t.Cleanup(func() { ClearRepoMilestoneCache(repo) })
+
+	require.Equal(t, Milestone{Title: "v4.1", State: "OPEN"}, FindMilestone(repo, "v4.1"),
+		"milestones that aren't cached only have a title")
+
+	milestoneCacheMu.Lock()
+	repoMilestoneCache[repo] = []Milestone{{Number: 3, Title: "v4.1", ProgressPercentage: 40}}
+	milestoneCacheMu.Unlock()
+
+	milestone := FindMilestone(repo, "V4.1")
+	require.Equal(t, 3, milestone.Number)
+	require.InDelta(t, 40, milestone.ProgressPercentage, 0)
+}
@@ -1,11 +1,13 @@
 package data
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
 	"net/http"
 	"net/url"
 	"os"
+	"strings"
 	"time"
 
 	"charm.land/log/v2"
@@ -27,6 +29,7 @@ type SuggestedReviewer struct {
 }
 
 type EnrichedPullRequestData struct {
+	Id      string
 	Url     string
 	Number  int
 	Title   string
@@ -44,6 +47,7 @@ type EnrichedPullRequestData struct {
 	Additions         int
 	Deletions         int
 	HeadRefName       string
+	HeadRefOid        string
 	BaseRefName       string
 	HeadRepository    struct {
 		Name string
@@ -51,17 +55,30 @@ type EnrichedPullRequestData struct {
 	HeadRef struct {
 		Name string
 	}
-	Labels             PRLabels  `graphql:"labels(first: 6)"`
-	Assignees          Assignees `graphql:"assignees(first: 3)"`
-	Repository         Repository
-	Commits            LastCommitWithStatusChecks `graphql:"commits(last: 1)"`
-	AllCommits         AllCommits                 `graphql:"allCommits: commits(last: 100)"`
-	Comments           CommentsWithBody           `graphql:"comments(last: 50, orderBy: { field: UPDATED_AT, direction: DESC })"`
-	ReviewThreads      ReviewThreadsWithComments  `graphql:"reviewThreads(last: 50)"`
-	ReviewRequests     ReviewRequests             `graphql:"reviewRequests(last: 100)"`
-	Reviews            Reviews                    `graphql:"reviews(last: 100)"`
-	SuggestedReviewers []SuggestedReviewer
-	Files              ChangedFiles `graphql:"files(first: 20)"`
+	Labels              PRLabels  `graphql:"labels(first: 6)"`
+	Assignees           Assignees `graphql:"assignees(first: 3)"`
+	Repository          Repository
+	Commits             LastCommitWithStatusChecks `graphql:"commits(last: 1)"`
+	AllCommits          AllCommits                 `graphql:"allCommits: commits(last: 100)"`
+	Comments            CommentsWithBody           `graphql:"comments(last: 50, orderBy: { field: UPDATED_AT, direction: DESC })"`
+	ReviewThreads       ReviewThreadsWithComments  `graphql:"reviewThreads(last: 50)"`
+	ReviewRequests      ReviewRequests             `graphql:"reviewRequests(last: 100)"`
+	Reviews             Reviews                    `graphql:"reviews(last: 100)"`
+	SuggestedReviewers  []SuggestedReviewer
+	Files               ChangedFiles `graphql:"files(first: 20)"`
+	IsInMergeQueue      bool
+	IsMergeQueueEnabled bool
+	AutoMergeRequest    *AutoMergeRequest
+	Milestone           *Milestone
+	ProjectItems        ProjectItems `graphql:"projectItems(first: 5) @include(if: $withProjectItems)"`
+	ReactionGroups      ReactionGroups
+	TimelineItems       TimelineEvents `graphql:"timelineItems(last: 50, itemTypes: [HEAD_REF_FORCE_PUSHED_EVENT, LABELED_EVENT, UNLABELED_EVENT, REVIEW_REQUESTED_EVENT, CROSS_REFERENCED_EVENT, MERGED_EVENT, CLOSED_EVENT, REOPENED_EVENT])"`
+}
+
+// AutoMergeRequest is set on a PR that will be merged once its requirements are met.
+type AutoMergeRequest struct {
+	EnabledAt   time.Time
+	MergeMethod string
 }
 
 type PullRequestData struct {
@@ -88,6 +105,7 @@ type PullRequestData struct {
 		Name string
 	}
 	Repository       Repository
+	Milestone        *Milestone
 	Assignees        Assignees            `graphql:"assignees(first: 3)"`
 	Comments         Comments             `graphql:"comments"`
 	ReviewThreads    ReviewThreads        `graphql:"reviewThreads"`
@@ -98,6 +116,7 @@ type PullRequestData struct {
 	Commits          LastCommitStatus `graphql:"commits(last: 1)"`
 	Labels           PRLabels         `graphql:"labels(first: 6)"`
 	MergeStateStatus MergeStateStatus `graphql:"mergeStateStatus"`
+	ProjectItems     ProjectItems     `graphql:"projectItems(first: 5) @include(if: $withProjectItems)"`
 }
 
 type LastCommitStatus struct {
@@ -110,7 +129,23 @@ type LastCommitStatus struct {
 	}
 }
 
+// SetState sets the status check rollup of the last commit, e.g. after polling its checks.
+func (c *LastCommitStatus) SetState(state graphql.String) {
+	if len(c.Nodes) == 0 {
+		c.Nodes = make([]struct {
+			Commit struct {
+				StatusCheckRollup struct {
+					State graphql.String
+				}
+			}
+		}, 1)
+	}
+	c.Nodes[0].Commit.StatusCheckRollup.State = state
+}
+
 type CheckRun struct {
+	// DatabaseId is also the id of the job when the check run belongs to a workflow run
+	DatabaseId int64
 	Name       graphql.String
 	Status     graphql.String
 	Conclusion checks.CheckRunState
@@ -119,7 +154,8 @@ type CheckRun struct {
 			Login graphql.String
 		}
 		WorkflowRun struct {
-			Workflow struct {
+			DatabaseId int64
+			Workflow   struct {
 				Name graphql.String
 			}
 		}
@@ -143,7 +179,8 @@ type CheckSuiteNode struct {
 	}
 
 	WorkflowRun struct {
-		Workflow struct {
+		DatabaseId int64
+		Workflow   struct {
 			Name graphql.String
 		}
 	}
@@ -246,21 +283,28 @@ type Commits struct {
 }
 
 type Comment struct {
+	Id     string
 	Author struct {
 		Login string
 	}
-	Body      string
-	UpdatedAt time.Time
+	Body           string
+	UpdatedAt      time.Time
+	ReactionGroups ReactionGroups
+	CommentMeta
 }
 
 type ReviewComment struct {
+	Id     string
 	Author struct {
 		Login string
 	}
-	Body      string
-	UpdatedAt time.Time
-	StartLine int
-	Line      int
+	Body           string
+	UpdatedAt      time.Time
+	StartLine      int
+	Line           int
+	DiffHunk       string
+	ReactionGroups ReactionGroups
+	CommentMeta
 }
 
 type ReviewComments struct {
@@ -277,12 +321,21 @@ type ReviewThreads struct {
 }
 
 type Review struct {
+	Id     string
 	Author struct {
 		Login string
 	}
 	Body      string
 	State     string
 	UpdatedAt time.Time
+	// Commit is the commit the review was submitted on, nil when it no longer exists
+	Commit         *ReviewCommit
+	ReactionGroups ReactionGroups
+	CommentMeta
+}
+
+type ReviewCommit struct {
+	Oid string
 }
 
 type ReviewsNumber struct {
@@ -294,16 +347,19 @@ type Reviews struct {
 	Nodes      []Review
 }
 
+type ReviewThread struct {
+	Id           string
+	IsOutdated   bool
+	IsResolved   bool
+	OriginalLine int
+	StartLine    int
+	Line         int
+	Path         string
+	Comments     ReviewComments `graphql:"comments(first: 20)"`
+}
+
 type ReviewThreadsWithComments struct {
-	Nodes []struct {
-		Id           string
-		IsOutdated   bool
-		OriginalLine int
-		StartLine    int
-		Line         int
-		Path         string
-		Comments     ReviewComments `graphql:"comments(first: 20)"`
-	}
+	Nodes []ReviewThread
 }
 
 type ChangedFile struct {
@@ -466,7 +522,10 @@ func (e EnrichedPullRequestData) ToPullRequestData() PullRequestData {
 		Repository:        e.Repository,
 		Assignees:         e.Assignees,
 		IsDraft:           e.IsDraft,
+		IsInMergeQueue:    e.IsInMergeQueue,
 		Labels:            e.Labels,
+		Milestone:         e.Milestone,
+		ProjectItems:      e.ProjectItems,
 		// Note: Comments, ReviewThreads, Reviews, ReviewRequests, Commits
 		// have different types in EnrichedPullRequestData vs PullRequestData
 		// We leave them as zero values since the enriched data will be used instead
@@ -550,6 +609,8 @@ func FetchPullRequests(query string, limit int, pageInfo *PageInfo) (PullRequest
 		"query":     graphql.String(makePullRequestsQuery(query)),
 		"limit":     graphql.Int(limit),
 		"endCursor": (*graphql.String)(endCursor),
+		// Project items are only fetched when a layout shows project fields
+		"withProjectItems": graphql.Boolean(fetchProjectItems),
 	}
 	log.Debug("Fetching PRs", "query", query, "limit", limit, "endCursor", endCursor)
 	err = client.Query("SearchPullRequests", &queryResult, variables)
@@ -589,7 +650,8 @@ func FetchPullRequest(prUrl string) (EnrichedPullRequestData, error) {
 		return EnrichedPullRequestData{}, err
 	}
 	variables := map[string]any{
-		"url": githubv4.URI{URL: parsedUrl},
+		"url":              githubv4.URI{URL: parsedUrl},
+		"withProjectItems": graphql.Boolean(fetchProjectItems),
 	}
 	log.Debug("Fetching PR", "url", prUrl)
 	err = client.Query("FetchPullRequest", &queryResult, variables)
@@ -598,5 +660,99 @@ func FetchPullRequest(prUrl string) (EnrichedPullRequestData, error) {
 	}
 	log.Info("Successfully fetched PR", "url", prUrl)
 
-	return queryResult.Resource.PullRequest, nil
+	pr := queryResult.Resource.PullRequest
+	withProjectItems(prUrl, &pr.ProjectItems)
+	return pr, nil
+}
+
+// FetchPullRequestChecks fetches the status checks of the last commit of a PR, as shown in the
+// checks tab of the PR view.
+func FetchPullRequestChecks(
+	ctx context.Context,
+	prUrl string,
+) (LastCommitWithStatusChecks, error) {
+	var err error
+	if client == nil {
+		client, err = gh.DefaultGraphQLClient()
+		if err != nil {
+			return LastCommitWithStatusChecks{}, err
+		}
+	}
+
+	var queryResult struct {
+		Resource struct {
+			PullRequest struct {
+				Commits LastCommitWithStatusChecks `graphql:"commits(last: 1)"`
+			} `graphql:"... on PullRequest"`
+		} `graphql:"resource(url: $url)"`
+	}
+	parsedUrl, err := url.Parse(prUrl)
+	if err != nil {
+		return LastCommitWithStatusChecks{}, err
+	}
+	variables := map[string]any{
+		"url": githubv4.URI{URL: parsedUrl},
+	}
+	log.Debug("Fetching PR checks", "url", prUrl)
+	err = client.QueryWithContext(ctx, "FetchPullRequestChecks", &queryResult, variables)
+	if err != nil {
+		return LastCommitWithStatusChecks{}, err
+	}
+	log.Info("Successfully fetched PR checks", "url", prUrl)
+
+	return queryResult.Resource.PullRequest.Commits, nil
+}
+
+// FetchPullRequestDiff returns the unified diff of a PR as printed by `gh pr diff`.
+func FetchPullRequestDiff(repoNameWithOwner string, number int) (string, error) {
+	log.Debug("Fetching PR diff", "repo", repoNameWithOwner, "number", number)
+	cmd := execCommand(
+		"gh",
+		"pr",
+		"diff",
+		fmt.Sprint(number),
+		"-R",
+		repoNameWithOwner,
+		"--color",
+		"never",
+	)
+	output, err := cmd.Output()
+	if err != nil {
+		return "", err
+	}
+	log.Info("Successfully fetched PR diff", "repo", repoNameWithOwner, "number", number)
+
+	return string(output), nil
+}
+
+// FetchPullRequestState returns the state of a PR, i.e. OPEN, CLOSED or MERGED.
+func FetchPullRequestState(repoNameWithOwner string, number int) (string, error) {
+	var err error
+	if client == nil {
+		client, err = gh.DefaultGraphQLClient()
+		if err != nil {
+			return "", err
+		}
+	}
+
+	var queryResult struct {
+		Repository struct {
+			PullRequest struct {
+				State string
+			} `graphql:"pullRequest(number: $number)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+	owner, name, _ := strings.Cut(repoNameWithOwner, "/")
+	variables := map[string]any{
+		"owner":  graphql.String(owner),
+		"name":   graphql.String(name),
+		"number": graphql.Int(number),
+	}
+	log.Debug("Fetching PR state", "repo", repoNameWithOwner, "number", number)
+	err = client.Query("FetchPullRequestState", &queryResult, variables)
+	if err != nil {
+		return "", err
+	}
+
+	return queryResult.Repository.PullRequest.State, nil
 }
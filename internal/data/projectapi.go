@@ -0,0 +1,279 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"charm.land/log/v2"
+	gh "github.com/cli/go-gh/v2/pkg/api"
+	graphql "github.com/cli/shurcooL-graphql"
+)
+
+const (
+	ProjectItemIssue       = "ISSUE"
+	ProjectItemPullRequest = "PULL_REQUEST"
+	ProjectItemDraftIssue  = "DRAFT_ISSUE"
+)
+
+// DefaultProjectStatusField is the single-select field GitHub adds to every project.
+const DefaultProjectStatusField = "Status"
+
+type ProjectData struct {
+	Id     string
+	Title  string
+	Number int
+	Url    string
+}
+
+type ProjectStatusField struct {
+	Id      string
+	Name    string
+	Options []ProjectFieldOption
+}
+
+type ProjectFieldOption struct {
+	Id    string
+	Name  string
+	Color string
+}
+
+// ProjectItemContent is the issue or PR an item of a project links to.
+type ProjectItemContent struct {
+	Number     int
+	Title      string
+	Url        string
+	State      string
+	UpdatedAt  time.Time
+	Repository struct {
+		NameWithOwner string
+	}
+	Author struct {
+		Login string
+	}
+}
+
+type ProjectItem struct {
+	Id        string
+	Type      string
+	UpdatedAt time.Time
+	Content   struct {
+		Issue       ProjectItemContent `graphql:"... on Issue"`
+		PullRequest ProjectItemContent `graphql:"... on PullRequest"`
+		DraftIssue  struct {
+			Title string
+			Body  string
+		} `graphql:"... on DraftIssue"`
+	}
+	Status struct {
+		SingleSelect struct {
+			OptionId string
+			Name     string
+		} `graphql:"... on ProjectV2ItemFieldSingleSelectValue"`
+	} `graphql:"fieldValueByName(name: $statusField)"`
+}
+
+// GetContent returns the issue or PR of the item, which is empty for draft issues.
+func (item ProjectItem) GetContent() ProjectItemContent {
+	if item.Type == ProjectItemPullRequest {
+		return item.Content.PullRequest
+	}
+	return item.Content.Issue
+}
+
+// GetStatusOptionId returns the id of the item's status option, or "" when it has no status.
+func (item ProjectItem) GetStatusOptionId() string {
+	return item.Status.SingleSelect.OptionId
+}
+
+// SetStatus sets the status option of the item.
+func (item *ProjectItem) SetStatus(option ProjectFieldOption) {
+	item.Status.SingleSelect.OptionId = option.Id
+	item.Status.SingleSelect.Name = option.Name
+}
+
+func (item ProjectItem) GetTitle() string {
+	if item.Type == ProjectItemDraftIssue {
+		return item.Content.DraftIssue.Title
+	}
+	return item.GetContent().Title
+}
+
+func (item ProjectItem) GetRepoNameWithOwner() string {
+	return item.GetContent().Repository.NameWithOwner
+}
+
+func (item ProjectItem) GetNumber() int {
+	return item.GetContent().Number
+}
+
+func (item ProjectItem) GetUrl() string {
+	return item.GetContent().Url
+}
+
+func (item ProjectItem) GetUpdatedAt() time.Time {
+	return item.UpdatedAt
+}
+
+var errNoProject = errors.New(
+	`project sections show a single project, add a "project:owner/number" filter`)
+
+// ProjectFilters are the parsed filters of a project section. Project items can't be searched
+// over the API, so the filters are applied to the fetched items.
+type ProjectFilters struct {
+	Owner  string
+	Number int
+	Repos  []string
+	// Types are the item types shown, all of them when empty
+	Types []string
+	// Open is nil when the filters don't mention the state of the items
+	Open *bool
+}
+
+// ParseProjectFilters parses the "project:owner/number", "repo:", "is:issue", "is:pr",
+// "is:draft", "is:open" and "is:closed" qualifiers. Other tokens are ignored.
+func ParseProjectFilters(query string) ProjectFilters {
+	var filters ProjectFilters
+	open, closed := true, false
+	for token := range strings.FieldsSeq(query) {
+		switch token {
+		case "is:issue":
+			filters.Types = append(filters.Types, ProjectItemIssue)
+		case "is:pr":
+			filters.Types = append(filters.Types, ProjectItemPullRequest)
+		case "is:draft":
+			filters.Types = append(filters.Types, ProjectItemDraftIssue)
+		case "is:open":
+			filters.Open = &open
+		case "is:closed":
+			filters.Open = &closed
+		default:
+			if repo, ok := strings.CutPrefix(token, "repo:"); ok && repo != "" &&
+				!slices.Contains(filters.Repos, repo) {
+				filters.Repos = append(filters.Repos, repo)
+			} else if project, ok := strings.CutPrefix(token, "project:"); ok {
+				owner, number, _ := strings.Cut(project, "/")
+				if n, err := strconv.Atoi(number); err == nil && owner != "" {
+					filters.Owner, filters.Number = owner, n
+				}
+			}
+		}
+	}
+	return filters
+}
+
+func (filters ProjectFilters) matches(item ProjectItem) bool {
+	if len(filters.Types) > 0 && !slices.Contains(filters.Types, item.Type) {
+		return false
+	}
+	if len(filters.Repos) > 0 && !slices.Contains(filters.Repos, item.GetRepoNameWithOwner()) {
+		return false
+	}
+	// Draft issues have no state, they're shown as open
+	isOpen := item.Type == ProjectItemDraftIssue || item.GetContent().State == "OPEN"
+	if filters.Open != nil && isOpen != *filters.Open {
+		return false
+	}
+	return true
+}
+
+type ProjectResponse struct {
+	Project     ProjectData
+	StatusField ProjectStatusField
+	Items       []ProjectItem
+	TotalCount  int
+}
+
+type projectV2 struct {
+	ProjectData
+	Field struct {
+		SingleSelect ProjectStatusField `graphql:"... on ProjectV2SingleSelectField"`
+	} `graphql:"field(name: $statusField)"`
+	Items struct {
+		Nodes      []ProjectItem
+		TotalCount int
+		PageInfo   PageInfo
+	} `graphql:"items(first: $limit, after: $endCursor)"`
+}
+
+// FetchProject fetches the project in the query, its status field and its first limit items.
+// Redacted items, that the user can't see, are skipped.
+func FetchProject(query string, statusField string, limit int) (ProjectResponse, error) {
+	filters := ParseProjectFilters(query)
+	if filters.Owner == "" {
+		return ProjectResponse{}, errNoProject
+	}
+
+	var err error
+	if client == nil {
+		client, err = gh.DefaultGraphQLClient()
+	}
+
+	if err != nil {
+		return ProjectResponse{}, err
+	}
+
+	var res ProjectResponse
+	var endCursor *string
+	for {
+		var queryResult struct {
+			RepositoryOwner struct {
+				User struct {
+					ProjectV2 projectV2 `graphql:"projectV2(number: $number)"`
+				} `graphql:"... on User"`
+				Organization struct {
+					ProjectV2 projectV2 `graphql:"projectV2(number: $number)"`
+				} `graphql:"... on Organization"`
+			} `graphql:"repositoryOwner(login: $owner)"`
+		}
+		variables := map[string]any{
+			"owner":       graphql.String(filters.Owner),
+			"number":      graphql.Int(filters.Number),
+			"statusField": graphql.String(statusField),
+			"limit":       graphql.Int(min(limit-len(res.Items), 100)),
+			"endCursor":   (*graphql.String)(endCursor),
+		}
+		log.Debug("Fetching project", "owner", filters.Owner, "number", filters.Number,
+			"endCursor", endCursor)
+		err = client.Query("ProjectItems", &queryResult, variables)
+		if err != nil {
+			return ProjectResponse{}, err
+		}
+
+		project := queryResult.RepositoryOwner.Organization.ProjectV2
+		if project.Id == "" {
+			project = queryResult.RepositoryOwner.User.ProjectV2
+		}
+		if project.Id == "" {
+			return ProjectResponse{}, fmt.Errorf("project %s/%d not found", filters.Owner,
+				filters.Number)
+		}
+
+		res.Project = project.ProjectData
+		res.StatusField = project.Field.SingleSelect
+		res.TotalCount = project.Items.TotalCount
+		for _, item := range project.Items.Nodes {
+			if item.Type != "REDACTED" && filters.matches(item) {
+				res.Items = append(res.Items, item)
+			}
+		}
+
+		pageInfo := project.Items.PageInfo
+		if !pageInfo.HasNextPage || len(res.Items) >= limit {
+			break
+		}
+		endCursor = &pageInfo.EndCursor
+	}
+	log.Info("Successfully fetched project", "owner", filters.Owner, "number", filters.Number,
+		"count", len(res.Items))
+
+	if res.StatusField.Id == "" {
+		return ProjectResponse{}, fmt.Errorf("project %s/%d has no single select field %q",
+			filters.Owner, filters.Number, statusField)
+	}
+
+	return res, nil
+}
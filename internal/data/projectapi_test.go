@@ -0,0 +1,43 @@
+package data
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseProjectFilters(t *testing.T) {
+	filters := ParseProjectFilters("project:dlvhdr/3 repo:dlvhdr/gh-dash is:open is:pr is:draft")
+	require.Equal(t, "dlvhdr", filters.Owner)
+	require.Equal(t, 3, filters.Number)
+	require.Equal(t, []string{"dlvhdr/gh-dash"}, filters.Repos)
+	require.Equal(t, []string{ProjectItemPullRequest, ProjectItemDraftIssue}, filters.Types)
+	require.True(t, *filters.Open)
+
+	filters = ParseProjectFilters("project:dlvhdr is:closed")
+	require.Empty(t, filters.Owner, "the project number is required")
+	require.False(t, *filters.Open)
+}
+
+func TestProjectFiltersMatch(t *testing.T) {
+	pr := ProjectItem{Type: ProjectItemPullRequest}
+	pr.Content.PullRequest.State = "MERGED"
+	pr.Content.PullRequest.Repository.NameWithOwner = "dlvhdr/gh-dash"
+	draft := ProjectItem{Type: ProjectItemDraftIssue}
+
+	filters := ParseProjectFilters("project:dlvhdr/3 is:closed")
+	require.True(t, filters.matches(pr))
+	require.False(t, filters.matches(draft), "draft issues are open")
+
+	filters = ParseProjectFilters("project:dlvhdr/3 repo:dlvhdr/gh-dash")
+	require.True(t, filters.matches(pr))
+	require.False(t, filters.matches(draft), "draft issues aren't in a repo")
+
+	filters = ParseProjectFilters("project:dlvhdr/3 is:issue")
+	require.False(t, filters.matches(pr))
+}
+
+func TestFetchProjectRequiresProject(t *testing.T) {
+	_, err := FetchProject("repo:dlvhdr/gh-dash", DefaultProjectStatusField, 100)
+	require.ErrorIs(t, err, errNoProject)
+}
@@ -0,0 +1,342 @@
+package data
+
+import (
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"charm.land/log/v2"
+	gh "github.com/cli/go-gh/v2/pkg/api"
+	"github.com/shurcooL/githubv4"
+)
+
+// Field data types of a project that can be edited from the dashboard
+const (
+	ProjectFieldSingleSelect = "SINGLE_SELECT"
+	ProjectFieldIteration    = "ITERATION"
+	ProjectFieldText         = "TEXT"
+	ProjectFieldNumber       = "NUMBER"
+	ProjectFieldDate         = "DATE"
+)
+
+// Types of the field values of a project item
+const (
+	projectSingleSelectValue = "ProjectV2ItemFieldSingleSelectValue"
+	projectIterationValue    = "ProjectV2ItemFieldIterationValue"
+	projectTextValue         = "ProjectV2ItemFieldTextValue"
+	projectNumberValue       = "ProjectV2ItemFieldNumberValue"
+	projectDateValue         = "ProjectV2ItemFieldDateValue"
+)
+
+// Project items need the read:project scope, which isn't granted to gh by default, so they're
+// only fetched with the searches when a layout shows project fields. The sidebar fetches them on
+// their own otherwise.
+var fetchProjectItems bool
+
+// SetFetchProjectItems sets whether issues and PRs are fetched with the project items they're in.
+func SetFetchProjectItems(enabled bool) {
+	fetchProjectItems = enabled
+}
+
+// IsFetchingProjectItems reports whether issues and PRs are fetched with their project items.
+func IsFetchingProjectItems() bool {
+	return fetchProjectItems
+}
+
+// ProjectItems are the items of the GitHub Projects an issue or PR was added to.
+type ProjectItems struct {
+	Nodes []ProjectItemFields
+}
+
+type ProjectItemFields struct {
+	Id      string
+	Project struct {
+		Id    string
+		Title string
+	}
+	FieldValues struct {
+		Nodes []ProjectFieldValue
+	} `graphql:"fieldValues(first: 20)"`
+}
+
+type projectFieldName struct {
+	Common struct {
+		Name string
+	} `graphql:"... on ProjectV2FieldCommon"`
+}
+
+// ProjectFieldValue is the value of a field of a project item. Only the fragment matching its
+// Typename is set.
+type ProjectFieldValue struct {
+	Typename     string `graphql:"__typename"`
+	SingleSelect struct {
+		Name  string
+		Field projectFieldName
+	} `graphql:"... on ProjectV2ItemFieldSingleSelectValue"`
+	Iteration struct {
+		Title string
+		Field projectFieldName
+	} `graphql:"... on ProjectV2ItemFieldIterationValue"`
+	Text struct {
+		Text  string
+		Field projectFieldName
+	} `graphql:"... on ProjectV2ItemFieldTextValue"`
+	Number struct {
+		Number float64
+		Field  projectFieldName
+	} `graphql:"... on ProjectV2ItemFieldNumberValue"`
+	Date struct {
+		Date  string
+		Field projectFieldName
+	} `graphql:"... on ProjectV2ItemFieldDateValue"`
+}
+
+// GetField returns the name of the field of the value, or "" for fields that aren't shown like
+// labels or assignees.
+func (v ProjectFieldValue) GetField() string {
+	switch v.Typename {
+	case projectSingleSelectValue:
+		return v.SingleSelect.Field.Common.Name
+	case projectIterationValue:
+		return v.Iteration.Field.Common.Name
+	case projectTextValue:
+		return v.Text.Field.Common.Name
+	case projectNumberValue:
+		return v.Number.Field.Common.Name
+	case projectDateValue:
+		return v.Date.Field.Common.Name
+	}
+	return ""
+}
+
+func (v ProjectFieldValue) String() string {
+	switch v.Typename {
+	case projectSingleSelectValue:
+		return v.SingleSelect.Name
+	case projectIterationValue:
+		return v.Iteration.Title
+	case projectTextValue:
+		return v.Text.Text
+	case projectNumberValue:
+		return strconv.FormatFloat(v.Number.Number, 'f', -1, 64)
+	case projectDateValue:
+		return v.Date.Date
+	}
+	return ""
+}
+
+func (v *ProjectFieldValue) set(value string) {
+	switch v.Typename {
+	case projectSingleSelectValue:
+		v.SingleSelect.Name = value
+	case projectIterationValue:
+		v.Iteration.Title = value
+	case projectNumberValue:
+		if n, err := strconv.ParseFloat(value, 64); err == nil {
+			v.Number.Number = n
+			break
+		}
+		v.Typename = projectTextValue
+		v.Text.Text = value
+	case projectDateValue:
+		v.Date.Date = value
+	default:
+		v.Typename = projectTextValue
+		v.Text.Text = value
+	}
+}
+
+func newProjectFieldValue(field string, value string) ProjectFieldValue {
+	v := ProjectFieldValue{Typename: projectTextValue}
+	v.Text.Text = value
+	v.Text.Field.Common.Name = field
+	return v
+}
+
+// FieldValue returns the values of the field in all the projects of the item, without
+// duplicates.
+func (items ProjectItems) FieldValue(field string) string {
+	var values []string
+	for _, item := range items.Nodes {
+		for _, v := range item.FieldValues.Nodes {
+			if strings.EqualFold(v.GetField(), field) && !slices.Contains(values, v.String()) {
+				values = append(values, v.String())
+			}
+		}
+	}
+	return strings.Join(values, ", ")
+}
+
+// SetFieldValue sets the value of the field of the project item with the given id, or clears it
+// when value is empty.
+func (items *ProjectItems) SetFieldValue(itemId string, field string, value string) {
+	for i := range items.Nodes {
+		item := &items.Nodes[i]
+		if item.Id != itemId {
+			continue
+		}
+		values := item.FieldValues.Nodes
+		idx := slices.IndexFunc(values, func(v ProjectFieldValue) bool {
+			return strings.EqualFold(v.GetField(), field)
+		})
+		switch {
+		case value == "" && idx >= 0:
+			values = slices.Delete(values, idx, idx+1)
+		case value == "":
+		case idx >= 0:
+			values[idx].set(value)
+		default:
+			values = append(values, newProjectFieldValue(field, value))
+		}
+		item.FieldValues.Nodes = values
+	}
+}
+
+// ProjectField is a field of a project, with the options of single select and iteration fields.
+type ProjectField struct {
+	Common struct {
+		Id       string
+		Name     string
+		DataType string
+	} `graphql:"... on ProjectV2FieldCommon"`
+	SingleSelect struct {
+		Options []ProjectFieldOption
+	} `graphql:"... on ProjectV2SingleSelectField"`
+	Iteration struct {
+		Configuration struct {
+			Iterations []ProjectIteration
+		}
+	} `graphql:"... on ProjectV2IterationField"`
+}
+
+type ProjectIteration struct {
+	Id        string
+	Title     string
+	StartDate string
+}
+
+// IsEditable reports whether the field holds a value set on the item, unlike the fields mirroring
+// the issue or PR like its title or labels.
+func (field ProjectField) IsEditable() bool {
+	switch field.Common.DataType {
+	case ProjectFieldSingleSelect, ProjectFieldIteration, ProjectFieldText, ProjectFieldNumber,
+		ProjectFieldDate:
+		return true
+	}
+	return false
+}
+
+// EditableProjectItem is a project item of an issue or PR with the fields of its project.
+type EditableProjectItem struct {
+	Id      string
+	Project struct {
+		Id     string
+		Title  string
+		Fields struct {
+			Nodes []ProjectField
+		} `graphql:"fields(first: 50)"`
+	}
+}
+
+// FetchEditableProjectItems fetches the project items of the issue or PR with the given url,
+// with the fields that can be set on them.
+func FetchEditableProjectItems(itemUrl string) ([]EditableProjectItem, error) {
+	var err error
+	if client == nil {
+		client, err = gh.DefaultGraphQLClient()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	type projectItems struct {
+		Nodes []EditableProjectItem
+	}
+	var queryResult struct {
+		Resource struct {
+			Issue struct {
+				ProjectItems projectItems `graphql:"projectItems(first: 10)"`
+			} `graphql:"... on Issue"`
+			PullRequest struct {
+				ProjectItems projectItems `graphql:"projectItems(first: 10)"`
+			} `graphql:"... on PullRequest"`
+		} `graphql:"resource(url: $url)"`
+	}
+	parsedUrl, err := url.Parse(itemUrl)
+	if err != nil {
+		return nil, err
+	}
+	variables := map[string]any{
+		"url": githubv4.URI{URL: parsedUrl},
+	}
+	log.Debug("Fetching project items", "url", itemUrl)
+	err = client.Query("FetchProjectItems", &queryResult, variables)
+	if err != nil {
+		return nil, err
+	}
+	items := queryResult.Resource.PullRequest.ProjectItems.Nodes
+	if len(items) == 0 {
+		items = queryResult.Resource.Issue.ProjectItems.Nodes
+	}
+	log.Info("Successfully fetched project items", "url", itemUrl, "count", len(items))
+
+	return items, nil
+}
+
+// FetchProjectItems fetches the project items of the issue or PR with the given url, for the
+// sidebar to show them when the search didn't fetch them.
+func FetchProjectItems(itemUrl string) (ProjectItems, error) {
+	var err error
+	if client == nil {
+		client, err = gh.DefaultGraphQLClient()
+		if err != nil {
+			return ProjectItems{}, err
+		}
+	}
+
+	var queryResult struct {
+		Resource struct {
+			Issue struct {
+				ProjectItems ProjectItems `graphql:"projectItems(first: 5)"`
+			} `graphql:"... on Issue"`
+			PullRequest struct {
+				ProjectItems ProjectItems `graphql:"projectItems(first: 5)"`
+			} `graphql:"... on PullRequest"`
+		} `graphql:"resource(url: $url)"`
+	}
+	parsedUrl, err := url.Parse(itemUrl)
+	if err != nil {
+		return ProjectItems{}, err
+	}
+	variables := map[string]any{
+		"url": githubv4.URI{URL: parsedUrl},
+	}
+	log.Debug("Fetching project items", "url", itemUrl)
+	err = client.Query("FetchItemProjectItems", &queryResult, variables)
+	if err != nil {
+		return ProjectItems{}, err
+	}
+	items := queryResult.Resource.PullRequest.ProjectItems
+	if len(items.Nodes) == 0 {
+		items = queryResult.Resource.Issue.ProjectItems
+	}
+	log.Info("Successfully fetched project items", "url", itemUrl, "count", len(items.Nodes))
+
+	return items, nil
+}
+
+// withProjectItems adds the project items of the item with the given url to items, when they
+// weren't fetched along with the item. Project items need a scope gh may not have, so failing to
+// fetch them only leaves them out.
+func withProjectItems(itemUrl string, items *ProjectItems) {
+	if fetchProjectItems {
+		return
+	}
+	fetched, err := FetchProjectItems(itemUrl)
+	if err != nil {
+		log.Debug("Failed fetching project items", "url", itemUrl, "err", err)
+		return
+	}
+	*items = fetched
+}
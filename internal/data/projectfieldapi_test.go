@@ -0,0 +1,56 @@
+package data
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newProjectItem(id string, values ...ProjectFieldValue) ProjectItemFields {
+	item := ProjectItemFields{Id: id}
+	item.FieldValues.Nodes = values
+	return item
+}
+
+func singleSelectValue(field string, name string) ProjectFieldValue {
+	v := ProjectFieldValue{Typename: projectSingleSelectValue}
+	v.SingleSelect.Name = name
+	v.SingleSelect.Field.Common.Name = field
+	return v
+}
+
+func TestProjectItemsFieldValue(t *testing.T) {
+	estimate := ProjectFieldValue{Typename: projectNumberValue}
+	estimate.Number.Number = 3
+	estimate.Number.Field.Common.Name = "Estimate"
+	items := ProjectItems{Nodes: []ProjectItemFields{
+		newProjectItem("PVTI_1", singleSelectValue("Status", "Todo"), estimate),
+		newProjectItem("PVTI_2", singleSelectValue("Status", "Todo")),
+		newProjectItem("PVTI_3", singleSelectValue("Status", "Done")),
+	}}
+
+	require.Equal(t, "Todo, Done", items.FieldValue("status"))
+	require.Equal(t, "3", items.FieldValue("Estimate"))
+	require.Empty(t, items.FieldValue("Iteration"))
+	require.Empty(t, ProjectFieldValue{Typename: "ProjectV2ItemFieldLabelValue"}.GetField())
+}
+
+func TestProjectItemsSetFieldValue(t *testing.T) {
+	items := ProjectItems{Nodes: []ProjectItemFields{
+		newProjectItem("PVTI_1", singleSelectValue("Status", "Todo")),
+		newProjectItem("PVTI_2", singleSelectValue("Status", "Todo")),
+	}}
+
+	items.SetFieldValue("PVTI_1", "Status", "Done")
+	require.Equal(t, "Done", items.Nodes[0].FieldValues.Nodes[0].String())
+	require.Equal(t, "Todo", items.Nodes[1].FieldValues.Nodes[0].String(),
+		"other items keep their value")
+
+	items.SetFieldValue("PVTI_1", "Notes", "needs design")
+	require.Equal(t, "needs design", items.FieldValue("Notes"))
+
+	items.SetFieldValue("PVTI_1", "Status", "")
+	items.SetFieldValue("PVTI_2", "Status", "")
+	require.Empty(t, items.FieldValue("Status"))
+	require.Len(t, items.Nodes[0].FieldValues.Nodes, 1)
+}
@@ -0,0 +1,75 @@
+package data
+
+import "slices"
+
+// ReactionContents are the emoji that can be reacted with, in the order GitHub shows them.
+var ReactionContents = []string{
+	"THUMBS_UP",
+	"THUMBS_DOWN",
+	"LAUGH",
+	"HOORAY",
+	"CONFUSED",
+	"HEART",
+	"ROCKET",
+	"EYES",
+}
+
+var reactionEmoji = map[string]string{
+	"THUMBS_UP":   "👍",
+	"THUMBS_DOWN": "👎",
+	"LAUGH":       "😄",
+	"HOORAY":      "🎉",
+	"CONFUSED":    "😕",
+	"HEART":       "❤️",
+	"ROCKET":      "🚀",
+	"EYES":        "👀",
+}
+
+// ReactionEmoji returns the emoji of a reaction content, e.g. 👍 for THUMBS_UP.
+func ReactionEmoji(content string) string {
+	return reactionEmoji[content]
+}
+
+// ReactionGroup is the reactions with the same emoji on a PR, issue or comment.
+type ReactionGroup struct {
+	Content  string
+	Reactors struct {
+		TotalCount int
+	}
+	ViewerHasReacted bool
+}
+
+type ReactionGroups []ReactionGroup
+
+// Get returns the group of the emoji, which is empty when nobody reacted with it.
+func (groups ReactionGroups) Get(content string) ReactionGroup {
+	i := slices.IndexFunc(groups, func(g ReactionGroup) bool { return g.Content == content })
+	if i < 0 {
+		return ReactionGroup{Content: content}
+	}
+	return groups[i]
+}
+
+// WithReaction returns the groups after the viewer added or removed their reaction with the
+// emoji. Adding a reaction the viewer already has, or removing one they don't, changes nothing.
+func (groups ReactionGroups) WithReaction(content string, reacted bool) ReactionGroups {
+	group := groups.Get(content)
+	if group.ViewerHasReacted == reacted {
+		return groups
+	}
+
+	group.ViewerHasReacted = reacted
+	if reacted {
+		group.Reactors.TotalCount++
+	} else {
+		group.Reactors.TotalCount = max(group.Reactors.TotalCount-1, 0)
+	}
+
+	updated := slices.Clone(groups)
+	i := slices.IndexFunc(updated, func(g ReactionGroup) bool { return g.Content == content })
+	if i < 0 {
+		return append(updated, group)
+	}
+	updated[i] = group
+	return updated
+}
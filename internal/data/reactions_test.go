@@ -0,0 +1,27 @@
+package data
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReactionGroups_WithReaction(t *testing.T) {
+	var groups ReactionGroups
+	groups = groups.WithReaction("HEART", true)
+	require.Equal(t, 1, groups.Get("HEART").Reactors.TotalCount)
+	require.True(t, groups.Get("HEART").ViewerHasReacted)
+
+	require.Equal(t, groups, groups.WithReaction("HEART", true),
+		"reacting twice with the same emoji counts once")
+
+	thumbsUp := ReactionGroup{Content: "THUMBS_UP", ViewerHasReacted: true}
+	thumbsUp.Reactors.TotalCount = 3
+	groups = ReactionGroups{thumbsUp}
+	removed := groups.WithReaction("THUMBS_UP", false)
+	require.Equal(t, 2, removed.Get("THUMBS_UP").Reactors.TotalCount)
+	require.False(t, removed.Get("THUMBS_UP").ViewerHasReacted)
+	require.Equal(t, 3, groups[0].Reactors.TotalCount, "the groups are updated in a copy")
+
+	require.Equal(t, ReactionGroup{Content: "EYES"}, removed.Get("EYES"))
+}
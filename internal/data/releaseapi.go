@@ -0,0 +1,332 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"charm.land/log/v2"
+	gh "github.com/cli/go-gh/v2/pkg/api"
+	graphql "github.com/cli/shurcooL-graphql"
+)
+
+type ReleaseData struct {
+	Id           string
+	Name         string
+	TagName      string
+	Description  string
+	Url          string
+	IsDraft      bool
+	IsPrerelease bool
+	IsLatest     bool
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	// PublishedAt is nil for drafts
+	PublishedAt *time.Time
+	Author      struct {
+		Login string
+	}
+	Repository    Repository
+	ReleaseAssets ReleaseAssets `graphql:"releaseAssets(first: 30)"`
+}
+
+type ReleaseAssets struct {
+	Nodes      []ReleaseAsset
+	TotalCount int
+}
+
+type ReleaseAsset struct {
+	Name          string
+	Size          int
+	DownloadCount int
+	DownloadUrl   string
+}
+
+// GetTitle returns the name of the release, which defaults to its tag on GitHub.
+func (data ReleaseData) GetTitle() string {
+	if data.Name == "" {
+		return data.TagName
+	}
+	return data.Name
+}
+
+func (data ReleaseData) GetRepoNameWithOwner() string {
+	return data.Repository.NameWithOwner
+}
+
+func (data ReleaseData) GetRepoNameAndOwner() (owner, repoName string) {
+	return data.Repository.Owner.Login, data.Repository.Name
+}
+
+// GetNumber returns 0 as releases are identified by their tag.
+func (data ReleaseData) GetNumber() int {
+	return 0
+}
+
+func (data ReleaseData) GetUrl() string {
+	return data.Url
+}
+
+func (data ReleaseData) GetUpdatedAt() time.Time {
+	return data.UpdatedAt
+}
+
+// GetPublishedAt returns when the release was published, or when it was created for drafts.
+func (data ReleaseData) GetPublishedAt() time.Time {
+	if data.PublishedAt == nil {
+		return data.CreatedAt
+	}
+	return *data.PublishedAt
+}
+
+var errNoReleaseRepos = errors.New(
+	`releases are listed per repository, add a "repo:owner/name" filter`)
+
+// ReleaseFilters are the parsed filters of a releases section. Releases can't be searched, so
+// the filters only support a few qualifiers.
+type ReleaseFilters struct {
+	Repos []string
+	// Draft and Prerelease are nil when the filters don't mention them
+	Draft      *bool
+	Prerelease *bool
+}
+
+// ParseReleaseFilters parses the "repo:", "is:draft" and "is:prerelease" qualifiers, which can
+// be negated with "-". Other tokens are ignored.
+func ParseReleaseFilters(query string) ReleaseFilters {
+	var filters ReleaseFilters
+	for token := range strings.FieldsSeq(query) {
+		negated := strings.HasPrefix(token, "-")
+		value := !negated
+		switch strings.TrimPrefix(token, "-") {
+		case "is:draft":
+			filters.Draft = &value
+		case "is:prerelease":
+			filters.Prerelease = &value
+		default:
+			if repo, ok := strings.CutPrefix(token, "repo:"); ok && !negated && repo != "" &&
+				!slices.Contains(filters.Repos, repo) {
+				filters.Repos = append(filters.Repos, repo)
+			}
+		}
+	}
+	return filters
+}
+
+func (filters ReleaseFilters) matches(release ReleaseData) bool {
+	if filters.Draft != nil && release.IsDraft != *filters.Draft {
+		return false
+	}
+	if filters.Prerelease != nil && release.IsPrerelease != *filters.Prerelease {
+		return false
+	}
+	return true
+}
+
+type ReleasesResponse struct {
+	Releases   []ReleaseData
+	TotalCount int
+	PageInfo   PageInfo
+}
+
+// FetchReleases fetches the releases of the repos in the query, newest first. Sections with a
+// single repo are paginated, otherwise the first limit releases of each repo are merged.
+func FetchReleases(query string, limit int, pageInfo *PageInfo) (ReleasesResponse, error) {
+	filters := ParseReleaseFilters(query)
+	if len(filters.Repos) == 0 {
+		return ReleasesResponse{}, errNoReleaseRepos
+	}
+
+	if len(filters.Repos) == 1 {
+		res, err := fetchRepoReleases(filters.Repos[0], limit, pageInfo)
+		if err != nil {
+			return ReleasesResponse{}, err
+		}
+		res.Releases = slices.DeleteFunc(res.Releases, func(release ReleaseData) bool {
+			return !filters.matches(release)
+		})
+		return res, nil
+	}
+
+	var merged ReleasesResponse
+	for _, repo := range filters.Repos {
+		res, err := fetchRepoReleases(repo, limit, nil)
+		if err != nil {
+			return ReleasesResponse{}, err
+		}
+		for _, release := range res.Releases {
+			if filters.matches(release) {
+				merged.Releases = append(merged.Releases, release)
+			}
+		}
+		merged.TotalCount += res.TotalCount
+	}
+	slices.SortStableFunc(merged.Releases, func(a, b ReleaseData) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+	return merged, nil
+}
+
+func fetchRepoReleases(repo string, limit int, pageInfo *PageInfo) (ReleasesResponse, error) {
+	owner, name, ok := strings.Cut(repo, "/")
+	if !ok {
+		return ReleasesResponse{}, fmt.Errorf("invalid repo %q, expected owner/name", repo)
+	}
+
+	var err error
+	if client == nil {
+		client, err = gh.DefaultGraphQLClient()
+	}
+
+	if err != nil {
+		return ReleasesResponse{}, err
+	}
+
+	var queryResult struct {
+		Repository struct {
+			Releases struct {
+				Nodes      []ReleaseData
+				TotalCount int
+				PageInfo   PageInfo
+			} `graphql:"releases(first: $limit, after: $endCursor, orderBy: {field: CREATED_AT, direction: DESC})"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+	var endCursor *string
+	if pageInfo != nil {
+		endCursor = &pageInfo.EndCursor
+	}
+	variables := map[string]any{
+		"owner":     graphql.String(owner),
+		"name":      graphql.String(name),
+		"limit":     graphql.Int(limit),
+		"endCursor": (*graphql.String)(endCursor),
+	}
+	log.Debug("Fetching releases", "repo", repo, "limit", limit, "endCursor", endCursor)
+	err = client.Query("RepoReleases", &queryResult, variables)
+	if err != nil {
+		return ReleasesResponse{}, err
+	}
+	releases := queryResult.Repository.Releases
+	log.Info("Successfully fetched releases", "repo", repo, "count", releases.TotalCount)
+
+	return ReleasesResponse{
+		Releases:   releases.Nodes,
+		TotalCount: releases.TotalCount,
+		PageInfo:   releases.PageInfo,
+	}, nil
+}
+
+type ReleaseCommit struct {
+	Sha    string `json:"sha"`
+	Commit struct {
+		Message string `json:"message"`
+		Author  struct {
+			Name string `json:"name"`
+		} `json:"author"`
+	} `json:"commit"`
+	Author *struct {
+		Login string `json:"login"`
+	} `json:"author"`
+}
+
+// GetAuthor returns the GitHub login of the commit author, or the git author name for commits
+// that aren't linked to a GitHub user.
+func (commit ReleaseCommit) GetAuthor() string {
+	if commit.Author != nil && commit.Author.Login != "" {
+		return commit.Author.Login
+	}
+	return commit.Commit.Author.Name
+}
+
+// GetHeadline returns the first line of the commit message.
+func (commit ReleaseCommit) GetHeadline() string {
+	headline, _, _ := strings.Cut(commit.Commit.Message, "\n")
+	return headline
+}
+
+type ReleaseCommits struct {
+	// PreviousTagName is empty for the first release of a repo, which has no commits listed
+	PreviousTagName string
+	Commits         []ReleaseCommit
+	TotalCommits    int
+}
+
+// FetchReleaseCommits fetches the commits between the tag of the previous published release and
+// the given tag. GitHub returns at most 250 of them, oldest first.
+func FetchReleaseCommits(repo string, tagName string) (ReleaseCommits, error) {
+	previous, err := fetchPreviousReleaseTag(repo, tagName)
+	if err != nil || previous == "" {
+		return ReleaseCommits{}, err
+	}
+
+	restClient, err := getRESTClient()
+	if err != nil {
+		return ReleaseCommits{}, err
+	}
+
+	path := fmt.Sprintf("repos/%s/compare/%s...%s?per_page=250", repo,
+		url.PathEscape(previous), url.PathEscape(tagName))
+	log.Debug("Fetching release commits", "repo", repo, "from", previous, "to", tagName)
+	var comparison struct {
+		TotalCommits int             `json:"total_commits"`
+		Commits      []ReleaseCommit `json:"commits"`
+	}
+	if err := restClient.Get(path, &comparison); err != nil {
+		return ReleaseCommits{}, err
+	}
+
+	return ReleaseCommits{
+		PreviousTagName: previous,
+		Commits:         comparison.Commits,
+		TotalCommits:    comparison.TotalCommits,
+	}, nil
+}
+
+// fetchPreviousReleaseTag returns the tag of the newest published release created before the
+// release with the given tag, looking at the last 100 releases.
+func fetchPreviousReleaseTag(repo string, tagName string) (string, error) {
+	owner, name, ok := strings.Cut(repo, "/")
+	if !ok {
+		return "", fmt.Errorf("invalid repo %q, expected owner/name", repo)
+	}
+
+	var err error
+	if client == nil {
+		client, err = gh.DefaultGraphQLClient()
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	var queryResult struct {
+		Repository struct {
+			Releases struct {
+				Nodes []struct {
+					TagName string
+					IsDraft bool
+				}
+			} `graphql:"releases(first: 100, orderBy: {field: CREATED_AT, direction: DESC})"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+	variables := map[string]any{
+		"owner": graphql.String(owner),
+		"name":  graphql.String(name),
+	}
+	err = client.Query("RepoReleaseTags", &queryResult, variables)
+	if err != nil {
+		return "", err
+	}
+
+	found := false
+	for _, release := range queryResult.Repository.Releases.Nodes {
+		if found && !release.IsDraft {
+			return release.TagName, nil
+		}
+		found = found || release.TagName == tagName
+	}
+	return "", nil
+}
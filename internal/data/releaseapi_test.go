@@ -0,0 +1,29 @@
+package data
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseReleaseFilters(t *testing.T) {
+	filters := ParseReleaseFilters(
+		"repo:dlvhdr/gh-dash repo:cli/cli -is:prerelease repo:dlvhdr/gh-dash -repo:a/b v4")
+	require.Equal(t, []string{"dlvhdr/gh-dash", "cli/cli"}, filters.Repos)
+	require.Nil(t, filters.Draft)
+	require.NotNil(t, filters.Prerelease)
+	require.False(t, *filters.Prerelease)
+
+	require.True(t, filters.matches(ReleaseData{IsDraft: true}))
+	require.False(t, filters.matches(ReleaseData{IsPrerelease: true}))
+
+	filters = ParseReleaseFilters("is:draft")
+	require.Empty(t, filters.Repos)
+	require.True(t, filters.matches(ReleaseData{IsDraft: true}))
+	require.False(t, filters.matches(ReleaseData{}))
+}
+
+func TestFetchReleasesRequiresRepo(t *testing.T) {
+	_, err := FetchReleases("is:draft", 20, nil)
+	require.ErrorIs(t, err, errNoReleaseRepos)
+}
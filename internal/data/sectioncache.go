@@ -0,0 +1,220 @@
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"charm.land/log/v2"
+)
+
+// sectionCacheMaxAge bounds how long an entry may live on disk without being
+// refreshed. Entries older than this are dropped on load.
+const sectionCacheMaxAge = 7 * 24 * time.Hour
+
+// SectionCacheEntry is the last known first page of results for a section,
+// stored as raw JSON so that every section type can share the same file.
+type SectionCacheEntry struct {
+	FetchedAt time.Time       `json:"fetchedAt"`
+	Payload   json.RawMessage `json:"payload"`
+}
+
+// SectionCache persists section search results keyed by the resolved section
+// query. On launch the cached results are rendered right away (marked as
+// stale) while the real fetch runs in the background and replaces them.
+type SectionCache struct {
+	mu      sync.RWMutex
+	entries map[string]SectionCacheEntry
+	// saveMu serializes saves, so a snapshot taken earlier never replaces a
+	// newer one on disk.
+	saveMu   sync.Mutex
+	filePath string
+}
+
+// SectionCacheKey builds the cache key for a section of the given type
+// (e.g. "pr", "issue", "notification") with its resolved query and limit.
+func SectionCacheKey(sectionType, query string, limit int) string {
+	return fmt.Sprintf("%s|%d|%s", sectionType, limit, query)
+}
+
+func newSectionCache(filename string) *SectionCache {
+	cache := &SectionCache{
+		entries: make(map[string]SectionCacheEntry),
+	}
+	filePath, err := getStateFilePath(filename)
+	if err != nil {
+		log.Error("Failed to get state file path for section cache", "err", err)
+	}
+	cache.filePath = filePath
+	if err := cache.load(); err != nil {
+		log.Error("Failed to load section cache", "err", err)
+	}
+	return cache
+}
+
+func (c *SectionCache) load() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.filePath == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(c.filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	if err := json.Unmarshal(data, &c.entries); err != nil {
+		c.entries = make(map[string]SectionCacheEntry)
+		return err
+	}
+	if c.entries == nil {
+		c.entries = make(map[string]SectionCacheEntry)
+	}
+
+	cutoff := time.Now().Add(-sectionCacheMaxAge)
+	for key, entry := range c.entries {
+		if entry.FetchedAt.Before(cutoff) {
+			delete(c.entries, key)
+		}
+	}
+	log.Debug("Loaded section cache", "count", len(c.entries))
+	return nil
+}
+
+func (c *SectionCache) save() error {
+	c.saveMu.Lock()
+	defer c.saveMu.Unlock()
+
+	if c.filePath == "" {
+		return nil
+	}
+
+	c.mu.RLock()
+	data, err := json.Marshal(c.entries)
+	count := len(c.entries)
+	c.mu.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(c.filePath)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	tmpFile, err := os.CreateTemp(dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	tmpPath := tmpFile.Name()
+	if _, err := tmpFile.Write(data); err != nil {
+		tmpFile.Close()
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := os.Rename(tmpPath, c.filePath); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+
+	log.Debug("Saved section cache", "count", count)
+	return nil
+}
+
+// Get decodes the cached results for key into v. It returns the time the
+// results were fetched and whether a usable entry was found. Entries older
+// than maxAge are treated as missing; a maxAge of 0 disables the check.
+func (c *SectionCache) Get(key string, maxAge time.Duration, v any) (time.Time, bool) {
+	c.mu.RLock()
+	entry, ok := c.entries[key]
+	c.mu.RUnlock()
+	if !ok {
+		return time.Time{}, false
+	}
+
+	if maxAge > 0 && time.Since(entry.FetchedAt) > maxAge {
+		return time.Time{}, false
+	}
+
+	if err := json.Unmarshal(entry.Payload, v); err != nil {
+		log.Warn("Failed to decode section cache entry", "key", key, "err", err)
+		return time.Time{}, false
+	}
+	return entry.FetchedAt, true
+}
+
+// Put stores v as the latest results for key and persists the cache in the
+// background.
+func (c *SectionCache) Put(key string, v any) {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		log.Error("Failed to encode section cache entry", "key", key, "err", err)
+		return
+	}
+
+	c.mu.Lock()
+	c.entries[key] = SectionCacheEntry{FetchedAt: time.Now(), Payload: payload}
+	c.mu.Unlock()
+	c.saveInBackground()
+}
+
+// Invalidate removes the cached results for key.
+func (c *SectionCache) Invalidate(key string) {
+	c.mu.Lock()
+	_, ok := c.entries[key]
+	delete(c.entries, key)
+	c.mu.Unlock()
+	if ok {
+		c.saveInBackground()
+	}
+}
+
+// Clear removes all cached results.
+func (c *SectionCache) Clear() {
+	c.mu.Lock()
+	c.entries = make(map[string]SectionCacheEntry)
+	c.mu.Unlock()
+	c.saveInBackground()
+}
+
+// saveInBackground persists the cache without blocking the caller, logging the error the
+// caller has no way to handle.
+func (c *SectionCache) saveInBackground() {
+	go func() {
+		if err := c.save(); err != nil {
+			log.Error("Failed to save section cache", "err", err)
+		}
+	}()
+}
+
+// Flush forces an immediate synchronous save.
+func (c *SectionCache) Flush() error {
+	return c.save()
+}
+
+// Singleton
+
+var (
+	sectionCache     *SectionCache
+	sectionCacheOnce sync.Once
+)
+
+// GetSectionCache returns the singleton section cache.
+func GetSectionCache() *SectionCache {
+	sectionCacheOnce.Do(func() {
+		sectionCache = newSectionCache("sections_cache.json")
+	})
+	return sectionCache
+}
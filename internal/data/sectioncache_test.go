@@ -0,0 +1,158 @@
+package data
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSectionCache(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "gh-dash-sectioncache-test")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	key := SectionCacheKey("pr", "is:open author:@me", 20)
+	res := PullRequestsResponse{
+		Prs: []PullRequestData{
+			{Number: 1, Title: "First", UpdatedAt: time.Now().UTC().Truncate(time.Second)},
+			{Number: 2, Title: "Second"},
+		},
+		TotalCount: 2,
+	}
+
+	t.Run("Put and Get round-trip", func(t *testing.T) {
+		cache := NewSectionCacheForTesting(filepath.Join(tempDir, "test1.json"))
+		cache.Put(key, res)
+
+		var got PullRequestsResponse
+		fetchedAt, ok := cache.Get(key, 0, &got)
+		if !ok {
+			t.Fatal("Expected cached entry to be found")
+		}
+		if fetchedAt.IsZero() {
+			t.Error("Expected fetchedAt to be set")
+		}
+		if got.TotalCount != 2 || len(got.Prs) != 2 {
+			t.Fatalf("Expected 2 PRs, got %d (total %d)", len(got.Prs), got.TotalCount)
+		}
+		if got.Prs[0].Title != "First" || !got.Prs[0].UpdatedAt.Equal(res.Prs[0].UpdatedAt) {
+			t.Errorf("Unexpected first PR: %+v", got.Prs[0])
+		}
+	})
+
+	t.Run("Get for unknown key", func(t *testing.T) {
+		cache := NewSectionCacheForTesting(filepath.Join(tempDir, "test2.json"))
+
+		var got PullRequestsResponse
+		if _, ok := cache.Get(key, 0, &got); ok {
+			t.Error("Should NOT find an entry that was never stored")
+		}
+	})
+
+	t.Run("Keys differ by type, query and limit", func(t *testing.T) {
+		keys := map[string]bool{
+			SectionCacheKey("pr", "is:open", 20):    true,
+			SectionCacheKey("issue", "is:open", 20): true,
+			SectionCacheKey("pr", "is:closed", 20):  true,
+			SectionCacheKey("pr", "is:open", 30):    true,
+		}
+		if len(keys) != 4 {
+			t.Errorf("Expected 4 distinct keys, got %d", len(keys))
+		}
+	})
+
+	t.Run("Entries older than maxAge are ignored", func(t *testing.T) {
+		cache := NewSectionCacheForTesting(filepath.Join(tempDir, "test3.json"))
+		payload, _ := json.Marshal(res)
+		cache.entries[key] = SectionCacheEntry{
+			FetchedAt: time.Now().Add(-2 * time.Hour),
+			Payload:   payload,
+		}
+
+		var got PullRequestsResponse
+		if _, ok := cache.Get(key, time.Hour, &got); ok {
+			t.Error("Should NOT use an entry older than maxAge")
+		}
+		if _, ok := cache.Get(key, 0, &got); !ok {
+			t.Error("Should use the entry when maxAge is disabled")
+		}
+	})
+
+	t.Run("Invalidate and Clear", func(t *testing.T) {
+		cache := NewSectionCacheForTesting(filepath.Join(tempDir, "test4.json"))
+		otherKey := SectionCacheKey("issue", "is:open", 20)
+		cache.Put(key, res)
+		cache.Put(otherKey, res)
+
+		var got PullRequestsResponse
+		cache.Invalidate(key)
+		if _, ok := cache.Get(key, 0, &got); ok {
+			t.Error("Should NOT find an entry after Invalidate")
+		}
+		if _, ok := cache.Get(otherKey, 0, &got); !ok {
+			t.Error("Invalidate should only remove the given key")
+		}
+
+		cache.Clear()
+		if _, ok := cache.Get(otherKey, 0, &got); ok {
+			t.Error("Should NOT find any entry after Clear")
+		}
+	})
+
+	t.Run("Persistence across instances", func(t *testing.T) {
+		filePath := filepath.Join(tempDir, "test5.json")
+		cache := NewSectionCacheForTesting(filePath)
+		cache.Put(key, res)
+		if err := cache.Flush(); err != nil {
+			t.Fatalf("Flush failed: %v", err)
+		}
+
+		reloaded := NewSectionCacheForTesting(filePath)
+		if err := reloaded.load(); err != nil {
+			t.Fatalf("load failed: %v", err)
+		}
+
+		var got PullRequestsResponse
+		if _, ok := reloaded.Get(key, 0, &got); !ok {
+			t.Fatal("Expected entry to survive a reload")
+		}
+		if len(got.Prs) != 2 {
+			t.Errorf("Expected 2 PRs after reload, got %d", len(got.Prs))
+		}
+	})
+
+	t.Run("Load drops entries past the on-disk max age", func(t *testing.T) {
+		filePath := filepath.Join(tempDir, "test6.json")
+		expired := time.Now().Add(-sectionCacheMaxAge - time.Hour)
+		entries := map[string]SectionCacheEntry{
+			"old": {FetchedAt: expired, Payload: []byte("{}")},
+			"new": {FetchedAt: time.Now(), Payload: []byte("{}")},
+		}
+		raw, _ := json.Marshal(entries)
+		if err := os.WriteFile(filePath, raw, 0o644); err != nil {
+			t.Fatalf("Failed to write cache file: %v", err)
+		}
+
+		cache := NewSectionCacheForTesting(filePath)
+		if err := cache.load(); err != nil {
+			t.Fatalf("load failed: %v", err)
+		}
+		if _, ok := cache.entries["old"]; ok {
+			t.Error("Expected old entry to be pruned on load")
+		}
+		if _, ok := cache.entries["new"]; !ok {
+			t.Error("Expected recent entry to be kept on load")
+		}
+	})
+
+	t.Run("Load ignores missing file", func(t *testing.T) {
+		cache := NewSectionCacheForTesting(filepath.Join(tempDir, "does-not-exist.json"))
+		if err := cache.load(); err != nil {
+			t.Errorf("Expected no error for missing file, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,19 @@
+package data
+
+// NewSectionCacheForTesting creates a SectionCache backed by the given file path.
+func NewSectionCacheForTesting(filePath string) *SectionCache {
+	return &SectionCache{
+		entries:  make(map[string]SectionCacheEntry),
+		filePath: filePath,
+	}
+}
+
+// OverrideSectionCacheForTesting replaces the singleton SectionCache with the
+// given cache. It returns a function that restores the original cache.
+func OverrideSectionCacheForTesting(cache *SectionCache) func() {
+	// Ensure the singleton is initialized so sync.Once has fired.
+	GetSectionCache()
+	old := sectionCache
+	sectionCache = cache
+	return func() { sectionCache = old }
+}
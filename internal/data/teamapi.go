@@ -0,0 +1,81 @@
+package data
+
+import (
+	"sync"
+
+	"charm.land/log/v2"
+	gh "github.com/cli/go-gh/v2/pkg/api"
+	graphql "github.com/cli/shurcooL-graphql"
+)
+
+var (
+	orgTeamCache = make(map[string][]Team)
+	teamCacheMu  sync.RWMutex
+)
+
+type Team struct {
+	Slug string
+	Name string
+}
+
+type OrgTeamsResponse struct {
+	RepositoryOwner struct {
+		Organization struct {
+			Teams struct {
+				Nodes []Team
+			} `graphql:"teams(first: 100)"`
+		} `graphql:"... on Organization"`
+	} `graphql:"repositoryOwner(login: $login)"`
+}
+
+func CachedOrgTeams(owner string) ([]Team, bool) {
+	teamCacheMu.RLock()
+	defer teamCacheMu.RUnlock()
+	teams, ok := orgTeamCache[owner]
+	return teams, ok
+}
+
+// FetchOrgTeams fetches the teams of the organization owning a repository. Repositories owned
+// by users have no teams.
+func FetchOrgTeams(owner string) ([]Team, error) {
+	if cachedTeams, ok := CachedOrgTeams(owner); ok {
+		return cachedTeams, nil
+	}
+
+	log.Debug("Fetching org teams", "owner", owner)
+
+	if client == nil {
+		var err error
+		client, err = gh.DefaultGraphQLClient()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var result OrgTeamsResponse
+	variables := map[string]any{
+		"login": graphql.String(owner),
+	}
+	err := client.Query("GetOrgTeams", &result, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	teams := result.RepositoryOwner.Organization.Teams.Nodes
+	if teams == nil {
+		teams = []Team{}
+	}
+
+	teamCacheMu.Lock()
+	defer teamCacheMu.Unlock()
+
+	orgTeamCache[owner] = teams
+	log.Debug("Successfully fetched org teams", "owner", owner, "len", len(teams))
+	return teams, nil
+}
+
+func ClearOrgTeamCache(owner string) {
+	teamCacheMu.Lock()
+	defer teamCacheMu.Unlock()
+	delete(orgTeamCache, owner)
+}
@@ -0,0 +1,209 @@
+package data
+
+import (
+	"time"
+)
+
+// CommentMeta holds the fields comments, reviews and review comments share that the activity
+// timeline needs to link to them and to tell what the user can do with them.
+type CommentMeta struct {
+	Url               string
+	CreatedAt         time.Time
+	IsMinimized       bool
+	ViewerDidAuthor   bool
+	ViewerCanUpdate   bool
+	ViewerCanDelete   bool
+	ViewerCanMinimize bool
+}
+
+// TimelineActor is the user, bot or app that caused a timeline event.
+type TimelineActor struct {
+	Login string
+}
+
+type ForcePushedEvent struct {
+	Actor        TimelineActor
+	CreatedAt    time.Time
+	BeforeCommit *struct {
+		AbbreviatedOid string
+	}
+	AfterCommit *struct {
+		AbbreviatedOid string
+	}
+}
+
+type LabelEvent struct {
+	Actor     TimelineActor
+	CreatedAt time.Time
+	Label     struct {
+		Name  string
+		Color string
+	}
+}
+
+type ReviewRequestedEvent struct {
+	Actor             TimelineActor
+	CreatedAt         time.Time
+	RequestedReviewer struct {
+		User      RequestedReviewerUser      `graphql:"... on User"`
+		Team      RequestedReviewerTeam      `graphql:"... on Team"`
+		Bot       RequestedReviewerBot       `graphql:"... on Bot"`
+		Mannequin RequestedReviewerMannequin `graphql:"... on Mannequin"`
+	}
+}
+
+// ReferencedSubject is the issue or PR a cross-reference was made from.
+type ReferencedSubject struct {
+	Number     int
+	Title      string
+	Url        string
+	Repository struct {
+		NameWithOwner string
+	}
+}
+
+type CrossReferencedEvent struct {
+	Actor             TimelineActor
+	CreatedAt         time.Time
+	IsCrossRepository bool
+	WillCloseTarget   bool
+	Source            struct {
+		Typename    string            `graphql:"__typename"`
+		Issue       ReferencedSubject `graphql:"... on Issue"`
+		PullRequest ReferencedSubject `graphql:"... on PullRequest"`
+	}
+}
+
+type MergedEvent struct {
+	Actor     TimelineActor
+	CreatedAt time.Time
+	Url       string
+	Commit    *struct {
+		AbbreviatedOid string
+	}
+	MergeRefName string
+}
+
+type ClosedEvent struct {
+	Actor       TimelineActor
+	CreatedAt   time.Time
+	Url         string
+	StateReason string
+}
+
+type ReopenedEvent struct {
+	Actor     TimelineActor
+	CreatedAt time.Time
+}
+
+// TimelineEvent is an event of the timeline of a PR or issue other than a comment or review,
+// which are fetched on their own. Only the field matching Typename is meaningful.
+type TimelineEvent struct {
+	Typename string `graphql:"__typename"`
+	Node     struct {
+		Id string
+	} `graphql:"... on Node"`
+	ForcePushed     ForcePushedEvent     `graphql:"... on HeadRefForcePushedEvent"`
+	Labeled         LabelEvent           `graphql:"... on LabeledEvent"`
+	Unlabeled       LabelEvent           `graphql:"... on UnlabeledEvent"`
+	ReviewRequested ReviewRequestedEvent `graphql:"... on ReviewRequestedEvent"`
+	CrossReferenced CrossReferencedEvent `graphql:"... on CrossReferencedEvent"`
+	Merged          MergedEvent          `graphql:"... on MergedEvent"`
+	Closed          ClosedEvent          `graphql:"... on ClosedEvent"`
+	Reopened        ReopenedEvent        `graphql:"... on ReopenedEvent"`
+}
+
+const (
+	TimelineForcePushed     = "HeadRefForcePushedEvent"
+	TimelineLabeled         = "LabeledEvent"
+	TimelineUnlabeled       = "UnlabeledEvent"
+	TimelineReviewRequested = "ReviewRequestedEvent"
+	TimelineCrossReferenced = "CrossReferencedEvent"
+	TimelineMerged          = "MergedEvent"
+	TimelineClosed          = "ClosedEvent"
+	TimelineReopened        = "ReopenedEvent"
+
+	// The types of the comments of the timeline, which decide the mutations they're edited and
+	// deleted with
+	TimelineIssueComment  = "IssueComment"
+	TimelineReview        = "PullRequestReview"
+	TimelineReviewComment = "PullRequestReviewComment"
+)
+
+// Actor returns the login of whoever caused the event.
+func (e TimelineEvent) Actor() string {
+	switch e.Typename {
+	case TimelineForcePushed:
+		return e.ForcePushed.Actor.Login
+	case TimelineLabeled:
+		return e.Labeled.Actor.Login
+	case TimelineUnlabeled:
+		return e.Unlabeled.Actor.Login
+	case TimelineReviewRequested:
+		return e.ReviewRequested.Actor.Login
+	case TimelineCrossReferenced:
+		return e.CrossReferenced.Actor.Login
+	case TimelineMerged:
+		return e.Merged.Actor.Login
+	case TimelineClosed:
+		return e.Closed.Actor.Login
+	case TimelineReopened:
+		return e.Reopened.Actor.Login
+	}
+	return ""
+}
+
+func (e TimelineEvent) CreatedAt() time.Time {
+	switch e.Typename {
+	case TimelineForcePushed:
+		return e.ForcePushed.CreatedAt
+	case TimelineLabeled:
+		return e.Labeled.CreatedAt
+	case TimelineUnlabeled:
+		return e.Unlabeled.CreatedAt
+	case TimelineReviewRequested:
+		return e.ReviewRequested.CreatedAt
+	case TimelineCrossReferenced:
+		return e.CrossReferenced.CreatedAt
+	case TimelineMerged:
+		return e.Merged.CreatedAt
+	case TimelineClosed:
+		return e.Closed.CreatedAt
+	case TimelineReopened:
+		return e.Reopened.CreatedAt
+	}
+	return time.Time{}
+}
+
+// Url returns the link to the event, or to the issue or PR a cross-reference was made from.
+// It's empty for events GitHub doesn't link to.
+func (e TimelineEvent) Url() string {
+	switch e.Typename {
+	case TimelineCrossReferenced:
+		return e.CrossReferenced.Subject().Url
+	case TimelineMerged:
+		return e.Merged.Url
+	case TimelineClosed:
+		return e.Closed.Url
+	}
+	return ""
+}
+
+// Subject returns the issue or PR the reference was made from.
+func (e CrossReferencedEvent) Subject() ReferencedSubject {
+	if e.Source.Typename == "PullRequest" {
+		return e.Source.PullRequest
+	}
+	return e.Source.Issue
+}
+
+// GetReviewerDisplayName returns the login of the requested user, bot or mannequin, or the slug
+// of the requested team.
+func (e ReviewRequestedEvent) GetReviewerDisplayName() string {
+	return ReviewRequestNode{RequestedReviewer: e.RequestedReviewer}.GetReviewerDisplayName()
+}
+
+// TimelineEvents are the latest events of a PR or issue shown in its activity timeline.
+type TimelineEvents struct {
+	Nodes []TimelineEvent
+}
@@ -0,0 +1,183 @@
+package data
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"charm.land/log/v2"
+)
+
+// Worktree is a git worktree a PR was checked out in.
+type Worktree struct {
+	// Repo is the full name of the repo of the PR, e.g. dlvhdr/gh-dash
+	Repo   string `json:"repo"`
+	Number int    `json:"number"`
+	// Branch is the local branch checked out in the worktree
+	Branch string `json:"branch"`
+	Path   string `json:"path"`
+	// RepoPath is the repoPaths directory the worktree was added to
+	RepoPath  string    `json:"repoPath"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// WorktreeStore persists the worktrees PRs were checked out in, so the repo view can list them
+// and they can be pruned once their PRs are closed.
+type WorktreeStore struct {
+	mu        sync.RWMutex
+	worktrees []Worktree
+	filePath  string
+}
+
+func newWorktreeStore(filename string) *WorktreeStore {
+	store := &WorktreeStore{}
+	filePath, err := getStateFilePath(filename)
+	if err != nil {
+		log.Error("Failed to get state file path for worktrees", "err", err)
+	}
+	store.filePath = filePath
+	if err := store.load(); err != nil {
+		log.Error("Failed to load worktrees", "err", err)
+	}
+	return store
+}
+
+func (s *WorktreeStore) load() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.filePath == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(s.filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	if err := json.Unmarshal(data, &s.worktrees); err != nil {
+		s.worktrees = nil
+		return err
+	}
+	log.Debug("Loaded worktrees", "count", len(s.worktrees))
+	return nil
+}
+
+func (s *WorktreeStore) save() error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.filePath == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(s.worktrees, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(s.filePath)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	tmpFile, err := os.CreateTemp(dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	tmpPath := tmpFile.Name()
+	if _, err := tmpFile.Write(data); err != nil {
+		tmpFile.Close()
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := os.Rename(tmpPath, s.filePath); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+
+	log.Debug("Saved worktrees", "count", len(s.worktrees))
+	return nil
+}
+
+// Get returns the worktree the PR was checked out in.
+func (s *WorktreeStore) Get(repo string, number int) (Worktree, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	i := slices.IndexFunc(s.worktrees, func(w Worktree) bool {
+		return strings.EqualFold(w.Repo, repo) && w.Number == number
+	})
+	if i < 0 {
+		return Worktree{}, false
+	}
+	return s.worktrees[i], true
+}
+
+// ForRepoPath returns the worktrees added to the repoPaths directory, newest first.
+func (s *WorktreeStore) ForRepoPath(repoPath string) []Worktree {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var worktrees []Worktree
+	for _, w := range s.worktrees {
+		if samePath(w.RepoPath, repoPath) {
+			worktrees = append(worktrees, w)
+		}
+	}
+	slices.SortStableFunc(worktrees, func(a, b Worktree) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+	return worktrees
+}
+
+// Put records the worktree, replacing the one of the same PR, and saves the store.
+func (s *WorktreeStore) Put(worktree Worktree) error {
+	s.mu.Lock()
+	s.worktrees = slices.DeleteFunc(s.worktrees, func(w Worktree) bool {
+		return strings.EqualFold(w.Repo, worktree.Repo) && w.Number == worktree.Number
+	})
+	s.worktrees = append(s.worktrees, worktree)
+	s.mu.Unlock()
+	return s.save()
+}
+
+// Remove forgets the worktree at path and saves the store.
+func (s *WorktreeStore) Remove(path string) error {
+	s.mu.Lock()
+	s.worktrees = slices.DeleteFunc(s.worktrees, func(w Worktree) bool {
+		return samePath(w.Path, path)
+	})
+	s.mu.Unlock()
+	return s.save()
+}
+
+func samePath(a, b string) bool {
+	return filepath.Clean(a) == filepath.Clean(b)
+}
+
+// Singleton
+
+var (
+	worktreeStore     *WorktreeStore
+	worktreeStoreOnce sync.Once
+)
+
+// GetWorktreeStore returns the singleton worktree store.
+func GetWorktreeStore() *WorktreeStore {
+	worktreeStoreOnce.Do(func() {
+		worktreeStore = newWorktreeStore("worktrees.json")
+	})
+	return worktreeStore
+}
@@ -0,0 +1,63 @@
+package data
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestWorktreeStore(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "worktrees.json")
+	store := NewWorktreeStoreForTesting(filePath)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	older := Worktree{
+		Repo:      "dlvhdr/gh-dash",
+		Number:    1,
+		Branch:    "fix-typo",
+		Path:      "/worktrees/dlvhdr/gh-dash/pr-1",
+		RepoPath:  "/code/gh-dash",
+		CreatedAt: now.Add(-time.Hour),
+	}
+	newer := Worktree{
+		Repo:      "dlvhdr/gh-dash",
+		Number:    2,
+		Path:      "/worktrees/dlvhdr/gh-dash/pr-2",
+		RepoPath:  "/code/gh-dash/",
+		CreatedAt: now,
+	}
+	other := Worktree{Repo: "dlvhdr/jb", Number: 1, RepoPath: "/code/jb"}
+	for _, w := range []Worktree{older, newer, other} {
+		if err := store.Put(w); err != nil {
+			t.Fatalf("Failed to save worktree: %v", err)
+		}
+	}
+
+	got := store.ForRepoPath("/code/gh-dash")
+	if len(got) != 2 || got[0].Number != 2 || got[1].Number != 1 {
+		t.Fatalf("Expected the 2 worktrees of the repo newest first, got %+v", got)
+	}
+
+	older.Branch = "fix-typos"
+	if err := store.Put(older); err != nil {
+		t.Fatalf("Failed to save worktree: %v", err)
+	}
+	if w, ok := store.Get("DLVHDR/gh-dash", 1); !ok || w.Branch != "fix-typos" {
+		t.Errorf("Expected the worktree of the PR to be replaced, got %+v", w)
+	}
+
+	if err := store.Remove("/worktrees/dlvhdr/gh-dash/pr-2/"); err != nil {
+		t.Fatalf("Failed to remove worktree: %v", err)
+	}
+
+	reloaded := NewWorktreeStoreForTesting(filePath)
+	if err := reloaded.load(); err != nil {
+		t.Fatalf("Failed to load worktrees: %v", err)
+	}
+	if _, ok := reloaded.Get("dlvhdr/gh-dash", 2); ok {
+		t.Error("Expected the removed worktree to be gone after reloading")
+	}
+	if w, ok := reloaded.Get("dlvhdr/gh-dash", 1); !ok || !w.CreatedAt.Equal(older.CreatedAt) {
+		t.Errorf("Expected the worktree to survive reloading, got %+v", w)
+	}
+}
@@ -0,0 +1,16 @@
+package data
+
+// NewWorktreeStoreForTesting creates a WorktreeStore backed by the given file path.
+func NewWorktreeStoreForTesting(filePath string) *WorktreeStore {
+	return &WorktreeStore{filePath: filePath}
+}
+
+// OverrideWorktreeStoreForTesting replaces the singleton WorktreeStore with the
+// given store. It returns a function that restores the original store.
+func OverrideWorktreeStoreForTesting(store *WorktreeStore) func() {
+	// Ensure the singleton is initialized so sync.Once has fired.
+	GetWorktreeStore()
+	old := worktreeStore
+	worktreeStore = store
+	return func() { worktreeStore = old }
+}
@@ -21,7 +21,22 @@ type Repo struct {
 	Remotes        []string
 	Branches       []Branch
 	HeadBranchName string
-	Status         gitm.NameStatus
+	Status         Status
+}
+
+// Status is the status of the working tree of a repo.
+type Status struct {
+	gitm.NameStatus
+	// Conflicts are the files with unresolved conflicts
+	Conflicts []string
+	// RebasingBranch is the branch of a rebase that stopped, e.g. on conflicts, until the rebase
+	// is continued or aborted
+	RebasingBranch string
+}
+
+// IsDirty returns whether the working tree has uncommitted changes.
+func (s Status) IsDirty() bool {
+	return len(s.Added)+len(s.Removed)+len(s.Modified)+len(s.Conflicts) > 0
 }
 
 type Branch struct {
@@ -86,7 +101,7 @@ func GetRepo(dir string) (*Repo, error) {
 	for i, b := range bNames {
 		var updatedAt *time.Time
 		var lastCommitMsg *string
-		isHead := b == headRef
+		isHead := b == headRef || b == status.RebasingBranch
 		commits, err := gitm.Log(dir, b, gitm.LogOptions{MaxCount: 1})
 		if err == nil && len(commits) > 0 {
 			updatedAt = &commits[0].Committer.When
@@ -119,11 +134,15 @@ func GetRepo(dir string) (*Repo, error) {
 		return branches[i].LastUpdatedAt.After(*branches[j].LastUpdatedAt)
 	})
 
+	// HEAD is detached while a rebase is stopped
 	headBranch, err := repo.SymbolicRef()
-	if err != nil {
+	if err != nil && status.RebasingBranch == "" {
 		return nil, err
 	}
 	headBranch, _ = strings.CutPrefix(headBranch, gitm.RefsHeads)
+	if status.RebasingBranch != "" {
+		headBranch = status.RebasingBranch
+	}
 
 	remotes, err := repo.Remotes(
 		gitm.RemotesOptions{CommandOptions: gitm.CommandOptions{Args: []string{"show"}}},
@@ -142,22 +161,22 @@ func GetRepo(dir string) (*Repo, error) {
 	}, nil
 }
 
-func GetStatus(dir string) (gitm.NameStatus, error) {
+func GetStatus(dir string) (Status, error) {
 	repo, err := gitm.Open(dir)
 	if err != nil {
-		return gitm.NameStatus{}, err
+		return Status{}, err
 	}
 	return getUnstagedStatus(repo)
 }
 
 // test
-func getUnstagedStatus(repo *gitm.Repository) (gitm.NameStatus, error) {
+func getUnstagedStatus(repo *gitm.Repository) (Status, error) {
 	cmd := gitm.NewCommand("diff", "HEAD", "--name-status")
 	stdout, err := cmd.RunInDir(repo.Path())
 	if err != nil {
-		return gitm.NameStatus{}, err
+		return Status{}, err
 	}
-	status := gitm.NameStatus{}
+	status := Status{RebasingBranch: getRebasingBranch(repo.Path())}
 	scanner := bufio.NewScanner(bytes.NewReader(stdout))
 	for scanner.Scan() {
 		fields := strings.Fields(scanner.Text())
@@ -174,6 +193,17 @@ func getUnstagedStatus(repo *gitm.Repository) (gitm.NameStatus, error) {
 			status.Modified = append(status.Modified, fields[1])
 		}
 	}
+
+	// diffing HEAD lists conflicting files as modified, the index still has them unmerged
+	stdout, err = gitm.NewCommand("diff", "--name-only", "--diff-filter=U").RunInDir(repo.Path())
+	if err != nil {
+		return Status{}, err
+	}
+	for _, file := range strings.Split(string(stdout), "\n") {
+		if file != "" {
+			status.Conflicts = append(status.Conflicts, file)
+		}
+	}
 	return status, err
 }
 
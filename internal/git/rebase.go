@@ -0,0 +1,90 @@
+package git
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	gitm "github.com/aymanbagabas/git-module"
+)
+
+// ErrRebaseStopped is returned when a rebase stops on conflicts and waits to be continued or
+// aborted.
+var ErrRebaseStopped = errors.New("the rebase stopped on conflicts")
+
+// GetDefaultBranch returns the default branch of the origin remote, e.g. main.
+func GetDefaultBranch(dir string) (string, error) {
+	stdout, err := gitm.NewCommand(
+		"symbolic-ref", "--short", "refs/remotes/origin/HEAD").RunInDir(dir)
+	if err == nil {
+		return strings.TrimPrefix(strings.TrimSpace(string(stdout)), "origin/"), nil
+	}
+
+	// origin/HEAD is only set by clones, so fall back to the usual names
+	for _, name := range []string{"main", "master"} {
+		_, err := gitm.NewCommand(
+			"rev-parse", "--verify", "--quiet", "refs/remotes/origin/"+name).RunInDir(dir)
+		if err == nil {
+			return name, nil
+		}
+	}
+	return "", errors.New("failed finding the default branch of origin")
+}
+
+// Rebase fetches onto from origin and rebases branch onto it, checking the branch out. It
+// returns ErrRebaseStopped when the rebase stops on conflicts.
+func Rebase(dir string, branch string, onto string) error {
+	if _, err := gitm.NewCommand("fetch", "origin", onto).RunInDir(dir); err != nil {
+		return err
+	}
+	_, err := gitm.NewCommand("rebase", "origin/"+onto, branch).RunInDir(dir)
+	if err != nil && getRebasingBranch(dir) != "" {
+		return ErrRebaseStopped
+	}
+	return err
+}
+
+// ContinueRebase continues a stopped rebase once its conflicts are resolved and staged. It
+// returns ErrRebaseStopped when the rebase stops again, on conflicts or unstaged resolutions.
+func ContinueRebase(dir string) error {
+	_, err := gitm.NewCommand("rebase", "--continue").
+		AddEnvs("GIT_EDITOR=true").
+		RunInDir(dir)
+	if err != nil && getRebasingBranch(dir) != "" {
+		return ErrRebaseStopped
+	}
+	return err
+}
+
+// AbortRebase aborts a stopped rebase, restoring the branch to what it was before.
+func AbortRebase(dir string) error {
+	_, err := gitm.NewCommand("rebase", "--abort").RunInDir(dir)
+	return err
+}
+
+// getRebasingBranch returns the branch of a stopped rebase of the repo at dir, or "" when no
+// rebase is in progress.
+func getRebasingBranch(dir string) string {
+	for _, state := range []string{"rebase-merge", "rebase-apply"} {
+		stdout, err := gitm.NewCommand("rev-parse", "--git-path", state+"/head-name").
+			RunInDir(dir)
+		if err != nil {
+			continue
+		}
+		path := strings.TrimSpace(string(stdout))
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dir, path)
+		}
+		headName, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		branch := strings.TrimPrefix(strings.TrimSpace(string(headName)), gitm.RefsHeads)
+		if branch == "" || branch == "detached HEAD" {
+			return "HEAD"
+		}
+		return branch
+	}
+	return ""
+}
@@ -0,0 +1,121 @@
+package git
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func run(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	c := exec.Command("git", args...)
+	c.Dir = dir
+	c.Env = append(os.Environ(),
+		"GIT_AUTHOR_NAME=gh-dash", "GIT_AUTHOR_EMAIL=gh-dash@example.com",
+		"GIT_COMMITTER_NAME=gh-dash", "GIT_COMMITTER_EMAIL=gh-dash@example.com",
+		"GIT_CONFIG_GLOBAL=/dev/null",
+	)
+	if output, err := c.CombinedOutput(); err != nil {
+		t.Fatalf("git %v: %v\n%s", args, err, output)
+	}
+}
+
+func commitFile(t *testing.T, dir string, content string, msg string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, "file"), []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	run(t, dir, "add", "file")
+	run(t, dir, "commit", "-q", "-m", msg)
+}
+
+// setupConflictingRepo clones a repo whose feature branch conflicts with the latest main of
+// origin.
+func setupConflictingRepo(t *testing.T) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git isn't installed")
+	}
+
+	dir := t.TempDir()
+	origin := filepath.Join(dir, "origin")
+	work := filepath.Join(dir, "work")
+	run(t, dir, "init", "-q", "-b", "main", origin)
+	commitFile(t, origin, "a\n", "init")
+	run(t, dir, "clone", "-q", origin, work)
+	commitFile(t, origin, "b\n", "main")
+
+	run(t, work, "checkout", "-q", "-b", "feature")
+	commitFile(t, work, "c\n", "feature")
+	run(t, work, "checkout", "-q", "main")
+	return work
+}
+
+func TestGetDefaultBranch(t *testing.T) {
+	work := setupConflictingRepo(t)
+
+	branch, err := GetDefaultBranch(work)
+	if err != nil || branch != "main" {
+		t.Fatalf("Expected main, got %q (%v)", branch, err)
+	}
+}
+
+func TestRebaseStoppedOnConflicts(t *testing.T) {
+	work := setupConflictingRepo(t)
+
+	if err := Rebase(work, "feature", "main"); !errors.Is(err, ErrRebaseStopped) {
+		t.Fatalf("Expected the rebase to stop on conflicts, got %v", err)
+	}
+
+	repo, err := GetRepo(work)
+	if err != nil {
+		t.Fatalf("Failed reading the repo while the rebase is stopped: %v", err)
+	}
+	if repo.Status.RebasingBranch != "feature" || repo.HeadBranchName != "feature" {
+		t.Errorf("Expected feature to be rebasing, got %q", repo.Status.RebasingBranch)
+	}
+	if len(repo.Status.Conflicts) != 1 || repo.Status.Conflicts[0] != "file" {
+		t.Errorf("Expected file to conflict, got %v", repo.Status.Conflicts)
+	}
+	if err := ContinueRebase(work); !errors.Is(err, ErrRebaseStopped) {
+		t.Errorf("Expected continuing with conflicts to stop again, got %v", err)
+	}
+
+	if err := AbortRebase(work); err != nil {
+		t.Fatalf("Failed aborting the rebase: %v", err)
+	}
+	status, err := GetStatus(work)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.RebasingBranch != "" || status.IsDirty() {
+		t.Errorf("Expected a clean status after aborting, got %+v", status)
+	}
+}
+
+func TestContinueRebase(t *testing.T) {
+	work := setupConflictingRepo(t)
+
+	if err := Rebase(work, "feature", "main"); !errors.Is(err, ErrRebaseStopped) {
+		t.Fatalf("Expected the rebase to stop on conflicts, got %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(work, "file"), []byte("b\nc\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	run(t, work, "add", "file")
+
+	t.Setenv("GIT_COMMITTER_NAME", "gh-dash")
+	t.Setenv("GIT_COMMITTER_EMAIL", "gh-dash@example.com")
+	if err := ContinueRebase(work); err != nil {
+		t.Fatalf("Failed continuing the rebase: %v", err)
+	}
+	status, err := GetStatus(work)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.RebasingBranch != "" || status.IsDirty() {
+		t.Errorf("Expected the rebase to be done, got %+v", status)
+	}
+}
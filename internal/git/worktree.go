@@ -0,0 +1,42 @@
+package git
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	gitm "github.com/aymanbagabas/git-module"
+)
+
+// AddWorktree adds a worktree at path to the repo at repoPath, detached at its HEAD until a
+// branch is checked out in it.
+func AddWorktree(repoPath string, path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	_, err := gitm.NewCommand("worktree", "add", "--detach", path).RunInDir(repoPath)
+	return err
+}
+
+// RemoveWorktree removes the worktree at path from the repo at repoPath. It fails when the
+// worktree has uncommitted changes, so they aren't lost.
+func RemoveWorktree(repoPath string, path string) error {
+	_, err := gitm.NewCommand("worktree", "remove", path).RunInDir(repoPath)
+	return err
+}
+
+// PruneWorktrees drops what git knows about the worktrees of the repo at repoPath whose
+// directories were deleted.
+func PruneWorktrees(repoPath string) error {
+	_, err := gitm.NewCommand("worktree", "prune").RunInDir(repoPath)
+	return err
+}
+
+// GetCurrentBranch returns the branch checked out in dir, or HEAD when it's detached.
+func GetCurrentBranch(dir string) (string, error) {
+	stdout, err := gitm.NewCommand("rev-parse", "--abbrev-ref", "HEAD").RunInDir(dir)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(stdout)), nil
+}
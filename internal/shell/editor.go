@@ -0,0 +1,58 @@
+package shell
+
+import (
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	ghConfig "github.com/cli/go-gh/v2/pkg/config"
+)
+
+// readGhEditor returns the editor set in the gh config, swapped in tests.
+var readGhEditor = func() string {
+	cfg, err := ghConfig.Read(nil)
+	if err != nil {
+		return ""
+	}
+	editor, err := cfg.Get([]string{"editor"})
+	if err != nil {
+		return ""
+	}
+	return editor
+}
+
+// Editor resolves the editor the same way gh does: GH_EDITOR, the editor of
+// the gh config, VISUAL and then EDITOR, falling back to notepad on Windows
+// and vi everywhere else.
+func Editor() string {
+	if editor := os.Getenv("GH_EDITOR"); editor != "" {
+		return editor
+	}
+	if editor := readGhEditor(); editor != "" {
+		return editor
+	}
+	for _, env := range []string{"VISUAL", "EDITOR"} {
+		if editor := os.Getenv(env); editor != "" {
+			return editor
+		}
+	}
+	if runtime.GOOS == "windows" {
+		return "notepad"
+	}
+	return "vi"
+}
+
+// EditorCommand resolves a *exec.Cmd that opens path in the editor. It runs
+// through the shell so editors configured with arguments, like "code --wait",
+// work as they do in gh.
+func EditorCommand(path string) *exec.Cmd {
+	return Command(Editor() + " " + quote(path))
+}
+
+func quote(arg string) string {
+	if runtime.GOOS == "windows" && os.Getenv("SHELL") == "" {
+		return `"` + arg + `"`
+	}
+	return "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+}
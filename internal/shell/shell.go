@@ -1,5 +1,5 @@
 // Package shell resolves the platform-appropriate shell for executing
-// user-supplied custom commands.
+// user-supplied custom commands, and the editor to open files in.
 package shell
 
 import (
@@ -18,15 +18,28 @@ import (
 //     "sh" failed because Windows ships neither sh nor SHELL by default.
 //   - Otherwise, run "sh -c <cmd>" (preserves the previous POSIX fallback).
 func Command(cmd string) *exec.Cmd {
+	shell, flag := resolve()
+	return exec.Command(shell, flag, cmd)
+}
+
+// Interactive resolves a *exec.Cmd that starts an interactive session of the
+// same shell Command runs commands through.
+func Interactive() *exec.Cmd {
+	shell, _ := resolve()
+	return exec.Command(shell)
+}
+
+// resolve returns the shell and the flag it takes a command string with.
+func resolve() (string, string) {
 	if shell := os.Getenv("SHELL"); shell != "" {
-		return exec.Command(shell, "-c", cmd)
+		return shell, "-c"
 	}
 	if runtime.GOOS == "windows" {
 		comspec := os.Getenv("COMSPEC")
 		if comspec == "" {
 			comspec = "cmd.exe"
 		}
-		return exec.Command(comspec, "/C", cmd)
+		return comspec, "/C"
 	}
-	return exec.Command("sh", "-c", cmd)
+	return "sh", "-c"
 }
@@ -67,3 +67,51 @@ func TestCommand_PassesCommandStringIntact(t *testing.T) {
 		t.Fatalf("command string altered, got %q", c.Args[2])
 	}
 }
+
+func TestInteractive_StartsTheSameShell(t *testing.T) {
+	t.Setenv("SHELL", "/usr/local/bin/fish")
+
+	c := Interactive()
+	if c.Path != "/usr/local/bin/fish" {
+		t.Fatalf("expected SHELL to be honored, got %q", c.Path)
+	}
+	if len(c.Args) != 1 {
+		t.Fatalf("expected no arguments, got %v", c.Args)
+	}
+}
+
+func TestEditor_ResolutionOrder(t *testing.T) {
+	ghEditor := "nano"
+	original := readGhEditor
+	readGhEditor = func() string { return ghEditor }
+	t.Cleanup(func() { readGhEditor = original })
+	t.Setenv("GH_EDITOR", "hx")
+	t.Setenv("VISUAL", "code --wait")
+	t.Setenv("EDITOR", "vim")
+
+	if got := Editor(); got != "hx" {
+		t.Fatalf("expected GH_EDITOR to win, got %q", got)
+	}
+	t.Setenv("GH_EDITOR", "")
+	if got := Editor(); got != "nano" {
+		t.Fatalf("expected the gh config editor, got %q", got)
+	}
+	ghEditor = ""
+	if got := Editor(); got != "code --wait" {
+		t.Fatalf("expected VISUAL, got %q", got)
+	}
+	t.Setenv("VISUAL", "")
+	if got := Editor(); got != "vim" {
+		t.Fatalf("expected EDITOR, got %q", got)
+	}
+}
+
+func TestEditorCommand_QuotesPath(t *testing.T) {
+	t.Setenv("SHELL", "/bin/sh")
+	t.Setenv("GH_EDITOR", "code --wait")
+
+	c := EditorCommand("/tmp/it's here.md")
+	if c.Args[2] != `code --wait '/tmp/it'\''s here.md'` {
+		t.Fatalf("expected the editor with its args and a quoted path, got %q", c.Args[2])
+	}
+}
@@ -0,0 +1,84 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/log/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/checkswatcher"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prssection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+func watchChecksTaskId(pr checkswatcher.PR) string {
+	return fmt.Sprintf("pr_watch_checks_%s", pr.Url)
+}
+
+// toggleWatchChecks adds a PR to the checks watch list, or removes it when it's already watched.
+func (m *Model) toggleWatchChecks(pr checkswatcher.PR) tea.Cmd {
+	taskId := watchChecksTaskId(pr)
+	if m.checksWatcher.Unwatch(pr.Url) {
+		task := m.tasks[taskId]
+		task.FinishedText = fmt.Sprintf("Stopped watching checks for PR #%d", pr.Number)
+		m.tasks[taskId] = task
+		return tea.Batch(m.syncSidebar(), func() tea.Msg {
+			return constants.TaskFinishedMsg{TaskId: taskId}
+		})
+	}
+
+	m.checksWatcher.Watch(pr)
+	startCmd := m.ctx.StartTask(context.Task{
+		Id:        taskId,
+		StartText: fmt.Sprintf("Watching checks for PR #%d", pr.Number),
+		State:     context.TaskStart,
+	})
+	return tea.Batch(startCmd, m.syncSidebar())
+}
+
+// onChecksUpdated shows the polled checks of a watched PR in the sections and the sidebar,
+// and reports the checks that changed state through the PR's task.
+func (m *Model) onChecksUpdated(msg checkswatcher.ChecksUpdatedMsg) tea.Cmd {
+	if msg.Err != nil {
+		log.Error("Error watching PR checks", "url", msg.PR.Url, "err", msg.Err)
+		return nil
+	}
+
+	for _, s := range m.prs {
+		if prs, ok := s.(*prssection.Model); ok {
+			prs.UpdateChecks(msg.PR.Url, msg.Commits)
+		}
+	}
+	m.prView.SetChecks(msg.PR.Url, msg.Commits)
+	syncCmd := m.syncSidebar()
+
+	taskId := watchChecksTaskId(msg.PR)
+	task, ok := m.tasks[taskId]
+	if !ok {
+		return syncCmd
+	}
+	if msg.Finished {
+		var err error
+		if msg.Failed() {
+			err = fmt.Errorf("checks have failed for PR #%d", msg.PR.Number)
+		}
+		task.FinishedText = fmt.Sprintf("Checks have passed for PR #%d", msg.PR.Number)
+		m.tasks[taskId] = task
+		return tea.Batch(syncCmd, func() tea.Msg {
+			return constants.TaskFinishedMsg{TaskId: taskId, Err: err}
+		})
+	}
+	if len(msg.Transitions) == 0 {
+		return syncCmd
+	}
+
+	descriptions := make([]string, 0, len(msg.Transitions))
+	for _, transition := range msg.Transitions {
+		descriptions = append(descriptions, transition.Describe())
+	}
+	task.StartText = fmt.Sprintf("PR #%d: %s (%d/%d checks done)",
+		msg.PR.Number, strings.Join(descriptions, ", "), msg.Completed, msg.Total)
+	return tea.Batch(syncCmd, m.ctx.StartTask(task))
+}
@@ -0,0 +1,33 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+)
+
+// BulkPrompt adds the number of rows an input applies to to its prompt, e.g.
+// "Leave a comment [3 PRs]…".
+func BulkPrompt(prompt string, count int, plural string) string {
+	return fmt.Sprintf("%s [%d %s]%s",
+		strings.TrimSuffix(prompt, constants.Ellipsis), count, plural, constants.Ellipsis)
+}
+
+// LabelsWithColors returns the labels with the given names, taking their colors from the
+// cached labels of the repo when they are known.
+func LabelsWithColors(repoNameWithOwner string, names []string) []data.Label {
+	colors := make(map[string]string)
+	if cached, ok := data.CachedRepoLabels(repoNameWithOwner); ok {
+		for _, label := range cached {
+			colors[label.Name] = label.Color
+		}
+	}
+
+	labels := make([]data.Label, 0, len(names))
+	for _, name := range names {
+		labels = append(labels, data.Label{Name: name, Color: colors[name]})
+	}
+	return labels
+}
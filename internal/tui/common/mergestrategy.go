@@ -0,0 +1,43 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+)
+
+const DefaultMergeStrategy = "merge"
+
+// GetRepoMergeStrategy returns the merge strategy configured for a given repo name.
+// Keys are matched like repoPaths keys: an exact repo name takes priority over an
+// {owner}/* wildcard, which takes priority over the :owner/:repo fallback.
+// For a given config of:
+//
+//	{
+//	  "user/repo":    "rebase",
+//	  "user/*":       "squash",
+//	  ":owner/:repo": "merge",
+//	}
+//
+// GetRepoMergeStrategy("user/repo", config) will return: "rebase"
+// GetRepoMergeStrategy("user/other_repo", config) will return: "squash"
+// GetRepoMergeStrategy("user_2/repo", config) will return: "merge"
+//
+// When nothing matches, DefaultMergeStrategy is returned.
+func GetRepoMergeStrategy(repoName string, cfgStrategies map[string]string) string {
+	if strategy, ok := cfgStrategies[repoName]; ok {
+		return strategy
+	}
+
+	owner, _, found := strings.Cut(repoName, "/")
+	if found {
+		if strategy, ok := cfgStrategies[fmt.Sprintf("%s/*", owner)]; ok {
+			return strategy
+		}
+	}
+
+	if strategy, ok := cfgStrategies[":owner/:repo"]; ok {
+		return strategy
+	}
+
+	return DefaultMergeStrategy
+}
@@ -0,0 +1,53 @@
+package common_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/common"
+)
+
+func TestGetRepoMergeStrategy(t *testing.T) {
+	strategies := map[string]string{
+		"user/repo": "rebase",
+		"user/*":    "squash",
+	}
+
+	testCases := map[string]struct {
+		repo       string
+		want       string
+		strategies map[string]string
+	}{
+		"exact match": {
+			repo:       "user/repo",
+			want:       "rebase",
+			strategies: strategies,
+		},
+		"wildcard match": {
+			repo:       "user/other_repo",
+			want:       "squash",
+			strategies: strategies,
+		},
+		"no match": {
+			repo:       "user_2/repo",
+			want:       common.DefaultMergeStrategy,
+			strategies: strategies,
+		},
+		"with :owner/:repo fallback": {
+			repo:       "user_2/repo",
+			want:       "squash",
+			strategies: map[string]string{":owner/:repo": "squash"},
+		},
+		"no config": {
+			repo: "user/repo",
+			want: common.DefaultMergeStrategy,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.want, common.GetRepoMergeStrategy(tc.repo, tc.strategies))
+		})
+	}
+}
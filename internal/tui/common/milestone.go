@@ -0,0 +1,52 @@
+package common
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+)
+
+// RenderMilestone renders the milestone of an issue or PR with a bar of the share of its issues
+// and PRs that are closed. It's empty when there's no milestone.
+func RenderMilestone(milestone *data.Milestone, styles CommonStyles, width int) string {
+	if milestone == nil {
+		return ""
+	}
+
+	title := styles.MainTextStyle.Render(milestone.Title)
+	if milestone.DueOn != nil {
+		title += styles.FaintTextStyle.Render(
+			fmt.Sprintf(" %s Due %s", constants.SmallDotIcon, milestone.DueOn.Format("Jan 2, 2006")))
+	}
+
+	percentage := fmt.Sprintf(" %d%%", int(math.Round(milestone.ProgressPercentage)))
+	barWidth := max(width-lipgloss.Width(percentage), 0)
+	done := int(math.Round(milestone.ProgressPercentage / 100 * float64(barWidth)))
+	done = min(max(done, 0), barWidth)
+	bar := styles.SuccessStyle.Render(strings.Repeat("▃", done)) +
+		styles.FaintTextStyle.Render(strings.Repeat("▃", barWidth-done)+percentage)
+
+	return strings.Join([]string{
+		styles.MainTextStyle.Underline(true).Render(
+			fmt.Sprintf("%s Milestone", constants.MilestoneIcon)),
+		lipgloss.NewStyle().MaxWidth(width).Render(title),
+		bar,
+	}, "\n")
+}
+
+// MilestoneWithTitle returns the milestone of the repo with the given title, taking its due
+// date and progress from the cached milestones of the repo when they are known. It's nil for an
+// empty title, which removes the milestone.
+func MilestoneWithTitle(repoNameWithOwner string, title string) *data.Milestone {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return nil
+	}
+	milestone := data.FindMilestone(repoNameWithOwner, title)
+	return &milestone
+}
@@ -0,0 +1,37 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+)
+
+// RenderProjectItems renders the projects an issue or PR is in, with a "Field: value" line for
+// each of the fields set on it. It's empty when the item isn't in any project.
+func RenderProjectItems(items data.ProjectItems, styles CommonStyles, width int) string {
+	if len(items.Nodes) == 0 {
+		return ""
+	}
+
+	lines := []string{
+		styles.MainTextStyle.Underline(true).Render(
+			fmt.Sprintf("%s Projects", constants.ProjectIcon)),
+	}
+	for _, item := range items.Nodes {
+		lines = append(lines, "", styles.MainTextStyle.Render(item.Project.Title))
+		for _, value := range item.FieldValues.Nodes {
+			field := value.GetField()
+			// The title of the item is the title of the issue or PR
+			if field == "" || field == "Title" {
+				continue
+			}
+			lines = append(lines, lipgloss.NewStyle().MaxWidth(width).Render(
+				styles.FaintTextStyle.Render(field+": ")+value.String()))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
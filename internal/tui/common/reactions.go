@@ -0,0 +1,27 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+// RenderReactions renders the count of each reaction on a PR, issue or comment, highlighting
+// the ones the user reacted with. It's empty when there are no reactions.
+func RenderReactions(groups data.ReactionGroups, styles CommonStyles) string {
+	reactions := make([]string, 0, len(groups))
+	for _, content := range data.ReactionContents {
+		group := groups.Get(content)
+		if group.Reactors.TotalCount == 0 {
+			continue
+		}
+		style := styles.FaintTextStyle
+		if group.ViewerHasReacted {
+			style = styles.MainTextStyle
+		}
+		reactions = append(reactions, style.Render(
+			fmt.Sprintf("%s %d", data.ReactionEmoji(content), group.Reactors.TotalCount)))
+	}
+	return strings.Join(reactions, "  ")
+}
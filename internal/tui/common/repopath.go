@@ -3,6 +3,8 @@ package common
 import (
 	"fmt"
 	"strings"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
 )
 
 // GetRepoLocalPath returns the local path for a given repo name.
@@ -57,3 +59,12 @@ func GetRepoLocalPath(repoName string, cfgPaths map[string]string) (string, bool
 
 	return "", false
 }
+
+// FindRepoLocalPath returns the local path of the repo like GetRepoLocalPath, falling back to
+// where the repo was cloned to on demand.
+func FindRepoLocalPath(repoName string, cfgPaths map[string]string) (string, bool) {
+	if repoPath, ok := GetRepoLocalPath(repoName, cfgPaths); ok {
+		return repoPath, true
+	}
+	return data.GetCloneStore().Get(repoName)
+}
@@ -0,0 +1,298 @@
+package common
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"charm.land/lipgloss/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+// TimelineAction is an action on the selected entry of the activity timeline of a PR or issue.
+type TimelineAction string
+
+const (
+	TimelineQuoteReply   TimelineAction = "quote_reply"
+	TimelineCopyLink     TimelineAction = "copy_link"
+	TimelineEdit         TimelineAction = "edit"
+	TimelineDelete       TimelineAction = "delete"
+	TimelineHideOutdated TimelineAction = "hide_outdated"
+)
+
+// Describe explains what the action does, shown next to it while picking one.
+func (a TimelineAction) Describe() string {
+	switch a {
+	case TimelineQuoteReply:
+		return "reply quoting it"
+	case TimelineCopyLink:
+		return "copy its link"
+	case TimelineEdit:
+		return "edit it in the editor"
+	case TimelineDelete:
+		return "delete it"
+	case TimelineHideOutdated:
+		return "hide it as outdated"
+	}
+	return ""
+}
+
+// TimelineEntry is a comment, review, review comment or event of the activity timeline of a PR
+// or issue.
+type TimelineEntry struct {
+	// Id is the node id of a comment, review or review comment, empty for events
+	Id string
+	// Typename is the GraphQL type of a comment, review or review comment
+	Typename       string
+	Author         string
+	Body           string
+	UpdatedAt      time.Time
+	Meta           data.CommentMeta
+	ReactionGroups data.ReactionGroups
+	// ReviewState is the state of a review, e.g. APPROVED
+	ReviewState string
+	// Path and Line are where a review comment was made
+	Path string
+	Line int
+	// Event is set for entries that aren't comments, e.g. a label being added
+	Event *data.TimelineEvent
+}
+
+// Key identifies the entry to keep it selected while the timeline changes.
+func (e TimelineEntry) Key() string {
+	if e.Event != nil {
+		return e.Event.Node.Id
+	}
+	return e.Id
+}
+
+func (e TimelineEntry) CreatedAt() time.Time {
+	if e.Event != nil {
+		return e.Event.CreatedAt()
+	}
+	if e.Meta.CreatedAt.IsZero() {
+		return e.UpdatedAt
+	}
+	return e.Meta.CreatedAt
+}
+
+func (e TimelineEntry) Url() string {
+	if e.Event != nil {
+		return e.Event.Url()
+	}
+	return e.Meta.Url
+}
+
+// Name describes the entry in prompts and task texts, e.g. "@dlvhdr's review on pr #12" for a
+// subject of "pr #12".
+func (e TimelineEntry) Name(subject string) string {
+	switch {
+	case e.Event != nil:
+		return "the event on " + subject
+	case e.Typename == data.TimelineReview:
+		return fmt.Sprintf("@%s's review on %s", e.Author, subject)
+	case e.Typename == data.TimelineReviewComment:
+		return fmt.Sprintf("@%s's review comment on %s", e.Author, subject)
+	}
+	return fmt.Sprintf("@%s's comment on %s", e.Author, subject)
+}
+
+// Actions returns what the user can do with the entry. Only the user's own comments can be
+// edited and deleted, and of reviews only the pending ones can be deleted.
+func (e TimelineEntry) Actions() []TimelineAction {
+	var actions []TimelineAction
+	if e.Event == nil && strings.TrimSpace(e.Body) != "" {
+		actions = append(actions, TimelineQuoteReply)
+	}
+	if e.Url() != "" {
+		actions = append(actions, TimelineCopyLink)
+	}
+	if e.Id == "" {
+		return actions
+	}
+	if e.Meta.ViewerDidAuthor && e.Meta.ViewerCanUpdate {
+		actions = append(actions, TimelineEdit)
+	}
+	isSubmittedReview := e.Typename == data.TimelineReview && e.ReviewState != "PENDING"
+	if e.Meta.ViewerDidAuthor && e.Meta.ViewerCanDelete && !isSubmittedReview {
+		actions = append(actions, TimelineDelete)
+	}
+	if e.Meta.ViewerCanMinimize && !e.Meta.IsMinimized {
+		actions = append(actions, TimelineHideOutdated)
+	}
+	return actions
+}
+
+// SelectableTimeline returns the entries that can be selected, leaving out the comments that
+// were just added and have no id until they're refetched.
+func SelectableTimeline(entries []TimelineEntry) []TimelineEntry {
+	return slices.DeleteFunc(slices.Clone(entries), func(e TimelineEntry) bool {
+		return e.Key() == ""
+	})
+}
+
+// SortTimeline sorts the entries oldest first.
+func SortTimeline(entries []TimelineEntry) {
+	slices.SortStableFunc(entries, func(a, b TimelineEntry) int {
+		return a.CreatedAt().Compare(b.CreatedAt())
+	})
+}
+
+// TimelineEntriesFromEvents returns the timeline entries of the events of a PR or issue.
+func TimelineEntriesFromEvents(events data.TimelineEvents) []TimelineEntry {
+	entries := make([]TimelineEntry, 0, len(events.Nodes))
+	for i := range events.Nodes {
+		if DescribeTimelineEvent(events.Nodes[i]) == "" {
+			continue
+		}
+		entries = append(entries, TimelineEntry{Event: &events.Nodes[i]})
+	}
+	return entries
+}
+
+// SelectTimelineEntry moves the selection by delta, starting at the first or last entry when
+// nothing is selected, and returns the key of the newly selected entry.
+func SelectTimelineEntry(entries []TimelineEntry, selected string, delta int) string {
+	entries = SelectableTimeline(entries)
+	if len(entries) == 0 {
+		return selected
+	}
+
+	i := slices.IndexFunc(entries, func(e TimelineEntry) bool { return e.Key() == selected })
+	switch {
+	case i < 0 && delta > 0:
+		i = 0
+	case i < 0:
+		i = len(entries) - 1
+	default:
+		i = max(0, min(len(entries)-1, i+delta))
+	}
+	return entries[i].Key()
+}
+
+// FindTimelineEntry returns the entry with the key, or nil when it's not in the timeline.
+func FindTimelineEntry(entries []TimelineEntry, key string) *TimelineEntry {
+	if key == "" {
+		return nil
+	}
+	for _, entry := range entries {
+		if entry.Key() == key {
+			return &entry
+		}
+	}
+	return nil
+}
+
+func referenceName(subject data.ReferencedSubject, crossRepository bool) string {
+	if crossRepository {
+		return fmt.Sprintf("%s#%d", subject.Repository.NameWithOwner, subject.Number)
+	}
+	return fmt.Sprintf("#%d", subject.Number)
+}
+
+// DescribeTimelineEvent describes what happened in an event, e.g. "added the bug label". It's
+// empty for events that aren't shown.
+func DescribeTimelineEvent(event data.TimelineEvent) string {
+	switch event.Typename {
+	case data.TimelineForcePushed:
+		e := event.ForcePushed
+		if e.BeforeCommit == nil || e.AfterCommit == nil {
+			return "force-pushed the branch"
+		}
+		return fmt.Sprintf("force-pushed the branch from %s to %s",
+			e.BeforeCommit.AbbreviatedOid, e.AfterCommit.AbbreviatedOid)
+	case data.TimelineLabeled:
+		return fmt.Sprintf("added the %s label", event.Labeled.Label.Name)
+	case data.TimelineUnlabeled:
+		return fmt.Sprintf("removed the %s label", event.Unlabeled.Label.Name)
+	case data.TimelineReviewRequested:
+		e := event.ReviewRequested
+		reviewer := e.GetReviewerDisplayName()
+		if reviewer == "" {
+			return "requested a review"
+		}
+		if e.RequestedReviewer.Team.Slug == "" {
+			reviewer = "@" + reviewer
+		}
+		return "requested a review from " + reviewer
+	case data.TimelineCrossReferenced:
+		e := event.CrossReferenced
+		subject := e.Subject()
+		kind := "issue"
+		if e.Source.Typename == "PullRequest" {
+			kind = "PR"
+		}
+		verb := "mentioned this in"
+		if e.WillCloseTarget {
+			verb = "linked this to"
+		}
+		return fmt.Sprintf("%s %s %s: %s", verb, kind,
+			referenceName(subject, e.IsCrossRepository), subject.Title)
+	case data.TimelineMerged:
+		e := event.Merged
+		if e.Commit == nil {
+			return fmt.Sprintf("merged this into %s", e.MergeRefName)
+		}
+		return fmt.Sprintf("merged commit %s into %s", e.Commit.AbbreviatedOid, e.MergeRefName)
+	case data.TimelineClosed:
+		switch event.Closed.StateReason {
+		case "NOT_PLANNED":
+			return "closed this as not planned"
+		case "DUPLICATE":
+			return "closed this as a duplicate"
+		case "COMPLETED":
+			return "closed this as completed"
+		}
+		return "closed this"
+	case data.TimelineReopened:
+		return "reopened this"
+	}
+	return ""
+}
+
+func timelineEventIcon(typename string) string {
+	switch typename {
+	case data.TimelineForcePushed:
+		return constants.VerticalCommitIcon
+	case data.TimelineLabeled, data.TimelineUnlabeled:
+		return constants.LabelsIcon
+	case data.TimelineReviewRequested:
+		return constants.CodeReviewIcon
+	case data.TimelineCrossReferenced:
+		return constants.CommentIcon
+	case data.TimelineMerged:
+		return constants.MergedIcon
+	case data.TimelineClosed:
+		return constants.ClosedIcon
+	case data.TimelineReopened:
+		return constants.OpenIcon
+	}
+	return constants.DotIcon
+}
+
+// RenderTimelineEvent renders an event of the activity timeline, e.g. "󰌖 dlvhdr added the bug
+// label 2h ago", wrapped to the width.
+func RenderTimelineEvent(event data.TimelineEvent, styles CommonStyles, width int) string {
+	text := lipgloss.JoinHorizontal(lipgloss.Top,
+		styles.FaintTextStyle.Render(timelineEventIcon(event.Typename)+" "),
+		styles.MainTextStyle.Render(event.Actor()),
+		" ",
+		styles.FaintTextStyle.Render(DescribeTimelineEvent(event)+" "+
+			utils.TimeElapsed(event.CreatedAt())),
+	)
+	return lipgloss.NewStyle().Width(width).Render(text)
+}
+
+// QuoteReply quotes the body of a comment to reply to it, like GitHub's quote reply.
+func QuoteReply(body string) string {
+	body = strings.TrimSpace(strings.ReplaceAll(body, "\r\n", "\n"))
+	lines := strings.Split(body, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight("> "+line, " ")
+	}
+	return strings.Join(lines, "\n") + "\n\n"
+}
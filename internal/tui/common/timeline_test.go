@@ -0,0 +1,105 @@
+package common_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/common"
+)
+
+func TestDescribeTimelineEvent(t *testing.T) {
+	pushed := data.TimelineEvent{Typename: data.TimelineForcePushed}
+	pushed.ForcePushed.BeforeCommit = &struct{ AbbreviatedOid string }{"abc1234"}
+	pushed.ForcePushed.AfterCommit = &struct{ AbbreviatedOid string }{"def5678"}
+
+	requested := data.TimelineEvent{Typename: data.TimelineReviewRequested}
+	requested.ReviewRequested.RequestedReviewer.Team.Slug = "core"
+
+	referenced := data.TimelineEvent{Typename: data.TimelineCrossReferenced}
+	referenced.CrossReferenced.IsCrossRepository = true
+	referenced.CrossReferenced.Source.Typename = "PullRequest"
+	referenced.CrossReferenced.Source.PullRequest = data.ReferencedSubject{
+		Number: 12,
+		Title:  "Fix the bug",
+	}
+	referenced.CrossReferenced.Source.PullRequest.Repository.NameWithOwner = "dlvhdr/gh-dash"
+
+	closed := data.TimelineEvent{Typename: data.TimelineClosed}
+	closed.Closed.StateReason = "NOT_PLANNED"
+
+	require.Equal(t, "force-pushed the branch from abc1234 to def5678",
+		common.DescribeTimelineEvent(pushed))
+	require.Equal(t, "requested a review from core", common.DescribeTimelineEvent(requested))
+	require.Equal(t, "mentioned this in PR dlvhdr/gh-dash#12: Fix the bug",
+		common.DescribeTimelineEvent(referenced))
+	require.Equal(t, "closed this as not planned", common.DescribeTimelineEvent(closed))
+	require.Empty(t, common.DescribeTimelineEvent(data.TimelineEvent{Typename: "PinnedEvent"}))
+}
+
+func TestTimelineEntryActions(t *testing.T) {
+	own := common.TimelineEntry{
+		Id:   "IC_1",
+		Body: "LGTM",
+		Meta: data.CommentMeta{
+			Url:             "https://github.com/dlvhdr/gh-dash/pull/1#issuecomment-1",
+			ViewerDidAuthor: true,
+			ViewerCanUpdate: true,
+			ViewerCanDelete: true,
+		},
+	}
+	require.Equal(t, []common.TimelineAction{
+		common.TimelineQuoteReply,
+		common.TimelineCopyLink,
+		common.TimelineEdit,
+		common.TimelineDelete,
+	}, own.Actions())
+
+	others := common.TimelineEntry{
+		Id:   "IC_2",
+		Body: "nit",
+		Meta: data.CommentMeta{ViewerCanUpdate: true, ViewerCanMinimize: true},
+	}
+	require.Equal(t, []common.TimelineAction{
+		common.TimelineQuoteReply,
+		common.TimelineHideOutdated,
+	}, others.Actions(), "only the user's own comments can be edited")
+
+	review := own
+	review.Typename = data.TimelineReview
+	review.ReviewState = "APPROVED"
+	require.NotContains(t, review.Actions(), common.TimelineDelete,
+		"submitted reviews can't be deleted")
+	review.ReviewState = "PENDING"
+	require.Contains(t, review.Actions(), common.TimelineDelete)
+
+	event := data.TimelineEvent{Typename: data.TimelineLabeled}
+	require.Empty(t, common.TimelineEntry{Event: &event}.Actions())
+}
+
+func TestSelectTimelineEntry(t *testing.T) {
+	now := time.Now()
+	labeled := data.TimelineEvent{Typename: data.TimelineLabeled}
+	labeled.Node.Id = "LE_1"
+	labeled.Labeled.CreatedAt = now.Add(-time.Hour)
+	entries := []common.TimelineEntry{
+		{Id: "IC_1", UpdatedAt: now},
+		{Body: "just added", UpdatedAt: now.Add(time.Minute)},
+		{Event: &labeled},
+	}
+	common.SortTimeline(entries)
+
+	require.Equal(t, "LE_1", common.SelectTimelineEntry(entries, "", 1))
+	require.Equal(t, "IC_1", common.SelectTimelineEntry(entries, "", -1),
+		"entries without a key can't be selected")
+	require.Equal(t, "IC_1", common.SelectTimelineEntry(entries, "LE_1", 5))
+	require.Equal(t, labeled.Node.Id, common.FindTimelineEntry(entries, "LE_1").Key())
+	require.Nil(t, common.FindTimelineEntry(entries, ""))
+}
+
+func TestQuoteReply(t *testing.T) {
+	require.Equal(t, "> Looks good\n>\n> but fix the typo\n\n",
+		common.QuoteReply("Looks good\r\n\r\nbut fix the typo\n"))
+}
@@ -2,6 +2,7 @@ package branch
 
 import (
 	"fmt"
+	"os"
 	"strings"
 
 	"charm.land/lipgloss/v2"
@@ -16,8 +17,12 @@ import (
 )
 
 type Branch struct {
-	Ctx     *context.ProgramContext
-	PR      *data.PullRequestData
+	Ctx *context.ProgramContext
+	PR  *data.PullRequestData
+	// Worktree is the worktree a PR was checked out in with the branch, if any
+	Worktree *data.Worktree
+	// Status is the status of the working tree the branch is checked out in, if it is
+	Status  *git.Status
 	Data    git.Branch
 	Columns []table.Column
 }
@@ -263,9 +268,46 @@ func (b *Branch) renderBranch(isSelected bool, width int) string {
 		lipgloss.Top,
 		name,
 		b.renderCommitsAheadBehind(isSelected),
+		b.renderStatus(isSelected),
+		b.renderWorktree(isSelected),
 	))
 }
 
+func (b *Branch) renderStatus(isSelected bool) string {
+	if b.Status == nil {
+		return ""
+	}
+	baseStyle := b.getBaseStyle(isSelected)
+	conflicts := len(b.Status.Conflicts)
+	switch {
+	case b.Status.RebasingBranch == b.Data.Name && conflicts > 0:
+		return baseStyle.Foreground(b.Ctx.Theme.ErrorText).Render(
+			fmt.Sprintf(" %s rebasing, %d conflicts", constants.ConflictIcon, conflicts))
+	case b.Status.RebasingBranch == b.Data.Name:
+		return baseStyle.Foreground(b.Ctx.Theme.WarningText).Render(
+			fmt.Sprintf(" %s rebasing", constants.ConflictIcon))
+	case conflicts > 0:
+		return baseStyle.Foreground(b.Ctx.Theme.ErrorText).Render(
+			fmt.Sprintf(" %s %d conflicts", constants.ConflictIcon, conflicts))
+	case b.Status.IsDirty():
+		return baseStyle.Foreground(b.Ctx.Theme.WarningText).Render(
+			fmt.Sprintf(" %s dirty", constants.DirtyIcon))
+	}
+	return ""
+}
+
+func (b *Branch) renderWorktree(isSelected bool) string {
+	if b.Worktree == nil {
+		return ""
+	}
+	path := b.Worktree.Path
+	if homeDir, err := os.UserHomeDir(); err == nil && strings.HasPrefix(path, homeDir) {
+		path = "~" + strings.TrimPrefix(path, homeDir)
+	}
+	return b.getBaseStyle(isSelected).Foreground(b.Ctx.Theme.FaintText).Render(
+		fmt.Sprintf(" %s %s", constants.WorktreeIcon, path))
+}
+
 func (b *Branch) getBaseStyle(isSelected bool) lipgloss.Style {
 	baseStyle := lipgloss.NewStyle()
 	if isSelected {
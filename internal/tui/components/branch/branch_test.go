@@ -0,0 +1,33 @@
+package branch
+
+import (
+	"testing"
+
+	gitm "github.com/aymanbagabas/git-module"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/git"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/theme"
+)
+
+func TestBranch_RenderStatus(t *testing.T) {
+	ctx := &context.ProgramContext{Theme: *theme.DefaultTheme}
+	b := Branch{Ctx: ctx, Data: git.Branch{Name: "feature", IsCheckedOut: true}}
+	require.Empty(t, b.renderStatus(false), "branches that aren't checked out have no status")
+
+	b.Status = &git.Status{}
+	require.Empty(t, b.renderStatus(false))
+
+	b.Status = &git.Status{NameStatus: gitm.NameStatus{Modified: []string{"main.go"}}}
+	require.Contains(t, b.renderStatus(false), "dirty")
+
+	b.Status.Conflicts = []string{"main.go", "go.mod"}
+	require.Contains(t, b.renderStatus(false), "2 conflicts")
+
+	b.Status.RebasingBranch = "feature"
+	require.Contains(t, b.renderStatus(false), "rebasing, 2 conflicts")
+
+	b.Status.Conflicts = nil
+	require.Contains(t, b.renderStatus(false), "rebasing")
+}
@@ -6,7 +6,6 @@ import (
 
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
-	gitm "github.com/aymanbagabas/git-module"
 
 	"github.com/dlvhdr/gh-dash/v4/internal/git"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/branch"
@@ -16,7 +15,7 @@ import (
 type Model struct {
 	ctx    *context.ProgramContext
 	branch *branch.BranchData
-	status *gitm.NameStatus
+	status *git.Status
 }
 
 func NewModel(ctx *context.ProgramContext) Model {
@@ -41,10 +40,15 @@ func (m Model) View() string {
 	if m.status == nil {
 		s.WriteString("\nLoading...")
 	} else {
-		if len(m.status.Added) == 0 && len(m.status.Removed) == 0 && len(m.status.Modified) == 0 {
+		if m.status.RebasingBranch != "" {
+			fmt.Fprintf(&s, "\nRebase of %s stopped", m.status.RebasingBranch)
+		} else if !m.status.IsDirty() {
 			s.WriteString("\nNo changes")
 		}
 
+		for _, file := range m.status.Conflicts {
+			fmt.Fprintf(&s, "\nU %s", file)
+		}
 		for _, file := range m.status.Added {
 			fmt.Fprintf(&s, "\nA %s", file)
 		}
@@ -76,7 +80,7 @@ func (m Model) View() string {
 }
 
 type updateBranchStatusMsg struct {
-	status gitm.NameStatus
+	status git.Status
 }
 
 func (m *Model) SetRow(b *branch.BranchData) tea.Cmd {
@@ -23,7 +23,7 @@ func testCtx() *context.ProgramContext {
 func TestView_NoBranch(t *testing.T) {
 	m := NewModel(testCtx())
 	m.ctx = testCtx()
-	m.status = &gitm.NameStatus{}
+	m.status = &git.Status{}
 
 	got := m.View()
 	require.Equal(t, "No branch selected", got)
@@ -43,7 +43,7 @@ func TestView_NoChanges(t *testing.T) {
 	m := NewModel(testCtx())
 	m.ctx = testCtx()
 	m.branch = &branch.BranchData{Data: git.Branch{Name: "main"}}
-	m.status = &gitm.NameStatus{}
+	m.status = &git.Status{}
 
 	got := m.View()
 	require.Contains(t, got, "No changes")
@@ -54,11 +54,11 @@ func TestView_WithFileChanges(t *testing.T) {
 	m := NewModel(testCtx())
 	m.ctx = testCtx()
 	m.branch = &branch.BranchData{Data: git.Branch{Name: "feature"}}
-	m.status = &gitm.NameStatus{
+	m.status = &git.Status{NameStatus: gitm.NameStatus{
 		Added:    []string{"new.go"},
 		Removed:  []string{"old.go"},
 		Modified: []string{"changed.go"},
-	}
+	}}
 
 	got := m.View()
 	require.Contains(t, got, "A new.go")
@@ -67,6 +67,22 @@ func TestView_WithFileChanges(t *testing.T) {
 	require.Contains(t, got, "feature")
 }
 
+func TestView_StoppedRebase(t *testing.T) {
+	m := NewModel(testCtx())
+	m.ctx = testCtx()
+	m.branch = &branch.BranchData{Data: git.Branch{Name: "feature"}}
+	m.status = &git.Status{
+		NameStatus:     gitm.NameStatus{Modified: []string{"main.go"}},
+		Conflicts:      []string{"main.go"},
+		RebasingBranch: "feature",
+	}
+
+	got := m.View()
+	require.Contains(t, got, "Rebase of feature stopped")
+	require.Contains(t, got, "U main.go")
+	require.NotContains(t, got, "No changes")
+}
+
 func TestView_WithPR(t *testing.T) {
 	m := NewModel(testCtx())
 	m.ctx = testCtx()
@@ -74,7 +90,7 @@ func TestView_WithPR(t *testing.T) {
 		Data: git.Branch{Name: "feature"},
 		PR:   &data.PullRequestData{Number: 42, Title: "Add feature"},
 	}
-	m.status = &gitm.NameStatus{}
+	m.status = &git.Status{}
 
 	got := m.View()
 	require.Contains(t, got, "#42 Add feature")
@@ -0,0 +1,358 @@
+// Package checkswatcher polls the status checks of the PRs in a watch list and reports the
+// checks that changed state, until all the checks of a PR have finished.
+package checkswatcher
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/log/v2"
+	ghchecks "github.com/dlvhdr/x/gh-checks"
+	"github.com/gen2brain/beeep"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+const pollInterval = 10 * time.Second
+
+// PR is a PR in the watch list.
+type PR struct {
+	Url               string
+	Number            int
+	RepoNameWithOwner string
+	Title             string
+}
+
+// ToggleWatchMsg asks the program to add a PR to the watch list, or to remove it when it's
+// already watched.
+type ToggleWatchMsg struct {
+	PR PR
+}
+
+// Transition is a check that changed state between two polls.
+type Transition struct {
+	Name string
+	// From is the previous state of the check, or empty when the check just started.
+	From string
+	To   string
+}
+
+// Describe returns a short description of the transition, e.g. "lint failed".
+func (t Transition) Describe() string {
+	switch {
+	case isWaiting(t.To):
+		return fmt.Sprintf("%s is running", t.Name)
+	case isFailure(t.To):
+		return fmt.Sprintf("%s failed", t.Name)
+	case ghchecks.IsConclusionASkip(t.To):
+		return fmt.Sprintf("%s was skipped", t.Name)
+	case t.To == string(ghchecks.CheckRunStateCancelled):
+		return fmt.Sprintf("%s was cancelled", t.Name)
+	default:
+		return fmt.Sprintf("%s passed", t.Name)
+	}
+}
+
+// ChecksUpdatedMsg is sent every time the checks of a watched PR have been polled.
+type ChecksUpdatedMsg struct {
+	PR          PR
+	Commits     data.LastCommitWithStatusChecks
+	Transitions []Transition
+	Completed   int
+	Total       int
+	// Finished is set on the last message sent for a PR, once all its checks have completed.
+	// The PR is no longer watched at that point.
+	Finished bool
+	Err      error
+}
+
+// Failed returns whether the checks of the PR have failed.
+func (msg ChecksUpdatedMsg) Failed() bool {
+	state := rollupState(msg.Commits)
+	return state == ghchecks.CommitStateFailure || state == ghchecks.CommitStateError
+}
+
+type watchedPR struct {
+	pr     PR
+	cancel context.CancelFunc
+}
+
+// Watcher polls the checks of each watched PR in its own goroutine. The updates are received
+// by the program through WaitForUpdate, and Stop ends all the goroutines when the program
+// exits.
+type Watcher struct {
+	interval time.Duration
+	fetch    func(ctx context.Context, prUrl string) (data.LastCommitWithStatusChecks, error)
+	notify   func(title, body string) error
+
+	ctx     context.Context
+	cancel  context.CancelFunc
+	updates chan ChecksUpdatedMsg
+	wg      sync.WaitGroup
+
+	mu      sync.Mutex
+	watched []*watchedPR
+}
+
+func New() *Watcher {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Watcher{
+		interval: pollInterval,
+		fetch:    data.FetchPullRequestChecks,
+		notify:   notify,
+		ctx:      ctx,
+		cancel:   cancel,
+		updates:  make(chan ChecksUpdatedMsg),
+	}
+}
+
+func notify(title, body string) error {
+	return beeep.Notify(title, body, "")
+}
+
+// Watch adds a PR to the watch list. It returns false when the PR is already watched.
+func (w *Watcher) Watch(pr PR) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.indexOf(pr.Url) != -1 || w.ctx.Err() != nil {
+		return false
+	}
+
+	ctx, cancel := context.WithCancel(w.ctx)
+	watched := &watchedPR{pr: pr, cancel: cancel}
+	w.watched = append(w.watched, watched)
+	w.wg.Add(1)
+	go w.poll(ctx, watched)
+	return true
+}
+
+// Unwatch removes a PR from the watch list. It returns false when the PR wasn't watched.
+func (w *Watcher) Unwatch(prUrl string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	i := w.indexOf(prUrl)
+	if i == -1 {
+		return false
+	}
+	w.watched[i].cancel()
+	w.watched = slices.Delete(w.watched, i, i+1)
+	return true
+}
+
+func (w *Watcher) IsWatching(prUrl string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.indexOf(prUrl) != -1
+}
+
+// Watched returns the watch list, in the order the PRs were added.
+func (w *Watcher) Watched() []PR {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	prs := make([]PR, 0, len(w.watched))
+	for _, watched := range w.watched {
+		prs = append(prs, watched.pr)
+	}
+	return prs
+}
+
+func (w *Watcher) indexOf(prUrl string) int {
+	return slices.IndexFunc(w.watched, func(watched *watchedPR) bool {
+		return watched.pr.Url == prUrl
+	})
+}
+
+// remove takes a PR whose checks have finished off the watch list, unless it has been
+// unwatched, and maybe watched again, in the meantime.
+func (w *Watcher) remove(watched *watchedPR) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.watched = slices.DeleteFunc(w.watched, func(other *watchedPR) bool {
+		return other == watched
+	})
+}
+
+// WaitForUpdate returns a command receiving the next update of the checks of a watched PR. The
+// program should call it again after each ChecksUpdatedMsg.
+func (w *Watcher) WaitForUpdate() tea.Cmd {
+	return func() tea.Msg {
+		select {
+		case msg := <-w.updates:
+			return msg
+		case <-w.ctx.Done():
+			return nil
+		}
+	}
+}
+
+// Stop cancels the polling of all watched PRs and waits for their goroutines to return.
+func (w *Watcher) Stop() {
+	w.cancel()
+	w.wg.Wait()
+}
+
+func (w *Watcher) poll(ctx context.Context, watched *watchedPR) {
+	defer w.wg.Done()
+	defer watched.cancel()
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	var states map[string]string
+	for {
+		msg := ChecksUpdatedMsg{PR: watched.pr}
+		commits, err := w.fetch(ctx, watched.pr.Url)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			log.Error("Error polling PR checks", "url", watched.pr.Url, "err", err)
+			msg.Err = err
+		} else {
+			current := checkStates(commits)
+			msg.Commits = commits
+			msg.Transitions = diffStates(states, current)
+			msg.Total = len(current)
+			msg.Completed = countCompleted(current)
+			msg.Finished = isFinished(commits, current)
+			states = current
+		}
+
+		if msg.Finished {
+			w.remove(watched)
+			w.notifyFinished(msg)
+		}
+
+		select {
+		case w.updates <- msg:
+		case <-ctx.Done():
+			return
+		}
+		if msg.Finished {
+			return
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (w *Watcher) notifyFinished(msg ChecksUpdatedMsg) {
+	rollup := "✅ Checks have passed"
+	if msg.Failed() {
+		rollup = "❌ Checks have failed"
+	}
+	err := w.notify(
+		fmt.Sprintf("gh-dash: %s", msg.PR.Title),
+		fmt.Sprintf("PR #%d in %s\n%s", msg.PR.Number, msg.PR.RepoNameWithOwner, rollup),
+	)
+	if err != nil {
+		log.Error("Error showing system notification", "err", err)
+	}
+}
+
+func rollupState(commits data.LastCommitWithStatusChecks) ghchecks.CommitState {
+	if len(commits.Nodes) == 0 {
+		return ghchecks.CommitStateUnknown
+	}
+	return ghchecks.CommitState(commits.Nodes[0].Commit.StatusCheckRollup.State)
+}
+
+// checkStates returns the state of each check of the last commit by the name of the check. The
+// state is the status of a check run that hasn't completed, or its conclusion otherwise.
+func checkStates(commits data.LastCommitWithStatusChecks) map[string]string {
+	states := make(map[string]string)
+	if len(commits.Nodes) == 0 {
+		return states
+	}
+	for _, node := range commits.Nodes[0].Commit.StatusCheckRollup.Contexts.Nodes {
+		switch node.Typename {
+		case "CheckRun":
+			name := checkRunName(node.CheckRun)
+			if ghchecks.IsStatusWaiting(string(node.CheckRun.Status)) {
+				states[name] = string(node.CheckRun.Status)
+			} else {
+				states[name] = string(node.CheckRun.Conclusion)
+			}
+		case "StatusContext":
+			states[string(node.StatusContext.Context)] = string(node.StatusContext.State)
+		}
+	}
+	return states
+}
+
+func checkRunName(checkRun data.CheckRun) string {
+	workflow := strings.TrimSpace(string(checkRun.CheckSuite.WorkflowRun.Workflow.Name))
+	name := strings.TrimSpace(string(checkRun.Name))
+	if workflow == "" {
+		return name
+	}
+	return fmt.Sprintf("%s/%s", workflow, name)
+}
+
+// diffStates returns the checks whose state changed, sorted by name. Nothing changed on the
+// first poll of a PR, when there are no previous states.
+func diffStates(previous, current map[string]string) []Transition {
+	if previous == nil {
+		return nil
+	}
+	transitions := make([]Transition, 0)
+	for name, state := range current {
+		if before, ok := previous[name]; !ok || before != state {
+			transitions = append(transitions, Transition{Name: name, From: before, To: state})
+		}
+	}
+	slices.SortFunc(transitions, func(a, b Transition) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return transitions
+}
+
+func countCompleted(states map[string]string) int {
+	completed := 0
+	for _, state := range states {
+		if !isWaiting(state) {
+			completed++
+		}
+	}
+	return completed
+}
+
+// isFinished returns whether all the checks of the last commit have completed, including the
+// workflows that are queued but don't have check runs yet.
+func isFinished(commits data.LastCommitWithStatusChecks, states map[string]string) bool {
+	switch rollupState(commits) {
+	case ghchecks.CommitStatePending, ghchecks.CommitStateExpected:
+		return false
+	}
+	if len(commits.Nodes) == 0 {
+		return true
+	}
+	if countCompleted(states) != len(states) {
+		return false
+	}
+	for _, suite := range commits.Nodes[0].Commit.CheckSuites.Nodes {
+		if ghchecks.IsStatusWaiting(string(suite.Status)) {
+			return false
+		}
+	}
+	return true
+}
+
+// isWaiting returns whether the state of a check run or a status context is one that hasn't
+// completed yet.
+func isWaiting(state string) bool {
+	return ghchecks.IsStatusWaiting(state) || state == string(ghchecks.CommitStateExpected)
+}
+
+func isFailure(state string) bool {
+	return ghchecks.IsConclusionAFailure(state) || state == string(ghchecks.CommitStateError)
+}
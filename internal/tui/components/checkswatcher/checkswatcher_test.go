@@ -0,0 +1,175 @@
+package checkswatcher
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+type testCheck struct {
+	name   string
+	status string
+	// conclusion is only set once the check has completed
+	conclusion string
+}
+
+func newCommits(t *testing.T, rollup string, checks ...testCheck) data.LastCommitWithStatusChecks {
+	t.Helper()
+	nodes := make([]map[string]any, 0, len(checks))
+	for _, c := range checks {
+		nodes = append(nodes, map[string]any{
+			"Typename": "CheckRun",
+			"CheckRun": map[string]any{
+				"Name":       c.name,
+				"Status":     c.status,
+				"Conclusion": c.conclusion,
+				"CheckSuite": map[string]any{
+					"WorkflowRun": map[string]any{"Workflow": map[string]any{"Name": "CI"}},
+				},
+			},
+		})
+	}
+	raw, err := json.Marshal(map[string]any{"Nodes": []any{map[string]any{
+		"Commit": map[string]any{"StatusCheckRollup": map[string]any{
+			"State":    rollup,
+			"Contexts": map[string]any{"Nodes": nodes},
+		}},
+	}}})
+	require.NoError(t, err)
+
+	var commits data.LastCommitWithStatusChecks
+	require.NoError(t, json.Unmarshal(raw, &commits))
+	return commits
+}
+
+type fetchResult struct {
+	commits data.LastCommitWithStatusChecks
+	err     error
+}
+
+// newTestWatcher returns a watcher that polls right away and whose fetches return the given
+// results one after the other.
+func newTestWatcher(results chan fetchResult) (*Watcher, *[]string) {
+	w := New()
+	w.interval = time.Millisecond
+	w.fetch = func(ctx context.Context, prUrl string) (data.LastCommitWithStatusChecks, error) {
+		select {
+		case res := <-results:
+			return res.commits, res.err
+		case <-ctx.Done():
+			return data.LastCommitWithStatusChecks{}, ctx.Err()
+		}
+	}
+	notified := make([]string, 0)
+	w.notify = func(title, body string) error {
+		notified = append(notified, body)
+		return nil
+	}
+	return w, &notified
+}
+
+func waitForUpdate(t *testing.T, w *Watcher) ChecksUpdatedMsg {
+	t.Helper()
+	msg, ok := w.WaitForUpdate()().(ChecksUpdatedMsg)
+	require.True(t, ok)
+	return msg
+}
+
+func TestWatcher_ReportsTransitionsUntilFinished(t *testing.T) {
+	results := make(chan fetchResult, 3)
+	w, notified := newTestWatcher(results)
+	defer w.Stop()
+
+	pr := PR{Url: "https://github.com/o/r/pull/1", Number: 1, RepoNameWithOwner: "o/r"}
+	results <- fetchResult{commits: newCommits(t, "PENDING",
+		testCheck{name: "lint", status: "IN_PROGRESS"},
+		testCheck{name: "test", status: "QUEUED"},
+	)}
+	results <- fetchResult{commits: newCommits(t, "PENDING",
+		testCheck{name: "lint", status: "COMPLETED", conclusion: "SUCCESS"},
+		testCheck{name: "test", status: "IN_PROGRESS"},
+	)}
+	results <- fetchResult{commits: newCommits(t, "FAILURE",
+		testCheck{name: "lint", status: "COMPLETED", conclusion: "SUCCESS"},
+		testCheck{name: "test", status: "COMPLETED", conclusion: "FAILURE"},
+	)}
+
+	require.True(t, w.Watch(pr))
+	require.False(t, w.Watch(pr), "a PR is only watched once")
+	require.Equal(t, []PR{pr}, w.Watched())
+
+	msg := waitForUpdate(t, w)
+	require.Empty(t, msg.Transitions, "the first poll has nothing to compare with")
+	require.Equal(t, 0, msg.Completed)
+	require.Equal(t, 2, msg.Total)
+	require.False(t, msg.Finished)
+
+	msg = waitForUpdate(t, w)
+	require.Equal(t, []Transition{
+		{Name: "CI/lint", From: "IN_PROGRESS", To: "SUCCESS"},
+		{Name: "CI/test", From: "QUEUED", To: "IN_PROGRESS"},
+	}, msg.Transitions)
+	require.Equal(t, "CI/lint passed", msg.Transitions[0].Describe())
+	require.Equal(t, "CI/test is running", msg.Transitions[1].Describe())
+	require.Equal(t, 1, msg.Completed)
+
+	msg = waitForUpdate(t, w)
+	require.Equal(t, "CI/test failed", msg.Transitions[0].Describe())
+	require.True(t, msg.Finished)
+	require.True(t, msg.Failed())
+	require.False(t, w.IsWatching(pr.Url), "finished PRs leave the watch list")
+	require.Equal(t, []string{"PR #1 in o/r\n❌ Checks have failed"}, *notified)
+}
+
+func TestWatcher_KeepsPollingAfterAnError(t *testing.T) {
+	results := make(chan fetchResult, 2)
+	w, _ := newTestWatcher(results)
+	defer w.Stop()
+
+	failure := errors.New("bad gateway")
+	results <- fetchResult{err: failure}
+	results <- fetchResult{commits: newCommits(t, "SUCCESS",
+		testCheck{name: "lint", status: "COMPLETED", conclusion: "SUCCESS"},
+	)}
+
+	w.Watch(PR{Url: "https://github.com/o/r/pull/2"})
+
+	msg := waitForUpdate(t, w)
+	require.ErrorIs(t, msg.Err, failure)
+	require.False(t, msg.Finished)
+
+	msg = waitForUpdate(t, w)
+	require.NoError(t, msg.Err)
+	require.True(t, msg.Finished)
+	require.False(t, msg.Failed())
+}
+
+func TestWatcher_UnwatchAndStop(t *testing.T) {
+	w, _ := newTestWatcher(make(chan fetchResult))
+
+	require.True(t, w.Watch(PR{Url: "https://github.com/o/r/pull/1"}))
+	require.True(t, w.Watch(PR{Url: "https://github.com/o/r/pull/2"}))
+	require.True(t, w.Unwatch("https://github.com/o/r/pull/1"))
+	require.False(t, w.Unwatch("https://github.com/o/r/pull/1"))
+	require.Equal(t, []PR{{Url: "https://github.com/o/r/pull/2"}}, w.Watched())
+
+	stopped := make(chan struct{})
+	go func() {
+		w.Stop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("Stop should end the goroutines of the watched PRs")
+	}
+
+	require.Nil(t, w.WaitForUpdate()(), "no updates are received after stopping")
+	require.False(t, w.Watch(PR{Url: "https://github.com/o/r/pull/3"}))
+}
@@ -28,6 +28,18 @@ const (
 	ModeUnassign
 	ModeLabel
 	ModeSearch
+	ModeReply
+	ModeReview
+	ModeMergeMessage
+	ModeReleaseTag
+	ModeProjectField
+	ModeMilestone
+	ModeNewIssue
+	ModeNewIssueList
+	ModeRequestReviewers
+	ModeRemoveReviewers
+	ModeReaction
+	ModeTimelineAction
 )
 
 type FetchPolicy int
@@ -305,14 +317,24 @@ func (c *Controller) clearRelevantCache() {
 		if c.repo.NameWithOwner != "" {
 			data.ClearRepoUserCache(c.repo.NameWithOwner)
 		}
+	case *fuzzyselect.ReviewerSource:
+		if c.repo.NameWithOwner != "" {
+			data.ClearRepoUserCache(c.repo.NameWithOwner)
+			data.ClearOrgTeamCache(c.repo.Owner)
+		}
 	case *fuzzyselect.LabelSource:
 		if c.repo.NameWithOwner != "" {
 			data.ClearRepoLabelCache(c.repo.NameWithOwner)
 		}
+	case *fuzzyselect.MilestoneSource:
+		if c.repo.NameWithOwner != "" {
+			data.ClearRepoMilestoneCache(c.repo.NameWithOwner)
+		}
 	case *fuzzyselect.SearchQuerySource:
 		if c.repo.NameWithOwner != "" {
 			data.ClearRepoLabelCache(c.repo.NameWithOwner)
 			data.ClearRepoUserCache(c.repo.NameWithOwner)
+			data.ClearRepoMilestoneCache(c.repo.NameWithOwner)
 		}
 	}
 }
@@ -349,6 +371,15 @@ func (c *Controller) ShowCompletions() {
 	}
 }
 
+// SetPrompt replaces the prompt of the active input, e.g. to reflect an option picked while
+// typing.
+func (c *Controller) SetPrompt(prompt string) {
+	c.prompt = prompt
+	if !c.showConfirmCancel {
+		c.inputBox.SetPrompt(prompt)
+	}
+}
+
 func (c *Controller) restorePrompt() {
 	c.inputBox.SetPrompt(c.prompt)
 	c.showConfirmCancel = false
@@ -368,7 +399,9 @@ func (c *Controller) resetAutocompleteState() {
 
 func (c Controller) usesAutocomplete() bool {
 	switch c.mode {
-	case ModeComment, ModeApprove, ModeAssign, ModeLabel, ModeSearch:
+	case ModeComment, ModeApprove, ModeAssign, ModeLabel, ModeSearch, ModeReply, ModeReview,
+		ModeProjectField, ModeMilestone, ModeNewIssueList, ModeRequestReviewers, ModeReaction,
+		ModeTimelineAction:
 		return true
 	default:
 		return false
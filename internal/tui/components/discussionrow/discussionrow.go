@@ -0,0 +1,119 @@
+package discussionrow
+
+import (
+	"fmt"
+
+	"charm.land/lipgloss/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/table"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+type Discussion struct {
+	Ctx            *context.ProgramContext
+	Data           data.DiscussionData
+	ShowAuthorIcon bool
+}
+
+func (discussion *Discussion) ToTableRow() table.Row {
+	return table.Row{
+		discussion.renderStatus(),
+		discussion.renderRepoName(),
+		discussion.renderTitle(),
+		discussion.renderCategory(),
+		discussion.renderAuthor(),
+		discussion.renderNumComments(),
+		discussion.renderNumUpvotes(),
+		discussion.renderUpdateAt(),
+		discussion.renderCreatedAt(),
+	}
+}
+
+func (discussion *Discussion) getTextStyle() lipgloss.Style {
+	return components.GetIssueTextStyle(discussion.Ctx)
+}
+
+// state is the state of the discussion in the terms used for issues, so closed discussions
+// are rendered like closed issues.
+func (discussion *Discussion) state() string {
+	if discussion.Data.Closed {
+		return "CLOSED"
+	}
+	return "OPEN"
+}
+
+func (discussion *Discussion) renderUpdateAt() string {
+	timeFormat := discussion.Ctx.Config.Defaults.DateFormat
+
+	updatedAtOutput := ""
+	if timeFormat == "" || timeFormat == "relative" {
+		updatedAtOutput = utils.TimeElapsed(discussion.Data.UpdatedAt)
+	} else {
+		updatedAtOutput = discussion.Data.UpdatedAt.Format(timeFormat)
+	}
+
+	return discussion.getTextStyle().Render(updatedAtOutput)
+}
+
+func (discussion *Discussion) renderCreatedAt() string {
+	timeFormat := discussion.Ctx.Config.Defaults.DateFormat
+
+	createdAtOutput := ""
+	if timeFormat == "" || timeFormat == "relative" {
+		createdAtOutput = utils.TimeElapsed(discussion.Data.CreatedAt)
+	} else {
+		createdAtOutput = discussion.Data.CreatedAt.Format(timeFormat)
+	}
+
+	return discussion.getTextStyle().Render(createdAtOutput)
+}
+
+func (discussion *Discussion) renderRepoName() string {
+	return discussion.getTextStyle().Render(discussion.Data.Repository.Name)
+}
+
+func (discussion *Discussion) renderTitle() string {
+	return components.RenderIssueTitle(
+		discussion.Ctx,
+		discussion.state(),
+		discussion.Data.Title,
+		discussion.Data.Number,
+	)
+}
+
+func (discussion *Discussion) renderCategory() string {
+	return discussion.getTextStyle().Render(discussion.Data.Category.Name)
+}
+
+func (discussion *Discussion) renderAuthor() string {
+	return discussion.getTextStyle().Render(
+		discussion.Data.GetAuthor(discussion.Ctx.Theme, discussion.ShowAuthorIcon))
+}
+
+func (discussion *Discussion) renderStatus() string {
+	switch {
+	case discussion.Data.Closed:
+		return discussion.getTextStyle().Render(constants.DiscussionIcon)
+	case discussion.Data.IsAnswered:
+		return lipgloss.NewStyle().
+			Foreground(discussion.Ctx.Theme.SuccessText).
+			Render(constants.AnsweredIcon)
+	default:
+		return lipgloss.NewStyle().
+			Foreground(discussion.Ctx.Styles.Colors.OpenIssue).
+			Render(constants.DiscussionIcon)
+	}
+}
+
+func (discussion *Discussion) renderNumComments() string {
+	return discussion.getTextStyle().Render(
+		fmt.Sprintf("%d", discussion.Data.Comments.TotalCount))
+}
+
+func (discussion *Discussion) renderNumUpvotes() string {
+	return discussion.getTextStyle().Render(fmt.Sprintf("%d", discussion.Data.UpvoteCount))
+}
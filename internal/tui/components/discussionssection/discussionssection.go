@@ -0,0 +1,446 @@
+package discussionssection
+
+import (
+	"fmt"
+	"time"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/discussionrow"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/table"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+const SectionType = "discussion"
+
+var discussionNumCountCellWidth = 6
+
+type Model struct {
+	section.BaseModel
+	Discussions []data.DiscussionData
+}
+
+func NewModel(
+	id int,
+	ctx *context.ProgramContext,
+	cfg config.DiscussionsSectionConfig,
+	lastUpdated time.Time,
+	createdAt time.Time,
+) Model {
+	m := Model{}
+	m.BaseModel = section.NewModel(
+		ctx,
+		section.NewSectionOptions{
+			Id:          id,
+			Config:      cfg.ToSectionConfig(),
+			Type:        SectionType,
+			Columns:     GetSectionColumns(cfg, ctx),
+			Singular:    m.GetItemSingularForm(),
+			Plural:      m.GetItemPluralForm(),
+			LastUpdated: lastUpdated,
+			CreatedAt:   createdAt,
+		},
+	)
+	m.Discussions = []data.DiscussionData{}
+
+	return m
+}
+
+func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.KeyPressMsg:
+
+		if m.IsSearchFocused() {
+			switch msg.String() {
+			case "ctrl+c", "esc":
+				m.SearchBar.SetValue(m.SearchValue)
+				blinkCmd := m.SetIsSearching(false)
+				return m, blinkCmd
+
+			case "enter":
+				m.SearchValue = m.SearchBar.Value()
+				m.SyncSmartFilterWithSearchValue()
+				m.SetIsSearching(false)
+				m.ResetRows()
+				return m, tea.Batch(m.FetchNextPageSectionRows()...)
+			}
+
+			break
+		}
+
+		if key.Matches(msg, keys.DiscussionKeys.ToggleSmartFiltering) {
+			if m.HasCurrentRepoNameInConfiguredFilter() || !m.HasRepoNameInConfiguredFilter() {
+				m.IsFilteredByCurrentRemote = !m.IsFilteredByCurrentRemote
+			}
+			searchValue := m.GetSearchValue()
+			if m.SearchValue != searchValue {
+				m.SearchValue = searchValue
+				m.SearchBar.SetValue(searchValue)
+				m.SetIsSearching(false)
+				m.ResetRows()
+				return m, tea.Batch(m.FetchNextPageSectionRows()...)
+			}
+		}
+
+	case tasks.UpdateDiscussionMsg:
+		for i := range m.Discussions {
+			if m.Discussions[i].Id == msg.DiscussionId {
+				applyUpdate(&m.Discussions[i], msg)
+				m.SetIsLoading(false)
+				m.Table.SetRows(m.BuildRows())
+				break
+			}
+		}
+
+	case SectionDiscussionsFetchedMsg:
+		if m.LastFetchTaskId == msg.TaskId {
+			m.IsStale = false
+			if m.PageInfo != nil {
+				m.Discussions = append(m.Discussions, msg.Discussions...)
+			} else {
+				m.Discussions = msg.Discussions
+			}
+			m.TotalCount = msg.TotalCount
+			m.SetIsLoading(false)
+			m.PageInfo = &msg.PageInfo
+			m.Table.SetRows(m.BuildRows())
+			m.UpdateLastUpdated(time.Now())
+			m.UpdateTotalItemsCount(m.TotalCount)
+		}
+	}
+
+	search, searchCmd := m.SearchBar.Update(msg)
+	m.SearchBar = search
+
+	prompt, promptCmd := m.PromptConfirmationBox.Update(msg)
+	m.PromptConfirmationBox = prompt
+
+	table, tableCmd := m.Table.Update(msg)
+	m.Table = table
+
+	return m, tea.Batch(cmd, searchCmd, promptCmd, tableCmd)
+}
+
+// applyUpdate applies the result of an action on the discussion, so it is shown without
+// refetching the section.
+func applyUpdate(discussion *data.DiscussionData, msg tasks.UpdateDiscussionMsg) {
+	if msg.NewComment != nil {
+		discussion.Comments.Nodes = append(discussion.Comments.Nodes, *msg.NewComment)
+		discussion.Comments.TotalCount++
+	}
+	if msg.AnswerId != nil {
+		discussion.Answer = nil
+		for i := range discussion.Comments.Nodes {
+			comment := &discussion.Comments.Nodes[i]
+			comment.IsAnswer = comment.Id != "" && comment.Id == *msg.AnswerId
+			if comment.IsAnswer {
+				answer := *comment
+				discussion.Answer = &answer
+			}
+		}
+		discussion.IsAnswered = discussion.Answer != nil
+	}
+}
+
+func GetSectionColumns(
+	cfg config.DiscussionsSectionConfig,
+	ctx *context.ProgramContext,
+) []table.Column {
+	dLayout := ctx.Config.Defaults.Layout.Discussions
+	sLayout := cfg.Layout
+
+	updatedAtLayout := config.MergeColumnConfigs(
+		dLayout.UpdatedAt,
+		sLayout.UpdatedAt,
+	)
+	createdAtLayout := config.MergeColumnConfigs(
+		dLayout.CreatedAt,
+		sLayout.CreatedAt,
+	)
+	stateLayout := config.MergeColumnConfigs(dLayout.State, sLayout.State)
+	repoLayout := config.MergeColumnConfigs(dLayout.Repo, sLayout.Repo)
+	titleLayout := config.MergeColumnConfigs(dLayout.Title, sLayout.Title)
+	categoryLayout := config.MergeColumnConfigs(dLayout.Category, sLayout.Category)
+	authorLayout := config.MergeColumnConfigs(dLayout.Author, sLayout.Author)
+	commentsLayout := config.MergeColumnConfigs(
+		dLayout.Comments,
+		sLayout.Comments,
+	)
+	upvotesLayout := config.MergeColumnConfigs(
+		dLayout.Upvotes,
+		sLayout.Upvotes,
+	)
+
+	return []table.Column{
+		{
+			Title:  "",
+			Width:  stateLayout.Width,
+			Hidden: stateLayout.Hidden,
+		},
+		{
+			Title:  "",
+			Width:  repoLayout.Width,
+			Hidden: repoLayout.Hidden,
+		},
+		{
+			Title:  "Title",
+			Grow:   utils.BoolPtr(true),
+			Hidden: titleLayout.Hidden,
+		},
+		{
+			Title:  "Category",
+			Width:  categoryLayout.Width,
+			Hidden: categoryLayout.Hidden,
+		},
+		{
+			Title:  "Author",
+			Width:  authorLayout.Width,
+			Hidden: authorLayout.Hidden,
+		},
+		{
+			Title:  constants.CommentsIcon,
+			Width:  &discussionNumCountCellWidth,
+			Hidden: commentsLayout.Hidden,
+		},
+		{
+			Title:  constants.UpvoteIcon,
+			Width:  &discussionNumCountCellWidth,
+			Hidden: upvotesLayout.Hidden,
+		},
+		{
+			Title:  "󱦻",
+			Width:  updatedAtLayout.Width,
+			Hidden: updatedAtLayout.Hidden,
+		},
+		{
+			Title:  "󱡢",
+			Width:  createdAtLayout.Width,
+			Hidden: createdAtLayout.Hidden,
+		},
+	}
+}
+
+func (m Model) BuildRows() []table.Row {
+	var rows []table.Row
+	for _, currDiscussion := range m.Discussions {
+		discussionModel := discussionrow.Discussion{
+			Ctx:            m.Ctx,
+			Data:           currDiscussion,
+			ShowAuthorIcon: m.ShowAuthorIcon,
+		}
+		rows = append(rows, discussionModel.ToTableRow())
+	}
+
+	if rows == nil {
+		rows = []table.Row{}
+	}
+
+	return rows
+}
+
+func (m *Model) NumRows() int {
+	return len(m.Discussions)
+}
+
+func (m *Model) GetCurrRow() data.RowData {
+	idx := m.Table.GetCurrItem()
+	if idx < 0 || idx >= len(m.Discussions) {
+		return nil
+	}
+	discussion := m.Discussions[idx]
+	return &discussion
+}
+
+// ToggleSelection does nothing as discussions don't support bulk actions.
+func (m *Model) ToggleSelection() {}
+
+func (m *Model) GetSelectedRows() []data.RowData {
+	return nil
+}
+
+func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
+	if m == nil {
+		return nil
+	}
+
+	if m.PageInfo != nil && !m.PageInfo.HasNextPage {
+		return nil
+	}
+
+	var cmds []tea.Cmd
+
+	startCursor := time.Now().String()
+	if m.PageInfo != nil {
+		startCursor = m.PageInfo.StartCursor
+	}
+	taskId := fmt.Sprintf("fetching_discussions_%d_%s", m.Id, startCursor)
+	isFirstFetch := m.LastFetchTaskId == ""
+	m.LastFetchTaskId = taskId
+	task := context.Task{
+		Id:        taskId,
+		StartText: fmt.Sprintf(`Fetching discussions for "%s"`, m.Config.Title),
+		FinishedText: fmt.Sprintf(
+			`Discussions for "%s" have been fetched`,
+			m.Config.Title,
+		),
+		State: context.TaskStart,
+		Error: nil,
+	}
+	startCmd := m.Ctx.StartTask(task)
+	cmds = append(cmds, startCmd)
+
+	limit := m.Config.Limit
+	if limit == nil {
+		limit = &m.Ctx.Config.Defaults.DiscussionsLimit
+	}
+	isFirstPage := m.PageInfo == nil
+	if isFirstPage {
+		m.CacheKey = data.SectionCacheKey(m.Type, m.GetFilters(), *limit)
+	}
+	cacheKey := m.CacheKey
+
+	if isFirstFetch {
+		m.warmStartFromCache()
+	}
+
+	fetchCmd := func() tea.Msg {
+		res, err := data.FetchDiscussions(m.GetFilters(), *limit, m.PageInfo)
+		if err != nil {
+			return constants.TaskFinishedMsg{
+				SectionId:   m.Id,
+				SectionType: m.Type,
+				TaskId:      taskId,
+				Err:         err,
+			}
+		}
+
+		if isFirstPage {
+			data.GetSectionCache().Put(cacheKey, res)
+		}
+
+		return constants.TaskFinishedMsg{
+			SectionId:   m.Id,
+			SectionType: m.Type,
+			TaskId:      taskId,
+			Msg: SectionDiscussionsFetchedMsg{
+				Discussions: res.Discussions,
+				TotalCount:  res.TotalCount,
+				PageInfo:    res.PageInfo,
+				TaskId:      taskId,
+			},
+		}
+	}
+	cmds = append(cmds, fetchCmd)
+
+	return cmds
+}
+
+// warmStartFromCache shows the last known results for the section, marked as
+// stale, until the fetch that is in flight replaces them.
+func (m *Model) warmStartFromCache() {
+	var cached data.DiscussionsResponse
+	fetchedAt, ok := data.GetSectionCache().Get(m.CacheKey, m.CacheMaxAge(), &cached)
+	if !ok {
+		return
+	}
+
+	m.Discussions = cached.Discussions
+	m.TotalCount = cached.TotalCount
+	m.IsStale = true
+	m.Table.SetRows(m.BuildRows())
+	m.UpdateLastUpdated(fetchedAt)
+	m.UpdateTotalItemsCount(m.TotalCount)
+}
+
+func (m *Model) UpdateLastUpdated(t time.Time) {
+	m.Table.UpdateLastUpdated(t)
+}
+
+func (m *Model) ResetRows() {
+	m.Discussions = nil
+	m.BaseModel.ResetRows()
+}
+
+func FetchAllSections(
+	ctx *context.ProgramContext,
+) (sections []section.Section, fetchAllCmd tea.Cmd) {
+	sectionConfigs := ctx.Config.DiscussionsSections
+	fetchDiscussionsCmds := make([]tea.Cmd, 0, len(sectionConfigs))
+	sections = make([]section.Section, 0, len(sectionConfigs))
+	for i, sectionConfig := range sectionConfigs {
+		sectionModel := NewModel(
+			i+1,
+			ctx,
+			sectionConfig,
+			time.Now(),
+			time.Now(),
+		) // 0 is the search section
+		if sectionConfig.Layout.AuthorIcon.Hidden != nil {
+			sectionModel.ShowAuthorIcon = !*sectionConfig.Layout.AuthorIcon.Hidden
+		}
+		sections = append(sections, &sectionModel)
+		fetchDiscussionsCmds = append(
+			fetchDiscussionsCmds,
+			sectionModel.FetchNextPageSectionRows()...)
+	}
+	return sections, tea.Batch(fetchDiscussionsCmds...)
+}
+
+type SectionDiscussionsFetchedMsg struct {
+	Discussions []data.DiscussionData
+	TotalCount  int
+	PageInfo    data.PageInfo
+	TaskId      string
+}
+
+func (m Model) GetItemSingularForm() string {
+	return "Discussion"
+}
+
+func (m Model) GetItemPluralForm() string {
+	return "Discussions"
+}
+
+func (m Model) GetTotalCount() int {
+	return m.TotalCount
+}
+
+func (m *Model) GetIsLoading() bool {
+	return m.IsLoading
+}
+
+func (m *Model) SetIsLoading(val bool) {
+	m.IsLoading = val
+	m.Table.SetIsLoading(val)
+}
+
+func (m Model) GetPagerContent() string {
+	pagerContent := ""
+	lastUpdated := m.LastUpdated().Format("01/02 15:04:05")
+	if m.IsStale {
+		lastUpdated = "Cached " + lastUpdated
+	}
+	if m.TotalCount > 0 {
+		pagerContent = fmt.Sprintf(
+			"%v %v • %v %v/%v • Fetched %v",
+			constants.WaitingIcon,
+			lastUpdated,
+			m.SingularForm,
+			m.Table.GetCurrItem()+1,
+			m.TotalCount,
+			len(m.Table.Rows),
+		)
+	}
+	pager := m.Ctx.Styles.ListViewPort.PagerStyle.Render(pagerContent)
+	return pager
+}
@@ -0,0 +1,48 @@
+package discussionssection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+)
+
+func TestApplyUpdate(t *testing.T) {
+	discussion := data.DiscussionData{
+		Id: "D_1",
+		Comments: data.DiscussionComments{
+			Nodes: []data.DiscussionComment{
+				{Id: "DC_1", IsAnswer: true},
+				{Id: "DC_2"},
+			},
+			TotalCount: 2,
+		},
+		IsAnswered: true,
+	}
+	discussion.Answer = &discussion.Comments.Nodes[0]
+
+	applyUpdate(&discussion, tasks.UpdateDiscussionMsg{
+		DiscussionId: "D_1",
+		NewComment:   &data.DiscussionComment{Body: "thanks!"},
+	})
+	require.Len(t, discussion.Comments.Nodes, 3)
+	require.Equal(t, 3, discussion.Comments.TotalCount)
+	require.True(t, discussion.IsAnswered, "adding a comment keeps the answer")
+
+	answerId := "DC_2"
+	applyUpdate(&discussion, tasks.UpdateDiscussionMsg{DiscussionId: "D_1", AnswerId: &answerId})
+	require.True(t, discussion.IsAnswered)
+	require.Equal(t, "DC_2", discussion.Answer.Id)
+	require.False(t, discussion.Comments.Nodes[0].IsAnswer, "the previous answer is replaced")
+	require.True(t, discussion.Comments.Nodes[1].IsAnswer)
+	require.False(t, discussion.Comments.Nodes[2].IsAnswer,
+		"comments without an id are never the answer")
+
+	answerId = ""
+	applyUpdate(&discussion, tasks.UpdateDiscussionMsg{DiscussionId: "D_1", AnswerId: &answerId})
+	require.False(t, discussion.IsAnswered)
+	require.Nil(t, discussion.Answer)
+	require.False(t, discussion.Comments.Nodes[1].IsAnswer)
+}
@@ -0,0 +1,488 @@
+package discussionview
+
+import (
+	"fmt"
+	"image/color"
+	"regexp"
+	"slices"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/glamour/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/common"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/cmpcontroller"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/discussionssection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/fuzzyselect"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/inputbox"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/markdown"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+var (
+	htmlCommentRegex = regexp.MustCompile("(?U)<!--(.|[[:space:]])*-->")
+	lineCleanupRegex = regexp.MustCompile(`((\n)+|^)([^\r\n]*\|[^\r\n]*(\n)?)+`)
+)
+
+type Model struct {
+	ctx        *context.ProgramContext
+	discussion *data.DiscussionData
+	sectionId  int
+	width      int
+	editor     cmpcontroller.Controller
+	// commentId is the id of the selected comment, which can be marked as the answer
+	commentId string
+}
+
+func NewModel(ctx *context.ProgramContext) Model {
+	ta := inputbox.DefaultTextArea(ctx)
+	cmp := cmpcontroller.New(ctx, inputbox.ModelOpts{TextArea: &ta})
+
+	return Model{
+		ctx:        ctx,
+		discussion: nil,
+		editor:     cmp,
+	}
+}
+
+// IsSelectionKey returns whether the key moves the comment selection, so the sidebar has to
+// scroll to the selected comment.
+func IsSelectionKey(msg tea.KeyMsg) bool {
+	return key.Matches(msg, keys.DiscussionKeys.PrevComment, keys.DiscussionKeys.NextComment)
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	cmd, handled := m.editor.Update(msg)
+
+	if msg, ok := msg.(tea.KeyMsg); ok && msg.String() == "ctrl+d" {
+		value := m.editor.Value()
+		mode := m.editor.Mode()
+		m.editor.Exit()
+		if m.discussion == nil {
+			return m, nil
+		}
+
+		if mode == cmpcontroller.ModeComment && len(strings.TrimSpace(value)) != 0 {
+			sid := tasks.SectionIdentifier{Id: m.sectionId, Type: discussionssection.SectionType}
+			return m, tasks.CommentOnDiscussion(m.ctx, sid, m.discussion, value)
+		}
+		return m, nil
+	}
+	if handled {
+		return m, cmd
+	}
+
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.discussion != nil {
+		switch {
+		case key.Matches(keyMsg, keys.DiscussionKeys.PrevComment):
+			m.selectComment(-1)
+		case key.Matches(keyMsg, keys.DiscussionKeys.NextComment):
+			m.selectComment(1)
+		case key.Matches(keyMsg, keys.DiscussionKeys.MarkAnswer):
+			return m, m.markAnswer(true)
+		case key.Matches(keyMsg, keys.DiscussionKeys.UnmarkAnswer):
+			return m, m.markAnswer(false)
+		}
+	}
+
+	return m, cmd
+}
+
+// markAnswer marks the selected comment as the answer of the discussion, or unmarks the
+// current answer when mark is false.
+func (m *Model) markAnswer(mark bool) tea.Cmd {
+	if !m.discussion.Category.IsAnswerable {
+		return errCmd(fmt.Errorf("discussions in the %q category can't be answered",
+			m.discussion.Category.Name))
+	}
+
+	commentId := ""
+	if mark {
+		if comment := m.selectedComment(); comment != nil {
+			commentId = comment.Id
+		}
+		if commentId == "" {
+			return errCmd(fmt.Errorf("select a comment with %s/%s to mark it as the answer",
+				keys.DiscussionKeys.PrevComment.Help().Key,
+				keys.DiscussionKeys.NextComment.Help().Key))
+		}
+	} else if m.discussion.Answer != nil {
+		commentId = m.discussion.Answer.Id
+	}
+	if commentId == "" {
+		return errCmd(fmt.Errorf("discussion #%d has no answer", m.discussion.Number))
+	}
+
+	sid := tasks.SectionIdentifier{Id: m.sectionId, Type: discussionssection.SectionType}
+	return tasks.MarkDiscussionAnswer(m.ctx, sid, m.discussion, commentId, mark)
+}
+
+func errCmd(err error) tea.Cmd {
+	return func() tea.Msg {
+		return constants.ErrMsg{Err: err}
+	}
+}
+
+// selectableComments are the comments that can be selected, leaving out the ones that were
+// just added and have no id until the discussion is refetched.
+func (m *Model) selectableComments() []data.DiscussionComment {
+	comments := make([]data.DiscussionComment, 0, len(m.discussion.Comments.Nodes))
+	for _, comment := range m.discussion.Comments.Nodes {
+		if comment.Id != "" {
+			comments = append(comments, comment)
+		}
+	}
+	return comments
+}
+
+func (m *Model) selectedComment() *data.DiscussionComment {
+	if m.commentId == "" {
+		return nil
+	}
+	for _, comment := range m.selectableComments() {
+		if comment.Id == m.commentId {
+			return &comment
+		}
+	}
+	return nil
+}
+
+func (m *Model) selectComment(delta int) {
+	comments := m.selectableComments()
+	if len(comments) == 0 {
+		return
+	}
+
+	i := slices.IndexFunc(comments, func(c data.DiscussionComment) bool {
+		return c.Id == m.commentId
+	})
+	switch {
+	case i < 0 && delta > 0:
+		i = 0
+	case i < 0:
+		i = len(comments) - 1
+	default:
+		i = max(0, min(len(comments)-1, i+delta))
+	}
+	m.commentId = comments[i].Id
+}
+
+func (m Model) View() string {
+	s := strings.Builder{}
+	s.WriteString(m.viewHeader())
+
+	comments, _ := m.renderComments()
+	s.WriteString(comments)
+
+	if m.editor.Mode() != cmpcontroller.ModeNone {
+		s.WriteString("\n")
+		s.WriteString(m.ctx.Styles.Sidebar.InputBox.Render(m.editor.View()))
+	}
+
+	return lipgloss.NewStyle().Padding(0, m.ctx.Styles.Sidebar.ContentPadding).Render(s.String())
+}
+
+// viewHeader renders everything shown above the comments.
+func (m *Model) viewHeader() string {
+	s := strings.Builder{}
+
+	s.WriteString(m.renderFullNameAndNumber())
+	s.WriteString("\n")
+
+	s.WriteString(m.renderTitle())
+	s.WriteString("\n\n")
+	s.WriteString(m.renderStatusPill())
+	s.WriteString("\n\n")
+	s.WriteString(m.renderAuthor())
+	s.WriteString("\n\n")
+
+	labels := m.renderLabels()
+	if labels != "" {
+		s.WriteString(labels)
+		s.WriteString("\n\n")
+	}
+
+	s.WriteString(m.renderBody())
+	s.WriteString("\n\n")
+
+	if m.discussion.Answer != nil {
+		s.WriteString(m.renderAnswer())
+		s.WriteString("\n\n")
+	}
+
+	return s.String()
+}
+
+// SelectionOffset returns the line of the selected comment in the rendered view.
+func (m *Model) SelectionOffset() int {
+	if !m.hasData() || m.commentId == "" {
+		return 0
+	}
+
+	_, line := m.renderComments()
+	return lipgloss.Height(m.viewHeader()) - 1 + line
+}
+
+func (m *Model) ViewCompletions() string {
+	if !m.hasData() {
+		return ""
+	}
+
+	return m.editor.ViewCompletions()
+}
+
+func (m *Model) InputBoxLineFromBottom() int {
+	return m.editor.LineFromBottom()
+}
+
+func (m *Model) renderFullNameAndNumber() string {
+	return common.RenderPreviewHeader(m.ctx.Theme, m.width,
+		fmt.Sprintf("#%d · %s", m.discussion.GetNumber(), m.discussion.GetRepoNameWithOwner()))
+}
+
+func (m *Model) renderTitle() string {
+	return common.RenderPreviewTitle(m.ctx.Theme, m.ctx.Styles.Common, m.width, m.discussion.Title)
+}
+
+func (m *Model) renderStatusPill() string {
+	var bgColor color.Color
+	content := ""
+	switch {
+	case m.discussion.Closed:
+		bgColor = m.ctx.Styles.Colors.ClosedIssue.Dark
+		content = constants.DiscussionIcon + " Closed"
+	case m.discussion.IsAnswered:
+		bgColor = m.ctx.Styles.Colors.SuccessText.Dark
+		content = constants.AnsweredIcon + " Answered"
+	case m.discussion.Category.IsAnswerable:
+		bgColor = m.ctx.Styles.Colors.OpenIssue.Dark
+		content = constants.DiscussionIcon + " Unanswered"
+	default:
+		bgColor = m.ctx.Styles.Colors.OpenIssue.Dark
+		content = constants.DiscussionIcon + " Open"
+	}
+
+	pill := m.ctx.Styles.PrView.PillStyle.
+		BorderForeground(bgColor).
+		Background(bgColor).
+		Render(content)
+	return lipgloss.JoinHorizontal(lipgloss.Top, pill, " ", m.ctx.Styles.Common.FaintTextStyle.
+		Render(fmt.Sprintf("in %s · %s %d", m.discussion.Category.Name, constants.UpvoteIcon,
+			m.discussion.UpvoteCount)))
+}
+
+func (m *Model) renderAuthor() string {
+	authorAssociation := m.discussion.AuthorAssociation
+	if authorAssociation == "" {
+		authorAssociation = "unknown role"
+	}
+	faint := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText)
+	return lipgloss.JoinHorizontal(lipgloss.Top,
+		" by ",
+		lipgloss.NewStyle().Foreground(m.ctx.Theme.PrimaryText).Bold(true).
+			Render("@"+m.discussion.Author.Login),
+		faint.Render(lipgloss.JoinHorizontal(lipgloss.Top,
+			" ⋅ ", utils.TimeElapsed(m.discussion.CreatedAt), " ago", " ⋅ ")),
+		faint.Render(lipgloss.JoinHorizontal(lipgloss.Top,
+			data.GetAuthorRoleIcon(m.discussion.AuthorAssociation, m.ctx.Theme),
+			" ", strings.ToLower(authorAssociation))),
+	)
+}
+
+func (m *Model) renderBody() string {
+	width := m.getIndentedContentWidth()
+	// Strip HTML comments from body and cleanup body.
+	body := htmlCommentRegex.ReplaceAllString(m.discussion.Body, "")
+	body = lineCleanupRegex.ReplaceAllString(body, "")
+
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return lipgloss.NewStyle().
+			Italic(true).
+			Foreground(m.ctx.Theme.FaintText).
+			Render("No description provided.")
+	}
+
+	markdownRenderer := markdown.GetMarkdownRenderer(width, m.ctx)
+	rendered, err := markdownRenderer.Render(body)
+	if err != nil {
+		return ""
+	}
+
+	return lipgloss.NewStyle().
+		Width(width).
+		MaxWidth(width).
+		Align(lipgloss.Left).
+		Render(rendered)
+}
+
+func (m *Model) renderLabels() string {
+	return common.RenderLabels(m.discussion.Labels.Nodes, common.LabelOpts{
+		Width:     m.getIndentedContentWidth(),
+		PillStyle: m.ctx.Styles.PrView.PillStyle,
+	})
+}
+
+func (m *Model) renderAnswer() string {
+	markdownRenderer := markdown.GetMarkdownRenderer(m.getIndentedContentWidth()-2, m.ctx)
+	title := m.ctx.Styles.Common.MainTextStyle.
+		MarginBottom(1).
+		Underline(true).
+		Render(constants.AnsweredIcon + " Answer")
+	return lipgloss.JoinVertical(lipgloss.Left, title,
+		lipgloss.NewStyle().PaddingLeft(2).Render(
+			m.renderComment(*m.discussion.Answer, markdownRenderer)))
+}
+
+// renderComments returns the rendered comments and the line the selected comment starts at.
+func (m *Model) renderComments() (string, int) {
+	comments := m.discussion.Comments
+	title := fmt.Sprintf("%s Comments", constants.CommentIcon)
+	if comments.TotalCount > len(comments.Nodes) {
+		title = fmt.Sprintf("%s (last %d of %d)", title, len(comments.Nodes), comments.TotalCount)
+	}
+	parts := []string{
+		m.ctx.Styles.Common.MainTextStyle.MarginBottom(1).Underline(true).Render(title),
+	}
+
+	if len(comments.Nodes) == 0 {
+		parts = append(parts, lipgloss.NewStyle().Italic(true).Render("No comments..."))
+	}
+
+	selectedLine := 0
+	markdownRenderer := markdown.GetMarkdownRenderer(m.getIndentedContentWidth()-2, m.ctx)
+	for _, comment := range comments.Nodes {
+		prefix := " "
+		if comment.Id != "" && comment.Id == m.commentId {
+			selectedLine = lipgloss.Height(lipgloss.JoinVertical(lipgloss.Left, parts...))
+			prefix = constants.SelectionIcon
+		}
+		parts = append(parts, lipgloss.JoinHorizontal(lipgloss.Top,
+			prefix, " ", m.renderComment(comment, markdownRenderer)))
+	}
+
+	hint := fmt.Sprintf("Press %s to comment", keys.DiscussionKeys.Comment.Help().Key)
+	if m.discussion.Category.IsAnswerable {
+		hint = fmt.Sprintf(
+			"Select a comment with %s/%s, press %s to mark it as the answer or %s to unmark it, "+
+				"%s to comment",
+			keys.DiscussionKeys.PrevComment.Help().Key,
+			keys.DiscussionKeys.NextComment.Help().Key,
+			keys.DiscussionKeys.MarkAnswer.Help().Key,
+			keys.DiscussionKeys.UnmarkAnswer.Help().Key,
+			keys.DiscussionKeys.Comment.Help().Key,
+		)
+	}
+	parts = append(parts, "", m.ctx.Styles.Common.FaintTextStyle.Italic(true).
+		Width(m.getIndentedContentWidth()).Render(hint))
+
+	return lipgloss.JoinVertical(lipgloss.Left, parts...), selectedLine
+}
+
+func (m *Model) renderComment(
+	comment data.DiscussionComment,
+	markdownRenderer glamour.TermRenderer,
+) string {
+	faint := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText)
+	header := []string{
+		m.ctx.Styles.Common.MainTextStyle.Render("@" + comment.Author.Login),
+		" ",
+		faint.Render(utils.TimeElapsed(comment.UpdatedAt)),
+	}
+	if comment.UpvoteCount > 0 {
+		header = append(header, " ", faint.Render(
+			fmt.Sprintf("%s %d", constants.UpvoteIcon, comment.UpvoteCount)))
+	}
+	if comment.Replies.TotalCount > 0 {
+		header = append(header, " ", faint.Render(
+			fmt.Sprintf("%s %d", constants.CommentsIcon, comment.Replies.TotalCount)))
+	}
+	if comment.IsAnswer {
+		header = append(header, " ", lipgloss.NewStyle().
+			Foreground(m.ctx.Theme.SuccessText).
+			Render(constants.AnsweredIcon+" Answer"))
+	}
+
+	body := lineCleanupRegex.ReplaceAllString(comment.Body, "")
+	rendered, err := markdownRenderer.Render(body)
+	if err != nil {
+		rendered = body
+	}
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		lipgloss.JoinHorizontal(lipgloss.Top, header...),
+		strings.TrimRight(rendered, "\n"),
+	)
+}
+
+func (m *Model) getIndentedContentWidth() int {
+	return m.width - 6
+}
+
+func (m *Model) SetWidth(width int) {
+	m.width = width
+	m.editor.SetWidth(
+		m.getIndentedContentWidth() - m.ctx.Styles.Sidebar.InputBox.GetHorizontalFrameSize(),
+	)
+}
+
+func (m *Model) SetSectionId(id int) {
+	m.sectionId = id
+}
+
+func (m *Model) SetRow(discussion *data.DiscussionData) {
+	if discussion == nil || m.discussion == nil || discussion.Id != m.discussion.Id {
+		m.commentId = ""
+	}
+	m.discussion = discussion
+}
+
+func (m *Model) IsTextInputBoxFocused() bool {
+	return m.editor.Active()
+}
+
+func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
+	m.ctx = ctx
+	m.editor.UpdateProgramContext(ctx)
+	m.editor.SetSelectStyles(ctx.Styles.Select)
+}
+
+func (m *Model) SetIsCommenting(isCommenting bool) tea.Cmd {
+	if m.discussion == nil {
+		return nil
+	}
+
+	if !isCommenting {
+		if m.editor.Mode() == cmpcontroller.ModeComment {
+			m.editor.Exit()
+		}
+		return nil
+	}
+
+	owner, repo := m.discussion.GetRepoNameAndOwner()
+	m.editor.SetAutocompleteSource(&fuzzyselect.UserMentionSource{WithAtSymbol: true})
+	return m.editor.Enter(cmpcontroller.EnterOptions{
+		Mode:   cmpcontroller.ModeComment,
+		Prompt: constants.CommentPrompt,
+		Repo: cmpcontroller.RepoRef{
+			NameWithOwner: m.discussion.GetRepoNameWithOwner(),
+			Owner:         owner,
+			Name:          repo,
+		},
+		EnterFetch:                       cmpcontroller.FetchSilent,
+		ConfirmDiscardOnCancel:           true,
+		HideAutocompleteWhenContextEmpty: true,
+	})
+}
+
+func (m *Model) hasData() bool {
+	return m.discussion != nil
+}
@@ -0,0 +1,123 @@
+package discussionview
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/theme"
+)
+
+func newTestModel(t *testing.T, answerable bool) Model {
+	t.Helper()
+
+	cfg, err := config.ParseConfig(config.Location{
+		ConfigFlag:       "../../../config/testdata/test-config.yml",
+		SkipGlobalConfig: true,
+	})
+	require.NoError(t, err)
+
+	thm := theme.ParseTheme(&cfg)
+	ctx := &context.ProgramContext{
+		Config:            &cfg,
+		Theme:             thm,
+		Styles:            context.InitStyles(thm),
+		HasDarkBackground: true,
+		BackgroundSource:  "default",
+		StartTask: func(task context.Task) tea.Cmd {
+			return nil
+		},
+	}
+
+	discussion := &data.DiscussionData{
+		Id:       "D_1",
+		Number:   3,
+		Title:    "RFC: config format",
+		Body:     "What should the config look like?",
+		Category: data.DiscussionCategory{Name: "RFCs", IsAnswerable: answerable},
+		Comments: data.DiscussionComments{
+			Nodes: []data.DiscussionComment{
+				{Id: "DC_1", Body: "YAML"},
+				{Id: "DC_2", Body: "TOML"},
+				{Body: "just posted"},
+			},
+			TotalCount: 3,
+		},
+	}
+
+	m := NewModel(ctx)
+	m.UpdateProgramContext(ctx)
+	m.SetWidth(80)
+	m.SetRow(discussion)
+	return m
+}
+
+func TestSelectComment(t *testing.T) {
+	m := newTestModel(t, true)
+
+	m.selectComment(-1)
+	require.Equal(t, "DC_2", m.commentId, "the selection starts at the last comment going up")
+	m.selectComment(1)
+	require.Equal(t, "DC_2", m.commentId, "comments without an id can't be selected")
+	m.selectComment(-1)
+	require.Equal(t, "DC_1", m.commentId)
+
+	comments, line := m.renderComments()
+	lines := strings.Split(ansi.Strip(comments), "\n")
+	require.Contains(t, lines[line], constants.SelectionIcon)
+
+	view := strings.Split(ansi.Strip(m.View()), "\n")
+	require.Contains(t, view[m.SelectionOffset()], constants.SelectionIcon)
+}
+
+func TestSetRowKeepsSelectionOfSameDiscussion(t *testing.T) {
+	m := newTestModel(t, true)
+	m.selectComment(1)
+
+	updated := *m.discussion
+	m.SetRow(&updated)
+	require.Equal(t, "DC_1", m.commentId)
+
+	m.SetRow(&data.DiscussionData{Id: "D_2"})
+	require.Empty(t, m.commentId)
+}
+
+func TestMarkAnswer(t *testing.T) {
+	m := newTestModel(t, true)
+
+	msg := m.markAnswer(true)()
+	require.IsType(t, constants.ErrMsg{}, msg, "a comment has to be selected")
+
+	m.selectComment(1)
+	require.NotNil(t, m.markAnswer(true))
+
+	msg = m.markAnswer(false)()
+	require.IsType(t, constants.ErrMsg{}, msg, "there is no answer to unmark")
+
+	m = newTestModel(t, false)
+	m.selectComment(1)
+	msg = m.markAnswer(true)()
+	require.ErrorContains(t, msg.(constants.ErrMsg).Err, "can't be answered")
+}
+
+func TestViewShowsAnswer(t *testing.T) {
+	m := newTestModel(t, true)
+	m.discussion.IsAnswered = true
+	m.discussion.Comments.Nodes[1].IsAnswer = true
+	m.discussion.Answer = &m.discussion.Comments.Nodes[1]
+
+	view := ansi.Strip(m.View())
+	require.Contains(t, view, "Answered")
+	require.Contains(t, view, "in RFCs")
+	require.True(t, slices.ContainsFunc(strings.Split(view, "\n"), func(line string) bool {
+		return strings.TrimSpace(line) == constants.AnsweredIcon+" Answer"
+	}), "the answer is shown above the comments")
+}
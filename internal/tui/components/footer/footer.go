@@ -135,6 +135,15 @@ func (m *Model) renderViewButton(view config.ViewType) string {
 	case config.IssuesView:
 		icon = ""
 		label = " Issues"
+	case config.DiscussionsView:
+		icon = constants.DiscussionIcon
+		label = " Discussions"
+	case config.ReleasesView:
+		icon = constants.ReleaseIcon
+		label = " Releases"
+	case config.ProjectsView:
+		icon = constants.ProjectIcon
+		label = " Projects"
 	}
 
 	if isActive {
@@ -176,14 +185,38 @@ func (m *Model) renderViewSwitcher(ctx *context.ProgramContext) string {
 		user = ctx.Styles.Common.FooterStyle.Render("@" + ctx.User)
 	}
 
-	view := lipgloss.JoinHorizontal(
-		lipgloss.Top,
+	views := []string{
 		ctx.Styles.ViewSwitcher.ViewsSeparator.PaddingLeft(1).
 			Render(m.renderViewButton(config.NotificationsView)),
 		ctx.Styles.ViewSwitcher.ViewsSeparator.Render(viewSeparator),
 		m.renderViewButton(config.PRsView),
 		ctx.Styles.ViewSwitcher.ViewsSeparator.Render(viewSeparator),
 		m.renderViewButton(config.IssuesView),
+	}
+	// The discussions, releases and projects views are only part of the view cycle when
+	// sections are configured for them
+	if len(ctx.Config.DiscussionsSections) > 0 {
+		views = append(views,
+			ctx.Styles.ViewSwitcher.ViewsSeparator.Render(viewSeparator),
+			m.renderViewButton(config.DiscussionsView),
+		)
+	}
+	if len(ctx.Config.ReleasesSections) > 0 {
+		views = append(views,
+			ctx.Styles.ViewSwitcher.ViewsSeparator.Render(viewSeparator),
+			m.renderViewButton(config.ReleasesView),
+		)
+	}
+	if len(ctx.Config.ProjectsSections) > 0 {
+		views = append(views,
+			ctx.Styles.ViewSwitcher.ViewsSeparator.Render(viewSeparator),
+			m.renderViewButton(config.ProjectsView),
+		)
+	}
+
+	view := lipgloss.JoinHorizontal(
+		lipgloss.Top,
+		lipgloss.JoinHorizontal(lipgloss.Top, views...),
 		lipgloss.NewStyle().Background(ctx.Styles.Common.FooterStyle.GetBackground()).Foreground(
 			ctx.Styles.ViewSwitcher.ViewsSeparator.GetBackground()).Render(" "),
 		repo,
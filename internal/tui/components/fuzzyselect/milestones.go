@@ -0,0 +1,68 @@
+package fuzzyselect
+
+import (
+	"fmt"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+// MilestoneSource completes the title of a single milestone of the repo.
+type MilestoneSource struct {
+	Milestones []data.Milestone
+}
+
+func (*MilestoneSource) ExtractContext(input string, cursorPos tea.Position) Context {
+	lines := lines(input)
+	if cursorPos.Y >= len(lines) {
+		return Context{}
+	}
+	line := []rune(lines[cursorPos.Y])
+	return Context{
+		Start:   tea.Position{X: 0, Y: cursorPos.Y},
+		End:     tea.Position{X: len(line), Y: cursorPos.Y},
+		Content: strings.TrimSpace(string(line)),
+	}
+}
+
+func (src *MilestoneSource) Suggestions(input string, cursorPos tea.Position) []Suggestion {
+	suggestions := make([]Suggestion, 0, len(src.Milestones))
+	for _, milestone := range src.Milestones {
+		suggestions = append(suggestions, Suggestion{
+			Value:  milestone.Title,
+			Detail: milestoneDetail(milestone),
+		})
+	}
+	return suggestions
+}
+
+// milestoneDetail describes a milestone by its due date, or its description when it has none.
+func milestoneDetail(milestone data.Milestone) string {
+	if milestone.DueOn != nil {
+		return "Due " + milestone.DueOn.Format("Jan 2, 2006")
+	}
+	return strings.TrimSpace(milestone.Description)
+}
+
+func (*MilestoneSource) InsertSuggestion(
+	input string,
+	suggestion string,
+	contextStart tea.Position,
+	contextEnd tea.Position,
+) (newInput string, newCursorPos tea.Position) {
+	lines := lines(input)
+	lines[contextStart.Y] = suggestion
+	return joinLines(lines), tea.Position{X: len([]rune(suggestion)), Y: contextStart.Y}
+}
+
+func (*MilestoneSource) ItemsToExclude(input string, cursorPos tea.Position) []string {
+	return nil
+}
+
+func (src *MilestoneSource) LoadSuggestions(ctx LoaderContext) error {
+	milestones, err := data.FetchRepoMilestones(fmt.Sprintf("%s/%s", ctx.RepoOwner, ctx.RepoName))
+	src.Milestones = milestones
+	return err
+}
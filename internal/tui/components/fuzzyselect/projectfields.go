@@ -0,0 +1,90 @@
+package fuzzyselect
+
+import (
+	"slices"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+// ProjectFieldSource completes "Field: value" assignments of the fields of the projects of the
+// issue or PR with the given url. Options are suggested for single select and iteration fields,
+// and the field name alone for text, number and date fields.
+type ProjectFieldSource struct {
+	Url   string
+	Items []data.EditableProjectItem
+}
+
+func (*ProjectFieldSource) ExtractContext(input string, cursorPos tea.Position) Context {
+	lines := lines(input)
+	if cursorPos.Y >= len(lines) {
+		return Context{}
+	}
+	line := []rune(lines[cursorPos.Y])
+	return Context{
+		Start:   tea.Position{X: 0, Y: cursorPos.Y},
+		End:     tea.Position{X: len(line), Y: cursorPos.Y},
+		Content: strings.TrimSpace(string(line)),
+	}
+}
+
+func (src *ProjectFieldSource) Suggestions(input string, cursorPos tea.Position) []Suggestion {
+	suggestions := make([]Suggestion, 0)
+	add := func(value string, detail string) {
+		if !slices.ContainsFunc(suggestions, func(s Suggestion) bool { return s.Value == value }) {
+			suggestions = append(suggestions, Suggestion{Value: value, Detail: detail})
+		}
+	}
+	for _, item := range src.Items {
+		for _, field := range item.Project.Fields.Nodes {
+			if !field.IsEditable() {
+				continue
+			}
+			name := field.Common.Name
+			switch field.Common.DataType {
+			case data.ProjectFieldSingleSelect:
+				for _, option := range field.SingleSelect.Options {
+					add(name+": "+option.Name, item.Project.Title)
+				}
+			case data.ProjectFieldIteration:
+				for _, iteration := range field.Iteration.Configuration.Iterations {
+					add(name+": "+iteration.Title, iteration.StartDate)
+				}
+			default:
+				add(name+": ", strings.ToLower(field.Common.DataType))
+			}
+		}
+	}
+	return suggestions
+}
+
+func (*ProjectFieldSource) InsertSuggestion(
+	input string,
+	suggestion string,
+	contextStart tea.Position,
+	contextEnd tea.Position,
+) (newInput string, newCursorPos tea.Position) {
+	lines := lines(input)
+	lines[contextStart.Y] = suggestion
+	return joinLines(lines), tea.Position{X: len([]rune(suggestion)), Y: contextStart.Y}
+}
+
+func (*ProjectFieldSource) ItemsToExclude(input string, cursorPos tea.Position) []string {
+	return nil
+}
+
+func (src *ProjectFieldSource) LoadSuggestions(ctx LoaderContext) error {
+	items, err := data.FetchEditableProjectItems(src.Url)
+	src.Items = items
+	return err
+}
+
+// ParseProjectFieldValue splits a "Field: value" assignment. The value is empty to clear the
+// field.
+func ParseProjectFieldValue(input string) (field string, value string, ok bool) {
+	field, value, ok = strings.Cut(strings.TrimSpace(input), ":")
+	field = strings.TrimSpace(field)
+	return field, strings.TrimSpace(value), ok && field != ""
+}
@@ -0,0 +1,86 @@
+package fuzzyselect
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+// ReactionSource completes a single reaction, e.g. thumbs_up, to toggle on a PR, issue or
+// comment. Groups are its current reactions, shown next to the suggestions.
+type ReactionSource struct {
+	Groups data.ReactionGroups
+}
+
+func (*ReactionSource) ExtractContext(input string, cursorPos tea.Position) Context {
+	return lineContext(input, cursorPos)
+}
+
+func (src *ReactionSource) Suggestions(input string, cursorPos tea.Position) []Suggestion {
+	suggestions := make([]Suggestion, 0, len(data.ReactionContents))
+	for _, content := range data.ReactionContents {
+		group := src.Groups.Get(content)
+		detail := fmt.Sprintf("%s %d", data.ReactionEmoji(content), group.Reactors.TotalCount)
+		if group.ViewerHasReacted {
+			detail += " · yours, removes it"
+		}
+		suggestions = append(suggestions, Suggestion{
+			Value:  strings.ToLower(content),
+			Detail: detail,
+		})
+	}
+	return suggestions
+}
+
+func (*ReactionSource) InsertSuggestion(
+	input string,
+	suggestion string,
+	contextStart tea.Position,
+	contextEnd tea.Position,
+) (newInput string, newCursorPos tea.Position) {
+	return replaceLine(input, suggestion, contextStart)
+}
+
+func (*ReactionSource) ItemsToExclude(input string, cursorPos tea.Position) []string {
+	return nil
+}
+
+func (*ReactionSource) LoadSuggestions(ctx LoaderContext) error {
+	return nil
+}
+
+// ParseReaction parses the reaction picked with a ReactionSource, e.g. "thumbs_up", to its
+// content, e.g. THUMBS_UP. It isn't ok for anything but a known reaction.
+func ParseReaction(value string) (content string, ok bool) {
+	content = strings.ToUpper(strings.TrimSpace(value))
+	return content, slices.Contains(data.ReactionContents, content)
+}
+
+// lineContext is the whole line at the cursor, for sources completing a single value.
+func lineContext(input string, cursorPos tea.Position) Context {
+	lines := lines(input)
+	if cursorPos.Y >= len(lines) {
+		return Context{}
+	}
+	line := []rune(lines[cursorPos.Y])
+	return Context{
+		Start:   tea.Position{X: 0, Y: cursorPos.Y},
+		End:     tea.Position{X: len(line), Y: cursorPos.Y},
+		Content: strings.TrimSpace(string(line)),
+	}
+}
+
+// replaceLine replaces the line of a lineContext with the suggestion.
+func replaceLine(
+	input string,
+	suggestion string,
+	contextStart tea.Position,
+) (newInput string, newCursorPos tea.Position) {
+	lines := lines(input)
+	lines[contextStart.Y] = suggestion
+	return joinLines(lines), tea.Position{X: len([]rune(suggestion)), Y: contextStart.Y}
+}
@@ -0,0 +1,61 @@
+package fuzzyselect
+
+import (
+	"errors"
+	"maps"
+	"slices"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+// ReviewerSource completes the users and teams a review can be requested from. Like
+// assignees, several reviewers are separated by spaces, and teams are written as org/team.
+type ReviewerSource struct {
+	UserMentionSource
+	// Rerequested maps the reviewers whose review can be re-requested to why, e.g. because
+	// their review was dismissed. They're suggested first.
+	Rerequested map[string]string
+	// Suggested are the suggested reviewers of the PR, suggested after the re-requested ones.
+	Suggested []string
+	Org       string
+	Teams     []data.Team
+}
+
+func (src *ReviewerSource) Suggestions(input string, cursorPos tea.Position) []Suggestion {
+	suggestions := make([]Suggestion, 0,
+		len(src.Rerequested)+len(src.Suggested)+len(src.Users)+len(src.Teams))
+	seen := make(map[string]bool)
+	add := func(value, detail string) {
+		if value == "" || seen[value] {
+			return
+		}
+		seen[value] = true
+		suggestions = append(suggestions, Suggestion{Value: value, Detail: detail})
+	}
+
+	for _, login := range slices.Sorted(maps.Keys(src.Rerequested)) {
+		add(login, src.Rerequested[login])
+	}
+	for _, login := range src.Suggested {
+		add(login, "suggested")
+	}
+	for _, user := range src.Users {
+		add(user.Login, user.Name)
+	}
+	for _, team := range src.Teams {
+		add(src.Org+"/"+team.Slug, team.Name)
+	}
+
+	return suggestions
+}
+
+func (src *ReviewerSource) LoadSuggestions(ctx LoaderContext) error {
+	usersErr := src.UserMentionSource.LoadSuggestions(ctx)
+	teams, teamsErr := data.FetchOrgTeams(ctx.RepoOwner)
+	src.Org = ctx.RepoOwner
+	src.Teams = teams
+
+	return errors.Join(usersErr, teamsErr)
+}
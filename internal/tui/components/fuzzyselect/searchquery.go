@@ -12,10 +12,12 @@ import (
 
 // SearchQuerySource implements the Source interface.
 type SearchQuerySource struct {
-	Labels    []data.Label
-	LabelsErr error
-	Users     []data.User
-	UsersErr  error
+	Labels        []data.Label
+	LabelsErr     error
+	Users         []data.User
+	UsersErr      error
+	Milestones    []data.Milestone
+	MilestonesErr error
 }
 
 func authorPrefix(info WordInfo) (string, bool) {
@@ -42,17 +44,35 @@ func labelPrefix(info WordInfo) (string, bool) {
 	return "", false
 }
 
-func (*SearchQuerySource) ExtractContext(input string, cursorPos tea.Position) Context {
-	info := ExtractWordAtCursor(input, cursorPos)
-	if prefix, ok := authorPrefix(info); ok {
-		c, _ := strings.CutPrefix(info.Word, prefix)
-		return Context{
-			Start:   tea.Position{X: info.StartIdx.X + len(prefix), Y: info.StartIdx.Y},
-			End:     info.EndIdx,
-			Content: c,
+func milestonePrefix(info WordInfo) (string, bool) {
+	if strings.HasPrefix(info.Word, "milestone:") {
+		return "milestone:", true
+	}
+
+	if strings.HasPrefix(info.Word, "-milestone:") {
+		return "-milestone:", true
+	}
+
+	return "", false
+}
+
+// qualifierPrefix returns the prefix of the search qualifier with completions at the word.
+func qualifierPrefix(info WordInfo) (string, bool) {
+	for _, prefix := range []func(WordInfo) (string, bool){
+		authorPrefix,
+		labelPrefix,
+		milestonePrefix,
+	} {
+		if p, ok := prefix(info); ok {
+			return p, true
 		}
 	}
-	if prefix, ok := labelPrefix(info); ok {
+	return "", false
+}
+
+func (*SearchQuerySource) ExtractContext(input string, cursorPos tea.Position) Context {
+	info := ExtractWordAtCursor(input, cursorPos)
+	if prefix, ok := qualifierPrefix(info); ok {
 		c, _ := strings.CutPrefix(info.Word, prefix)
 		return Context{
 			Start:   tea.Position{X: info.StartIdx.X + len(prefix), Y: info.StartIdx.Y},
@@ -95,9 +115,28 @@ func (src *SearchQuerySource) Suggestions(input string, cursorPos tea.Position)
 		return suggestions
 	}
 
+	if _, ok := milestonePrefix(wordInfo); ok {
+		suggestions := make([]Suggestion, 0, len(src.Milestones))
+		for _, milestone := range src.Milestones {
+			suggestions = append(suggestions, Suggestion{
+				Value:  quoteQualifierValue(milestone.Title),
+				Detail: milestoneDetail(milestone),
+			})
+		}
+		return suggestions
+	}
+
 	return nil
 }
 
+// quoteQualifierValue quotes a qualifier value with spaces, like a milestone titled "Q3 2026".
+func quoteQualifierValue(value string) string {
+	if strings.ContainsAny(value, " \t") {
+		return `"` + value + `"`
+	}
+	return value
+}
+
 func (*SearchQuerySource) InsertSuggestion(
 	input string,
 	suggestion string,
@@ -131,7 +170,14 @@ func (src *SearchQuerySource) LoadSuggestions(ctx LoaderContext) error {
 		src.LabelsErr = err
 	})
 
+	wg.Go(func() {
+		milestones, err := data.FetchRepoMilestones(
+			fmt.Sprintf("%s/%s", ctx.RepoOwner, ctx.RepoName))
+		src.Milestones = milestones
+		src.MilestonesErr = err
+	})
+
 	wg.Wait()
 
-	return errors.Join(src.UsersErr, src.LabelsErr)
+	return errors.Join(src.UsersErr, src.LabelsErr, src.MilestonesErr)
 }
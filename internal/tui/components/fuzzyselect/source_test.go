@@ -2,9 +2,13 @@ package fuzzyselect
 
 import (
 	"testing"
+	"time"
 
 	tea "charm.land/bubbletea/v2"
 	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/common"
 )
 
 func TestExtractLabelAtCursor(t *testing.T) {
@@ -294,3 +298,81 @@ yes
 @octo `, newInput)
 	require.Equal(t, tea.Position{Y: 0, X: 6}, newCursor)
 }
+
+func TestSearchQuerySourceMilestones(t *testing.T) {
+	dueOn := time.Date(2026, time.November, 2, 0, 0, 0, 0, time.UTC)
+	source := SearchQuerySource{Milestones: []data.Milestone{
+		{Title: "v4.1", DueOn: &dueOn},
+		{Title: "Q4 2026", Description: "Planning"},
+	}}
+
+	require.Equal(
+		t,
+		Context{Start: tea.Position{X: 18}, End: tea.Position{X: 20}, Content: "v4"},
+		source.ExtractContext("is:open milestone:v4", tea.Position{X: 20}),
+	)
+	require.Equal(t, []Suggestion{
+		{Value: "v4.1", Detail: "Due Nov 2, 2026"},
+		{Value: `"Q4 2026"`, Detail: "Planning"},
+	}, source.Suggestions("is:open -milestone:", tea.Position{X: 19}))
+	require.Nil(t, source.Suggestions("is:open v4", tea.Position{X: 10}))
+}
+
+func TestReviewerSource(t *testing.T) {
+	source := ReviewerSource{
+		UserMentionSource: UserMentionSource{Users: []data.User{
+			{Login: "octo", Name: "Octo Cat"},
+			{Login: "dlvhdr", Name: "Dolev Hadar"},
+		}},
+		Rerequested: map[string]string{"octo": "review dismissed"},
+		Suggested:   []string{"dlvhdr"},
+		Org:         "cli",
+		Teams:       []data.Team{{Slug: "maintainers", Name: "Maintainers"}},
+	}
+
+	require.Equal(t, []Suggestion{
+		{Value: "octo", Detail: "review dismissed"},
+		{Value: "dlvhdr", Detail: "suggested"},
+		{Value: "cli/maintainers", Detail: "Maintainers"},
+	}, source.Suggestions("", tea.Position{}))
+	require.Equal(
+		t,
+		Context{Start: tea.Position{X: 5}, End: tea.Position{X: 12}, Content: "cli/mai"},
+		source.ExtractContext("octo cli/mai", tea.Position{X: 12}),
+		"teams are completed as a single word",
+	)
+	require.Equal(t, []string{"octo"}, source.ItemsToExclude("octo cli/mai", tea.Position{X: 12}))
+}
+
+func TestReactionSource(t *testing.T) {
+	heart := data.ReactionGroup{Content: "HEART", ViewerHasReacted: true}
+	heart.Reactors.TotalCount = 2
+	source := ReactionSource{Groups: data.ReactionGroups{heart}}
+
+	suggestions := source.Suggestions("", tea.Position{})
+	require.Len(t, suggestions, len(data.ReactionContents))
+	require.Equal(t, Suggestion{Value: "thumbs_up", Detail: "👍 0"}, suggestions[0])
+	require.Contains(t, suggestions, Suggestion{Value: "heart", Detail: "❤️ 2 · yours, removes it"})
+
+	content, ok := ParseReaction(" heart ")
+	require.True(t, ok)
+	require.Equal(t, "HEART", content)
+	_, ok = ParseReaction("thumbsup")
+	require.False(t, ok)
+}
+
+func TestTimelineActionSource(t *testing.T) {
+	actions := []common.TimelineAction{common.TimelineQuoteReply, common.TimelineCopyLink}
+	source := TimelineActionSource{Actions: actions}
+
+	require.Equal(t, []Suggestion{
+		{Value: "quote_reply", Detail: "reply quoting it"},
+		{Value: "copy_link", Detail: "copy its link"},
+	}, source.Suggestions("", tea.Position{}))
+
+	action, ok := ParseTimelineAction(" Copy_Link ", actions)
+	require.True(t, ok)
+	require.Equal(t, common.TimelineCopyLink, action)
+	_, ok = ParseTimelineAction("delete", actions)
+	require.False(t, ok, "only the actions of the entry can be picked")
+}
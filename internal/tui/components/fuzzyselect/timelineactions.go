@@ -0,0 +1,58 @@
+package fuzzyselect
+
+import (
+	"slices"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/common"
+)
+
+// TimelineActionSource completes a single action, e.g. quote_reply, on the selected entry of
+// the activity timeline of a PR or issue.
+type TimelineActionSource struct {
+	Actions []common.TimelineAction
+}
+
+func (*TimelineActionSource) ExtractContext(input string, cursorPos tea.Position) Context {
+	return lineContext(input, cursorPos)
+}
+
+func (src *TimelineActionSource) Suggestions(input string, cursorPos tea.Position) []Suggestion {
+	suggestions := make([]Suggestion, 0, len(src.Actions))
+	for _, action := range src.Actions {
+		suggestions = append(suggestions, Suggestion{
+			Value:  string(action),
+			Detail: action.Describe(),
+		})
+	}
+	return suggestions
+}
+
+func (*TimelineActionSource) InsertSuggestion(
+	input string,
+	suggestion string,
+	contextStart tea.Position,
+	contextEnd tea.Position,
+) (newInput string, newCursorPos tea.Position) {
+	return replaceLine(input, suggestion, contextStart)
+}
+
+func (*TimelineActionSource) ItemsToExclude(input string, cursorPos tea.Position) []string {
+	return nil
+}
+
+func (*TimelineActionSource) LoadSuggestions(ctx LoaderContext) error {
+	return nil
+}
+
+// ParseTimelineAction parses the action picked with a TimelineActionSource. It isn't ok for
+// anything but one of the actions.
+func ParseTimelineAction(
+	value string,
+	actions []common.TimelineAction,
+) (action common.TimelineAction, ok bool) {
+	action = common.TimelineAction(strings.ToLower(strings.TrimSpace(value)))
+	return action, slices.Contains(actions, action)
+}
@@ -17,20 +17,25 @@ type Issue struct {
 	Ctx            *context.ProgramContext
 	Data           data.IssueData
 	ShowAuthorIcon bool
+	// ProjectFields are the project fields shown after the other columns
+	ProjectFields []string
 }
 
 func (issue *Issue) ToTableRow() table.Row {
-	return table.Row{
+	row := table.Row{
 		issue.renderStatus(),
 		issue.renderRepoName(),
 		issue.renderTitle(),
 		issue.renderOpenedBy(),
 		issue.renderAssignees(),
+		issue.renderMilestone(),
 		issue.renderNumComments(),
 		issue.renderNumReactions(),
 		issue.renderUpdateAt(),
 		issue.renderCreatedAt(),
 	}
+	return append(row, components.RenderProjectFields(
+		issue.Ctx, issue.Data.ProjectItems, issue.ProjectFields)...)
 }
 
 func (issue *Issue) getTextStyle() lipgloss.Style {
@@ -89,6 +94,13 @@ func (issue *Issue) renderAssignees() string {
 	return issue.getTextStyle().Render(strings.Join(assignees, ","))
 }
 
+func (issue *Issue) renderMilestone() string {
+	if issue.Data.Milestone == nil {
+		return ""
+	}
+	return issue.getTextStyle().Render(issue.Data.Milestone.Title)
+}
+
 func (issue *Issue) renderStatus() string {
 	if issue.Data.State == "OPEN" {
 		return lipgloss.NewStyle().Foreground(issue.Ctx.Styles.Colors.OpenIssue).Render("")
@@ -24,7 +24,8 @@ const SectionType = "issue"
 
 type Model struct {
 	section.BaseModel
-	Issues []data.IssueData
+	Issues        []data.IssueData
+	projectFields []string
 }
 
 func NewModel(
@@ -49,6 +50,9 @@ func NewModel(
 		},
 	)
 	m.Issues = []data.IssueData{}
+	m.projectFields = section.ProjectFieldNames(config.MergeProjectFieldColumns(
+		ctx.Config.Defaults.Layout.Issues.ProjectFields, cfg.Layout.ProjectFields))
+	m.Sorting = section.NewSorting(sortColumns, cfg.SortBy, cfg.SortDirection, cfg.GroupBy)
 
 	return m
 }
@@ -90,11 +94,22 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 				if input == "Y" || input == "y" {
 					issue := m.GetCurrRow()
 					sid := tasks.SectionIdentifier{Id: m.Id, Type: SectionType}
+					selected := m.GetSelectedRows()
 					switch action {
+					case section.ConfirmAction:
+						cmd = m.RunConfirmedAction()
 					case "close":
-						cmd = tasks.CloseIssue(m.Ctx, sid, issue)
+						if len(selected) > 0 {
+							cmd = tasks.CloseIssues(m.Ctx, sid, selected)
+						} else {
+							cmd = tasks.CloseIssue(m.Ctx, sid, issue)
+						}
 					case "reopen":
-						cmd = tasks.ReopenIssue(m.Ctx, sid, issue)
+						if len(selected) > 0 {
+							cmd = tasks.ReopenIssues(m.Ctx, sid, selected)
+						} else {
+							cmd = tasks.ReopenIssue(m.Ctx, sid, issue)
+						}
 					}
 				}
 
@@ -119,11 +134,35 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 				m.ResetRows()
 				return m, tea.Batch(m.FetchNextPageSectionRows()...)
 			}
+
+		case key.Matches(msg, keys.IssueKeys.SortBy):
+			m.Sorting = section.NextSortColumn(sortColumns, m.Sorting)
+			m.sortIssues()
+			m.Table.SetRows(m.BuildRows())
+
+		case key.Matches(msg, keys.IssueKeys.ReverseSort):
+			m.Sorting.Desc = !m.Sorting.Desc
+			m.sortIssues()
+			m.Table.SetRows(m.BuildRows())
+
+		case key.Matches(msg, keys.IssueKeys.GroupBy):
+			m.Sorting = section.NextGroupBy(groupColumns, m.Sorting)
+			m.sortIssues()
+			m.Table.SetRows(m.BuildRows())
+
+		case key.Matches(msg, keys.IssueKeys.ToggleGroup):
+			m.Table.ToggleCurrGroup()
 		}
 
 	case tasks.UpdateIssueMsg:
 		for i, currIssue := range m.Issues {
 			if currIssue.Number == msg.IssueNumber {
+				if msg.Title != nil {
+					currIssue.Title = *msg.Title
+				}
+				if msg.Body != nil {
+					currIssue.Body = *msg.Body
+				}
 				if msg.IsClosed != nil {
 					if *msg.IsClosed {
 						currIssue.State = "CLOSED"
@@ -134,6 +173,10 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 				if msg.Labels != nil {
 					currIssue.Labels.Nodes = msg.Labels.Nodes
 				}
+				if msg.AddedLabels != nil {
+					currIssue.Labels.Nodes = addLabels(
+						currIssue.Labels.Nodes, msg.AddedLabels.Nodes)
+				}
 				if msg.NewComment != nil {
 					currIssue.Comments.Nodes = append(currIssue.Comments.Nodes, *msg.NewComment)
 				}
@@ -145,6 +188,15 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 					currIssue.Assignees.Nodes = removeAssignees(
 						currIssue.Assignees.Nodes, msg.RemovedAssignees.Nodes)
 				}
+				if msg.Milestone != nil {
+					currIssue.Milestone = msg.Milestone.Milestone
+				}
+				if msg.Reaction != nil {
+					updateReactions(&currIssue, *msg.Reaction)
+				}
+				if msg.Comment != nil {
+					updateComment(&currIssue, *msg.Comment)
+				}
 				m.Issues[i] = currIssue
 				m.SetIsLoading(false)
 				m.Table.SetRows(m.BuildRows())
@@ -152,8 +204,35 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 			}
 		}
 
+	case tasks.IssueCreatedMsg:
+		m.PrependSearchOrder(issueKey(msg.Issue))
+		m.Issues = append([]data.IssueData{msg.Issue}, m.Issues...)
+		m.TotalCount++
+		m.sortIssues()
+		m.Table.SetRows(m.BuildRows())
+		m.UpdateTotalItemsCount(m.TotalCount)
+
+	case tasks.UpdateProjectFieldMsg:
+		for i := range m.Issues {
+			if m.Issues[i].Url == msg.Url {
+				m.Issues[i].ProjectItems.SetFieldValue(msg.ItemId, msg.Field, msg.Value)
+				m.Table.SetRows(m.BuildRows())
+			}
+		}
+
+	case tasks.BulkUpdateMsg:
+		cmds := make([]tea.Cmd, 0, len(msg.Msgs))
+		for _, updateMsg := range msg.Msgs {
+			_, updateCmd := m.Update(updateMsg)
+			cmds = append(cmds, updateCmd)
+		}
+		m.ClearSelection()
+		return m, tea.Batch(cmds...)
+
 	case SectionIssuesFetchedMsg:
 		if m.LastFetchTaskId == msg.TaskId {
+			m.IsStale = false
+			m.TrackSearchOrder(issueKeys(msg.Issues), m.PageInfo == nil)
 			if m.PageInfo != nil {
 				m.Issues = append(m.Issues, msg.Issues...)
 			} else {
@@ -162,6 +241,7 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 			m.TotalCount = msg.TotalCount
 			m.SetIsLoading(false)
 			m.PageInfo = &msg.PageInfo
+			m.sortIssues()
 			m.Table.SetRows(m.BuildRows())
 			m.UpdateLastUpdated(time.Now())
 			m.UpdateTotalItemsCount(m.TotalCount)
@@ -170,6 +250,7 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 
 	search, searchCmd := m.SearchBar.Update(msg)
 	m.SearchBar = search
+	m.SyncSelection(m.rowKeys())
 
 	prompt, promptCmd := m.PromptConfirmationBox.Update(msg)
 	m.PromptConfirmationBox = prompt
@@ -203,6 +284,10 @@ func GetSectionColumns(
 		dLayout.Assignees,
 		sLayout.Assignees,
 	)
+	milestoneLayout := config.MergeColumnConfigs(
+		dLayout.Milestone,
+		sLayout.Milestone,
+	)
 	commentsLayout := config.MergeColumnConfigs(
 		dLayout.Comments,
 		sLayout.Comments,
@@ -211,8 +296,10 @@ func GetSectionColumns(
 		dLayout.Reactions,
 		sLayout.Reactions,
 	)
+	projectFieldColumns := section.ProjectFieldColumns(
+		config.MergeProjectFieldColumns(dLayout.ProjectFields, sLayout.ProjectFields))
 
-	return []table.Column{
+	return append([]table.Column{
 		{
 			Title:  "",
 			Width:  stateLayout.Width,
@@ -238,6 +325,11 @@ func GetSectionColumns(
 			Width:  assigneesLayout.Width,
 			Hidden: assigneesLayout.Hidden,
 		},
+		{
+			Title:  "Milestone",
+			Width:  milestoneLayout.Width,
+			Hidden: milestoneLayout.Hidden,
+		},
 		{
 			Title:  constants.CommentsIcon,
 			Width:  &issueNumCommentsCellWidth,
@@ -258,13 +350,18 @@ func GetSectionColumns(
 			Width:  createdAtLayout.Width,
 			Hidden: createdAtLayout.Hidden,
 		},
-	}
+	}, projectFieldColumns...)
 }
 
 func (m Model) BuildRows() []table.Row {
 	var rows []table.Row
 	for _, currIssue := range m.Issues {
-		issueModel := issuerow.Issue{Ctx: m.Ctx, Data: currIssue, ShowAuthorIcon: m.ShowAuthorIcon}
+		issueModel := issuerow.Issue{
+			Ctx:            m.Ctx,
+			Data:           currIssue,
+			ShowAuthorIcon: m.ShowAuthorIcon,
+			ProjectFields:  m.projectFields,
+		}
 		rows = append(rows, issueModel.ToTableRow())
 	}
 
@@ -288,6 +385,31 @@ func (m *Model) GetCurrRow() data.RowData {
 	return &issue
 }
 
+func (m *Model) rowKeys() []string {
+	return issueKeys(m.Issues)
+}
+
+// ToggleSelection marks or unmarks the current issue for a bulk action.
+func (m *Model) ToggleSelection() {
+	issue := m.GetCurrRow()
+	if issue == nil {
+		return
+	}
+	m.ToggleRowSelection(issue.GetUrl())
+	m.SyncSelection(m.rowKeys())
+}
+
+// GetSelectedRows returns the marked issues in the order they are shown.
+func (m *Model) GetSelectedRows() []data.RowData {
+	var rows []data.RowData
+	for i := range m.Issues {
+		if m.Selection[m.Issues[i].Url] {
+			rows = append(rows, &m.Issues[i])
+		}
+	}
+	return rows
+}
+
 func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
 	if m == nil {
 		return nil
@@ -304,6 +426,7 @@ func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
 		startCursor = m.PageInfo.StartCursor
 	}
 	taskId := fmt.Sprintf("fetching_issues_%d_%s", m.Id, startCursor)
+	isFirstFetch := m.LastFetchTaskId == ""
 	m.LastFetchTaskId = taskId
 	task := context.Task{
 		Id:        taskId,
@@ -318,11 +441,21 @@ func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
 	startCmd := m.Ctx.StartTask(task)
 	cmds = append(cmds, startCmd)
 
+	limit := m.Config.Limit
+	if limit == nil {
+		limit = &m.Ctx.Config.Defaults.IssuesLimit
+	}
+	isFirstPage := m.PageInfo == nil
+	if isFirstPage {
+		m.CacheKey = data.SectionCacheKey(m.Type, m.GetFilters(), *limit)
+	}
+	cacheKey := m.CacheKey
+
+	if isFirstFetch {
+		m.warmStartFromCache()
+	}
+
 	fetchCmd := func() tea.Msg {
-		limit := m.Config.Limit
-		if limit == nil {
-			limit = &m.Ctx.Config.Defaults.IssuesLimit
-		}
 		res, err := data.FetchIssues(m.GetFilters(), *limit, m.PageInfo)
 		if err != nil {
 			return constants.TaskFinishedMsg{
@@ -333,6 +466,10 @@ func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
 			}
 		}
 
+		if isFirstPage {
+			data.GetSectionCache().Put(cacheKey, res)
+		}
+
 		return constants.TaskFinishedMsg{
 			SectionId:   m.Id,
 			SectionType: m.Type,
@@ -350,6 +487,34 @@ func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
 	return cmds
 }
 
+// warmStartFromCache shows the last known results for the section, marked as
+// stale, until the fetch that is in flight replaces them.
+func (m *Model) warmStartFromCache() {
+	var cached data.IssuesResponse
+	fetchedAt, ok := data.GetSectionCache().Get(m.CacheKey, m.CacheMaxAge(), &cached)
+	if !ok {
+		return
+	}
+
+	m.Issues = cached.Issues
+	m.TotalCount = cached.TotalCount
+	m.IsStale = true
+	m.TrackSearchOrder(m.rowKeys(), true)
+	m.sortIssues()
+	m.Table.SetRows(m.BuildRows())
+	m.UpdateLastUpdated(fetchedAt)
+	m.UpdateTotalItemsCount(m.TotalCount)
+}
+
+// SetProjectItems sets the project items of the issue with the given url.
+func (m *Model) SetProjectItems(url string, items data.ProjectItems) {
+	for i := range m.Issues {
+		if m.Issues[i].Url == url {
+			m.Issues[i].ProjectItems = items
+		}
+	}
+}
+
 func (m *Model) UpdateLastUpdated(t time.Time) {
 	m.Table.UpdateLastUpdated(t)
 }
@@ -391,6 +556,18 @@ type SectionIssuesFetchedMsg struct {
 	TaskId     string
 }
 
+func addLabels(labels, addedLabels []data.Label) []data.Label {
+	newLabels := labels
+	for _, label := range addedLabels {
+		hasLabel := func(l data.Label) bool { return l.Name == label.Name }
+		if !slices.ContainsFunc(newLabels, hasLabel) {
+			newLabels = append(newLabels, label)
+		}
+	}
+
+	return newLabels
+}
+
 func addAssignees(assignees, addedAssignees []data.Assignee) []data.Assignee {
 	newAssignees := assignees
 	for _, assignee := range addedAssignees {
@@ -419,6 +596,51 @@ func assigneesContains(assignees []data.Assignee, assignee data.Assignee) bool {
 	return slices.Contains(assignees, assignee)
 }
 
+// updateReactions toggles the user's reaction on the issue or the comment it was made on. The
+// reactions column counts the reactions on the issue itself.
+func updateReactions(issue *data.IssueData, update tasks.ReactionUpdate) {
+	reacted := !update.Removed
+	if issue.Id == update.SubjectId {
+		hadReacted := issue.ReactionGroups.Get(update.Content).ViewerHasReacted
+		issue.ReactionGroups = issue.ReactionGroups.WithReaction(update.Content, reacted)
+		switch {
+		case reacted && !hadReacted:
+			issue.Reactions.TotalCount++
+		case !reacted && hadReacted:
+			issue.Reactions.TotalCount = max(issue.Reactions.TotalCount-1, 0)
+		}
+		return
+	}
+	for i := range issue.Comments.Nodes {
+		if comment := &issue.Comments.Nodes[i]; comment.Id == update.SubjectId {
+			comment.ReactionGroups = comment.ReactionGroups.WithReaction(update.Content, reacted)
+			return
+		}
+	}
+}
+
+// updateComment applies an edited, deleted or hidden comment to the issue.
+func updateComment(issue *data.IssueData, update tasks.CommentUpdate) {
+	for i := range issue.Comments.Nodes {
+		comment := &issue.Comments.Nodes[i]
+		if comment.Id != update.Id {
+			continue
+		}
+		if update.IsDeleted {
+			issue.Comments.Nodes = slices.Delete(issue.Comments.Nodes, i, i+1)
+			issue.Comments.TotalCount = max(issue.Comments.TotalCount-1, 0)
+			return
+		}
+		if update.Body != nil {
+			comment.Body = *update.Body
+		}
+		if update.IsMinimized {
+			comment.IsMinimized = true
+		}
+		return
+	}
+}
+
 func (m Model) GetItemSingularForm() string {
 	return "Issue"
 }
@@ -442,16 +664,20 @@ func (m *Model) SetIsLoading(val bool) {
 
 func (m Model) GetPagerContent() string {
 	pagerContent := ""
+	lastUpdated := m.LastUpdated().Format("01/02 15:04:05")
+	if m.IsStale {
+		lastUpdated = "Cached " + lastUpdated
+	}
 	if m.TotalCount > 0 {
 		pagerContent = fmt.Sprintf(
 			"%v %v • %v %v/%v • Fetched %v",
 			constants.WaitingIcon,
-			m.LastUpdated().Format("01/02 15:04:05"),
+			lastUpdated,
 			m.SingularForm,
 			m.Table.GetCurrItem()+1,
 			m.TotalCount,
 			len(m.Table.Rows),
-		)
+		) + m.SortingStatus() + m.SelectionStatus()
 	}
 	pager := m.Ctx.Styles.ListViewPort.PagerStyle.Render(pagerContent)
 	return pager
@@ -0,0 +1,72 @@
+package issuessection
+
+import (
+	"cmp"
+	"strings"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+)
+
+// sortColumns are the columns issues can be sorted by, in the order the sort key cycles
+// through them.
+var sortColumns = []section.SortColumn[data.IssueData]{
+	{Name: "updatedAt", Desc: true, Compare: func(a, b data.IssueData) int {
+		return a.UpdatedAt.Compare(b.UpdatedAt)
+	}},
+	{Name: "createdAt", Desc: true, Compare: func(a, b data.IssueData) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	}},
+	{Name: "repo", Compare: func(a, b data.IssueData) int {
+		return strings.Compare(a.GetRepoNameWithOwner(), b.GetRepoNameWithOwner())
+	}},
+	{Name: "creator", Compare: func(a, b data.IssueData) int {
+		return strings.Compare(a.Author.Login, b.Author.Login)
+	}},
+	{Name: "title", Compare: func(a, b data.IssueData) int {
+		return strings.Compare(strings.ToLower(a.Title), strings.ToLower(b.Title))
+	}},
+	{Name: "state", Compare: func(a, b data.IssueData) int {
+		return strings.Compare(a.State, b.State)
+	}},
+	{Name: "comments", Desc: true, Compare: func(a, b data.IssueData) int {
+		return cmp.Compare(a.Comments.TotalCount, b.Comments.TotalCount)
+	}},
+	{Name: "reactions", Desc: true, Compare: func(a, b data.IssueData) int {
+		return cmp.Compare(a.Reactions.TotalCount, b.Reactions.TotalCount)
+	}},
+}
+
+// groupColumns are the ways issues can be grouped, in the order the group key cycles through
+// them.
+var groupColumns = []section.GroupColumn[data.IssueData]{
+	{Name: "repo", Group: func(issue data.IssueData) string {
+		return issue.GetRepoNameWithOwner()
+	}},
+	{Name: "creator", Group: func(issue data.IssueData) string {
+		return issue.Author.Login
+	}},
+	{Name: "label", None: "No label", Group: func(issue data.IssueData) string {
+		if len(issue.Labels.Nodes) == 0 {
+			return ""
+		}
+		return issue.Labels.Nodes[0].Name
+	}},
+}
+
+func (m *Model) sortIssues() {
+	section.SortRows(&m.BaseModel, m.Issues, sortColumns, groupColumns, issueKey)
+	m.Table.SetGroups(section.RowGroups(&m.BaseModel, m.Issues, groupColumns))
+}
+
+func issueKey(issue data.IssueData) string {
+	return issue.Url
+}
+
+func issueKeys(issues []data.IssueData) []string {
+	keys := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		keys = append(keys, issueKey(issue))
+	}
+	return keys
+}
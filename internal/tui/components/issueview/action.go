@@ -12,6 +12,11 @@ const (
 	IssueActionCheckout
 	IssueActionClose
 	IssueActionReopen
+	IssueActionEditProjectField
+	IssueActionMilestone
+	IssueActionEdit
+	IssueActionReact
+	IssueActionTimelineAction
 )
 
 // IssueAction represents an action to be performed on an issue.
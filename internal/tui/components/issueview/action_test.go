@@ -51,6 +51,7 @@ func TestUpdateReturnsCorrectActions(t *testing.T) {
 		{"checkout key", "C", IssueActionCheckout},
 		{"close key", "x", IssueActionClose},
 		{"reopen key", "X", IssueActionReopen},
+		{"react key", "+", IssueActionReact},
 	}
 
 	for _, tc := range testCases {
@@ -1,55 +1,70 @@
 package issueview
 
 import (
-	"sort"
-	"time"
+	"fmt"
 
 	"charm.land/glamour/v2"
 	"charm.land/lipgloss/v2"
 
-	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/common"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/markdown"
 	"github.com/dlvhdr/gh-dash/v4/internal/utils"
 )
 
-type RenderedActivity struct {
-	UpdatedAt      time.Time
-	RenderedString string
-}
-
-func (m *Model) renderActivity() string {
+// renderActivity returns the rendered timeline and the line the selected entry starts at.
+func (m *Model) renderActivity() (string, int) {
 	width := m.getIndentedContentWidth() - 2
 	markdownRenderer := markdown.GetMarkdownRenderer(width, m.ctx)
 
-	var activity []RenderedActivity
-	for _, comment := range m.issue.Data.Comments.Nodes {
-		renderedComment, err := m.renderComment(comment, markdownRenderer)
+	type renderedEntry struct {
+		key      string
+		rendered string
+	}
+	var rendered []renderedEntry
+	for _, entry := range m.timelineEntries() {
+		var r string
+		var err error
+		if entry.Event != nil {
+			r = common.RenderTimelineEvent(*entry.Event, m.ctx.Styles.Common, width) + "\n"
+		} else {
+			r, err = m.renderComment(entry, markdownRenderer)
+		}
 		if err != nil {
 			continue
 		}
-		activity = append(activity, RenderedActivity{
-			UpdatedAt:      comment.UpdatedAt,
-			RenderedString: renderedComment,
-		})
+		rendered = append(rendered, renderedEntry{key: entry.Key(), rendered: r})
 	}
 
-	sort.Slice(activity, func(i, j int) bool {
-		return activity[i].UpdatedAt.Before(activity[j].UpdatedAt)
-	})
+	title := m.renderActivitiesTitle()
+	if len(rendered) == 0 {
+		return lipgloss.JoinVertical(lipgloss.Left, title,
+			lipgloss.NewStyle().PaddingLeft(2).Render(renderEmptyState())), 0
+	}
 
-	body := ""
-	bodyStyle := lipgloss.NewStyle().PaddingLeft(2)
-	if len(activity) == 0 {
-		body = renderEmptyState()
-	} else {
-		var renderedActivities []string
-		for _, activity := range activity {
-			renderedActivities = append(renderedActivities, activity.RenderedString)
+	parts := []string{title}
+	selectedLine := 0
+	for _, entry := range rendered {
+		prefix := " "
+		if entry.key != "" && entry.key == m.entry {
+			selectedLine = lipgloss.Height(lipgloss.JoinVertical(lipgloss.Left, parts...))
+			prefix = constants.SelectionIcon
 		}
-		body = lipgloss.JoinVertical(lipgloss.Left, renderedActivities...)
+		parts = append(parts, lipgloss.JoinHorizontal(lipgloss.Top,
+			prefix, " ", entry.rendered))
 	}
 
-	return lipgloss.JoinVertical(lipgloss.Left, m.renderActivitiesTitle(), bodyStyle.Render(body))
+	hint := fmt.Sprintf("Select an entry with %s/%s, press %s to react or %s to act on it",
+		keys.IssueKeys.PrevComment.Help().Key,
+		keys.IssueKeys.NextComment.Help().Key,
+		keys.IssueKeys.React.Help().Key,
+		keys.IssueKeys.TimelineAction.Help().Key,
+	)
+	parts = append(parts, m.ctx.Styles.Common.FaintTextStyle.Italic(true).
+		Width(m.getIndentedContentWidth()).Render(hint), "")
+
+	return lipgloss.JoinVertical(lipgloss.Left, parts...), selectedLine
 }
 
 func (m Model) renderActivitiesTitle() string {
@@ -64,7 +79,7 @@ func renderEmptyState() string {
 }
 
 func (m *Model) renderComment(
-	comment data.IssueComment,
+	comment common.TimelineEntry,
 	markdownRenderer glamour.TermRenderer,
 ) (string, error) {
 	width := m.getIndentedContentWidth() - 2
@@ -74,19 +89,25 @@ func (m *Model) renderComment(
 		BorderForeground(m.ctx.Theme.FaintBorder).Render(
 		lipgloss.JoinHorizontal(
 			lipgloss.Top,
-			m.ctx.Styles.Common.MainTextStyle.Render(comment.Author.Login),
+			m.ctx.Styles.Common.MainTextStyle.Render(comment.Author),
 			" ",
 			lipgloss.NewStyle().
 				Foreground(m.ctx.Theme.FaintText).
 				Render(utils.TimeElapsed(comment.UpdatedAt)),
 		))
 
+	if comment.Meta.IsMinimized {
+		return lipgloss.JoinVertical(lipgloss.Left, header, m.ctx.Styles.Common.FaintTextStyle.
+			Italic(true).Render("This was hidden.")+"\n"), nil
+	}
+
 	body := lineCleanupRegex.ReplaceAllString(comment.Body, "")
 	body, err := markdownRenderer.Render(body)
 
-	return lipgloss.JoinVertical(
-		lipgloss.Left,
-		header,
-		body,
-	), err
+	parts := []string{header, body}
+	if reactions := common.RenderReactions(comment.ReactionGroups,
+		m.ctx.Styles.Common); reactions != "" {
+		parts = append(parts, reactions, "")
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, parts...), err
 }
@@ -0,0 +1,77 @@
+package issueview
+
+import (
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/common"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/cmpcontroller"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/fuzzyselect"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/issuessection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+)
+
+// bulkModes are the inputs that can apply to several issues at once.
+var bulkModes = []cmpcontroller.Mode{
+	cmpcontroller.ModeComment,
+	cmpcontroller.ModeAssign,
+	cmpcontroller.ModeUnassign,
+	cmpcontroller.ModeLabel,
+	cmpcontroller.ModeMilestone,
+}
+
+// SetBulkRows makes the next comment, assignment, labeling or milestone apply to all of rows
+// instead of only the previewed issue. A nil rows goes back to acting on the previewed issue.
+func (m *Model) SetBulkRows(rows []data.RowData) {
+	m.bulkRows = rows
+}
+
+func (m *Model) isBulk() bool {
+	return len(m.bulkRows) > 0
+}
+
+// prompt returns the prompt of an input, noting how many issues it applies to when acting on
+// several of them.
+func (m *Model) prompt(prompt string) string {
+	if !m.isBulk() {
+		return prompt
+	}
+	return common.BulkPrompt(prompt, len(m.bulkRows), "issues")
+}
+
+// submitBulk runs the action of mode on all bulk rows, reported as a single task.
+func (m *Model) submitBulk(mode cmpcontroller.Mode, value string) tea.Cmd {
+	rows := m.bulkRows
+	m.bulkRows = nil
+	sid := tasks.SectionIdentifier{Id: m.sectionId, Type: issuessection.SectionType}
+
+	switch mode {
+	case cmpcontroller.ModeComment:
+		if len(strings.TrimSpace(value)) != 0 {
+			return tasks.CommentOnIssues(m.ctx, sid, rows, value)
+		}
+
+	case cmpcontroller.ModeAssign:
+		if usernames := fuzzyselect.AllWords(value); len(usernames) > 0 {
+			return tasks.AssignIssues(m.ctx, sid, rows, usernames)
+		}
+
+	case cmpcontroller.ModeUnassign:
+		if usernames := fuzzyselect.AllWords(value); len(usernames) > 0 {
+			return tasks.UnassignIssues(m.ctx, sid, rows, usernames)
+		}
+
+	case cmpcontroller.ModeLabel:
+		if names := fuzzyselect.CurrentLabels(value); len(names) > 0 {
+			labels := common.LabelsWithColors(m.issue.Data.GetRepoNameWithOwner(), names)
+			return tasks.AddLabelsToIssues(m.ctx, sid, rows, labels)
+		}
+
+	case cmpcontroller.ModeMilestone:
+		milestone := common.MilestoneWithTitle(m.issue.Data.GetRepoNameWithOwner(), value)
+		return tasks.SetIssuesMilestone(m.ctx, sid, rows, milestone)
+	}
+	return nil
+}
@@ -9,7 +9,7 @@ import (
 
 	tea "charm.land/bubbletea/v2"
 
-	"github.com/dlvhdr/gh-dash/v4/internal/tui/common"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
 )
@@ -21,14 +21,13 @@ func (m *Model) Checkout() (tea.Cmd, error) {
 
 	issue := m.issue.Data
 	repoName := issue.GetRepoNameWithOwner()
-	repoPath, ok := common.GetRepoLocalPath(repoName, m.ctx.Config.RepoPaths)
-	if !ok {
-		return nil, errors.New(
-			"local path to repo not specified, set one in your config.yml under repoPaths",
-		)
-	}
-
 	issueNumber := issue.GetNumber()
+	return tasks.WithRepoPath(m.ctx, repoName, func(repoPath string) tea.Cmd {
+		return m.checkoutIssue(repoPath, repoName, issueNumber)
+	})
+}
+
+func (m *Model) checkoutIssue(repoPath string, repoName string, issueNumber int) tea.Cmd {
 	taskId := fmt.Sprintf("issue_checkout_%d", issueNumber)
 	task := context.Task{
 		Id:        taskId,
@@ -60,5 +59,5 @@ func (m *Model) Checkout() (tea.Cmd, error) {
 		c.Dir = repoPath
 		err := c.Run()
 		return constants.TaskFinishedMsg{TaskId: taskId, Err: err}
-	}), nil
+	})
 }
@@ -0,0 +1,20 @@
+package issueview
+
+import (
+	"errors"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/issuessection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+)
+
+// Edit opens the title and body of the issue in the editor.
+func (m *Model) Edit() (tea.Cmd, error) {
+	if m.issue == nil {
+		return nil, errors.New("no issue selected")
+	}
+
+	sid := tasks.SectionIdentifier{Id: m.sectionId, Type: issuessection.SectionType}
+	return tasks.EditIssue(m.ctx, sid, m.issue.Data), nil
+}
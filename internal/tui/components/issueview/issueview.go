@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"image/color"
 	"regexp"
+	"slices"
 	"strings"
 
 	"charm.land/bubbles/v2/key"
@@ -36,6 +37,16 @@ type Model struct {
 	sectionId int
 	width     int
 	editor    cmpcontroller.Controller
+	bulkRows  []data.RowData
+	// projectFields completes the project fields while editing one, and holds the fields to
+	// resolve the edit with
+	projectFields *fuzzyselect.ProjectFieldSource
+	newIssue      newIssueState
+	// entry is the key of the selected entry of the timeline, reactions and timeline actions
+	// apply to it instead of the issue
+	entry string
+	// projectItemsUrl is the issue whose project items were last fetched
+	projectItemsUrl string
 }
 
 func NewModel(ctx *context.ProgramContext) Model {
@@ -56,10 +67,17 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd, *IssueAction) {
 		value := m.editor.Value()
 		mode := m.editor.Mode()
 		m.editor.Exit()
+		if m.newIssue.open {
+			return m, m.setNewIssueField(value), nil
+		}
 		if m.issue == nil {
 			return m, nil, nil
 		}
 
+		if m.isBulk() && slices.Contains(bulkModes, mode) {
+			return m, m.submitBulk(mode, value), nil
+		}
+
 		sid := tasks.SectionIdentifier{Id: m.sectionId, Type: issuessection.SectionType}
 
 		switch mode {
@@ -95,22 +113,68 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd, *IssueAction) {
 				), nil
 			}
 			return m, nil, nil
+
+		case cmpcontroller.ModeProjectField:
+			field, fieldValue, ok := fuzzyselect.ParseProjectFieldValue(value)
+			if ok && m.projectFields != nil {
+				return m, tasks.SetProjectField(
+					m.ctx,
+					sid,
+					m.issue.Data,
+					m.projectFields.Items,
+					field,
+					fieldValue,
+				), nil
+			}
+			return m, nil, nil
+
+		case cmpcontroller.ModeMilestone:
+			milestone := common.MilestoneWithTitle(m.issue.Data.GetRepoNameWithOwner(), value)
+			if milestone != nil || m.issue.Data.Milestone != nil {
+				return m, tasks.SetIssueMilestone(m.ctx, sid, m.issue.Data, milestone), nil
+			}
+			return m, nil, nil
+		case cmpcontroller.ModeReaction:
+			return m, m.react(value), nil
+
+		case cmpcontroller.ModeTimelineAction:
+			return m, m.actOnEntry(value), nil
 		}
 	}
 	if handled {
 		return m, cmd, nil
 	}
 
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.newIssue.open {
+		return m, m.updateNewIssue(keyMsg), nil
+	}
+
 	if keyMsg, ok := msg.(tea.KeyMsg); ok {
 		switch {
 		case key.Matches(keyMsg, keys.IssueKeys.Label):
 			return m, nil, &IssueAction{Type: IssueActionLabel}
+		case key.Matches(keyMsg, keys.IssueKeys.EditProjectField):
+			return m, nil, &IssueAction{Type: IssueActionEditProjectField}
+		case key.Matches(keyMsg, keys.IssueKeys.Milestone):
+			return m, nil, &IssueAction{Type: IssueActionMilestone}
 		case key.Matches(keyMsg, keys.IssueKeys.Assign):
 			return m, nil, &IssueAction{Type: IssueActionAssign}
 		case key.Matches(keyMsg, keys.IssueKeys.Unassign):
 			return m, nil, &IssueAction{Type: IssueActionUnassign}
 		case key.Matches(keyMsg, keys.IssueKeys.Comment):
 			return m, nil, &IssueAction{Type: IssueActionComment}
+		case key.Matches(keyMsg, keys.IssueKeys.Edit):
+			return m, nil, &IssueAction{Type: IssueActionEdit}
+		case key.Matches(keyMsg, keys.IssueKeys.React):
+			return m, nil, &IssueAction{Type: IssueActionReact}
+		case key.Matches(keyMsg, keys.IssueKeys.TimelineAction):
+			return m, nil, &IssueAction{Type: IssueActionTimelineAction}
+		case key.Matches(keyMsg, keys.IssueKeys.PrevComment):
+			m.entry = common.SelectTimelineEntry(m.timelineEntries(), m.entry, -1)
+			return m, nil, nil
+		case key.Matches(keyMsg, keys.IssueKeys.NextComment):
+			m.entry = common.SelectTimelineEntry(m.timelineEntries(), m.entry, 1)
+			return m, nil, nil
 		case key.Matches(keyMsg, keys.IssueKeys.Checkout):
 			return m, nil, &IssueAction{Type: IssueActionCheckout}
 		case key.Matches(keyMsg, keys.IssueKeys.Close):
@@ -124,6 +188,26 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd, *IssueAction) {
 }
 
 func (m Model) View() string {
+	if m.newIssue.open {
+		return lipgloss.NewStyle().Padding(0, m.ctx.Styles.Sidebar.ContentPadding).
+			Render(m.renderNewIssueForm())
+	}
+
+	s := strings.Builder{}
+	s.WriteString(m.viewHeader())
+
+	activity, _ := m.renderActivity()
+	s.WriteString(activity)
+
+	if m.editor.Mode() != cmpcontroller.ModeNone {
+		s.WriteString(m.ctx.Styles.Sidebar.InputBox.Render(m.editor.View()))
+	}
+
+	return lipgloss.NewStyle().Padding(0, m.ctx.Styles.Sidebar.ContentPadding).Render(s.String())
+}
+
+// viewHeader renders everything shown above the comments.
+func (m *Model) viewHeader() string {
 	s := strings.Builder{}
 
 	s.WriteString(m.renderFullNameAndNumber())
@@ -142,19 +226,34 @@ func (m Model) View() string {
 		s.WriteString("\n\n")
 	}
 
+	milestone := common.RenderMilestone(m.issue.Data.Milestone, m.ctx.Styles.Common,
+		m.getIndentedContentWidth())
+	if milestone != "" {
+		s.WriteString(milestone)
+		s.WriteString("\n\n")
+	}
+
+	projects := common.RenderProjectItems(m.issue.Data.ProjectItems, m.ctx.Styles.Common,
+		m.getIndentedContentWidth())
+	if projects != "" {
+		s.WriteString(projects)
+		s.WriteString("\n\n")
+	}
+
 	s.WriteString(m.renderBody())
 	s.WriteString("\n\n")
-	s.WriteString(m.renderActivity())
 
-	if m.editor.Mode() != cmpcontroller.ModeNone {
-		s.WriteString(m.ctx.Styles.Sidebar.InputBox.Render(m.editor.View()))
+	if reactions := common.RenderReactions(m.issue.Data.ReactionGroups,
+		m.ctx.Styles.Common); reactions != "" {
+		s.WriteString(reactions)
+		s.WriteString("\n\n")
 	}
 
-	return lipgloss.NewStyle().Padding(0, m.ctx.Styles.Sidebar.ContentPadding).Render(s.String())
+	return s.String()
 }
 
 func (m *Model) ViewCompletions() string {
-	if !m.hasData() {
+	if !m.hasData() && !m.newIssue.open {
 		return ""
 	}
 
@@ -266,6 +365,9 @@ func (m *Model) SetSectionId(id int) {
 }
 
 func (m *Model) SetRow(data *data.IssueData) {
+	if data == nil || m.issue == nil || data.Url != m.issue.Data.Url {
+		m.entry = ""
+	}
 	if data == nil {
 		m.issue = nil
 	} else {
@@ -273,6 +375,30 @@ func (m *Model) SetRow(data *data.IssueData) {
 	}
 }
 
+// ProjectItemsFetchedMsg holds the project items of an issue of a section whose search didn't
+// fetch them.
+type ProjectItemsFetchedMsg struct {
+	Id    int
+	Url   string
+	Items data.ProjectItems
+	Err   error
+}
+
+// LoadProjectItems fetches the project items of the current issue, so the sidebar shows them
+// even when no layout has a project field column.
+func (m *Model) LoadProjectItems() tea.Cmd {
+	if m.issue == nil || data.IsFetchingProjectItems() || m.projectItemsUrl == m.issue.Data.Url {
+		return nil
+	}
+	url := m.issue.Data.Url
+	m.projectItemsUrl = url
+	sectionId := m.sectionId
+	return func() tea.Msg {
+		items, err := data.FetchProjectItems(url)
+		return ProjectItemsFetchedMsg{Id: sectionId, Url: url, Items: items, Err: err}
+	}
+}
+
 func (m *Model) IsTextInputBoxFocused() bool {
 	return m.editor.Active()
 }
@@ -305,7 +431,7 @@ func (m *Model) SetIsCommenting(isCommenting bool) tea.Cmd {
 	m.editor.SetAutocompleteSource(&fuzzyselect.UserMentionSource{WithAtSymbol: true})
 	cmd := m.editor.Enter(cmpcontroller.EnterOptions{
 		Mode:                             cmpcontroller.ModeComment,
-		Prompt:                           constants.CommentPrompt,
+		Prompt:                           m.prompt(constants.CommentPrompt),
 		Repo:                             m.repoRef(),
 		EnterFetch:                       cmpcontroller.FetchSilent,
 		ConfirmDiscardOnCancel:           true,
@@ -331,14 +457,14 @@ func (m *Model) SetIsAssigning(isAssigning bool) tea.Cmd {
 	}
 
 	initialValue := ""
-	if !m.userAssignedToIssue(m.ctx.User) {
+	if m.isBulk() || !m.userAssignedToIssue(m.ctx.User) {
 		initialValue = m.ctx.User
 	}
 
 	m.editor.SetAutocompleteSource(&fuzzyselect.UserMentionSource{WithAtSymbol: false})
 	cmd := m.editor.Enter(cmpcontroller.EnterOptions{
 		Mode:                             cmpcontroller.ModeAssign,
-		Prompt:                           constants.AssignPrompt,
+		Prompt:                           m.prompt(constants.AssignPrompt),
 		InitialValue:                     initialValue,
 		Repo:                             m.repoRef(),
 		EnterFetch:                       cmpcontroller.FetchSilent,
@@ -359,16 +485,22 @@ func (m *Model) SetIsLabeling(isLabeling bool) tea.Cmd {
 		return nil
 	}
 
+	// Labels can only be added when acting on several issues, so none are prefilled
+	prompt := constants.LabelPrompt
 	labels := make([]string, 0, len(m.issue.Data.Labels.Nodes)+1)
-	for _, label := range m.issue.Data.Labels.Nodes {
-		labels = append(labels, label.Name)
+	if m.isBulk() {
+		prompt = m.prompt(constants.BulkLabelPrompt)
+	} else {
+		for _, label := range m.issue.Data.Labels.Nodes {
+			labels = append(labels, label.Name)
+		}
 	}
 	labels = append(labels, "")
 
 	m.editor.SetAutocompleteSource(&fuzzyselect.LabelSource{})
 	cmd := m.editor.Enter(cmpcontroller.EnterOptions{
 		Mode:                             cmpcontroller.ModeLabel,
-		Prompt:                           constants.LabelPrompt,
+		Prompt:                           prompt,
 		InitialValue:                     strings.Join(labels, ", "),
 		Repo:                             m.repoRef(),
 		EnterFetch:                       cmpcontroller.FetchSilent,
@@ -378,6 +510,61 @@ func (m *Model) SetIsLabeling(isLabeling bool) tea.Cmd {
 	return cmd
 }
 
+// SetIsSettingMilestone enters or exits setting the milestone of the issue
+func (m *Model) SetIsSettingMilestone(isSetting bool) tea.Cmd {
+	if m.issue == nil {
+		return nil
+	}
+
+	if !isSetting {
+		if m.editor.Mode() == cmpcontroller.ModeMilestone {
+			m.editor.Exit()
+		}
+		return nil
+	}
+
+	var title string
+	if m.issue.Data.Milestone != nil && !m.isBulk() {
+		title = m.issue.Data.Milestone.Title
+	}
+
+	m.editor.SetAutocompleteSource(&fuzzyselect.MilestoneSource{})
+	cmd := m.editor.Enter(cmpcontroller.EnterOptions{
+		Mode:         cmpcontroller.ModeMilestone,
+		Prompt:       m.prompt(constants.MilestonePrompt),
+		InitialValue: title,
+		Repo:         m.repoRef(),
+		EnterFetch:   cmpcontroller.FetchSilent,
+	})
+	m.editor.ShowCompletions()
+	return cmd
+}
+
+// SetIsEditingProjectField enters or exits setting a field of the projects of the issue
+func (m *Model) SetIsEditingProjectField(isEditing bool) tea.Cmd {
+	if m.issue == nil {
+		return nil
+	}
+
+	if !isEditing {
+		if m.editor.Mode() == cmpcontroller.ModeProjectField {
+			m.editor.Exit()
+		}
+		return nil
+	}
+
+	m.projectFields = &fuzzyselect.ProjectFieldSource{Url: m.issue.Data.GetUrl()}
+	m.editor.SetAutocompleteSource(m.projectFields)
+	cmd := m.editor.Enter(cmpcontroller.EnterOptions{
+		Mode:       cmpcontroller.ModeProjectField,
+		Prompt:     constants.ProjectFieldPrompt,
+		Repo:       m.repoRef(),
+		EnterFetch: cmpcontroller.FetchWithLoading,
+	})
+	m.editor.ShowCompletions()
+	return cmd
+}
+
 func (m *Model) userAssignedToIssue(login string) bool {
 	for _, a := range m.issue.Data.Assignees.Nodes {
 		if login == a.Login {
@@ -403,10 +590,15 @@ func (m *Model) SetIsUnassigning(isUnassigning bool) tea.Cmd {
 		return nil
 	}
 
+	initialValue := strings.Join(m.issueAssignees(), "\n")
+	if m.isBulk() {
+		initialValue = ""
+	}
+
 	cmd := m.editor.Enter(cmpcontroller.EnterOptions{
 		Mode:         cmpcontroller.ModeUnassign,
-		Prompt:       constants.UnassignPrompt,
-		InitialValue: strings.Join(m.issueAssignees(), "\n"),
+		Prompt:       m.prompt(constants.UnassignPrompt),
+		InitialValue: initialValue,
 		Repo:         m.repoRef(),
 	})
 	return cmd
@@ -2,11 +2,15 @@ package issueview
 
 import (
 	"testing"
+	"time"
 
+	tea "charm.land/bubbletea/v2"
 	"github.com/stretchr/testify/require"
 
 	"github.com/dlvhdr/gh-dash/v4/internal/config"
 	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/cmpcontroller"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/theme"
 )
@@ -51,3 +55,94 @@ func TestRenderBodyDoesNotPanicBeforeContextSync(t *testing.T) {
 		_ = m.renderBody()
 	})
 }
+
+func TestCommentSelectionPicksReactionSubject(t *testing.T) {
+	m := NewModel(newTestContext(t))
+	m.SetWidth(80)
+	now := time.Now()
+	m.SetRow(&data.IssueData{
+		Id:     "I_3",
+		Number: 3,
+		Url:    "https://github.com/dlvhdr/gh-dash/issues/3",
+		Comments: data.IssueComments{Nodes: []data.IssueComment{
+			{Id: "IC_2", Author: struct{ Login string }{Login: "octo"}, UpdatedAt: now},
+			{Id: "IC_1", UpdatedAt: now.Add(-time.Hour)},
+		}},
+	})
+
+	subject, _ := m.reactionSubject()
+	require.Equal(t, "I_3", subject.Id)
+	require.Zero(t, m.SelectionOffset())
+
+	m, _, _ = m.Update(tea.KeyPressMsg{Text: "}"})
+	m, _, _ = m.Update(tea.KeyPressMsg{Text: "}"})
+	subject, _ = m.reactionSubject()
+	require.Equal(t, "IC_2", subject.Id, "comments are selected oldest first")
+	require.Equal(t, "@octo's comment on issue #3", subject.Name)
+	require.Positive(t, m.SelectionOffset())
+
+	m.SetRow(&data.IssueData{Url: "https://github.com/dlvhdr/gh-dash/issues/4"})
+	require.Empty(t, m.entry, "the selection is reset for another issue")
+}
+
+func TestQuoteReplyToSelectedComment(t *testing.T) {
+	m := NewModel(newTestContext(t))
+	m.SetWidth(80)
+	m.SetRow(&data.IssueData{
+		Id:     "I_3",
+		Number: 3,
+		Comments: data.IssueComments{Nodes: []data.IssueComment{
+			{Id: "IC_1", Body: "Can you add a test?", UpdatedAt: time.Now()},
+		}},
+	})
+
+	m.SetIsActingOnEntry(true)
+	require.False(t, m.GetIsActingOnEntry(), "nothing is selected to act on")
+
+	m, _, _ = m.Update(tea.KeyPressMsg{Text: "}"})
+	m.SetIsActingOnEntry(true)
+	require.True(t, m.GetIsActingOnEntry())
+
+	m.editor.Exit()
+	m.actOnEntry("quote_reply")
+	require.Equal(t, cmpcontroller.ModeComment, m.editor.Mode())
+	require.Equal(t, "> Can you add a test?\n\n", m.editor.Value())
+}
+
+func TestDeleteCommentAsksForConfirmation(t *testing.T) {
+	m := NewModel(newTestContext(t))
+	m.SetWidth(80)
+	m.SetRow(&data.IssueData{
+		Id:     "I_3",
+		Number: 3,
+		Comments: data.IssueComments{Nodes: []data.IssueComment{{
+			Id:          "IC_1",
+			Author:      struct{ Login string }{Login: "octo"},
+			UpdatedAt:   time.Now(),
+			CommentMeta: data.CommentMeta{ViewerDidAuthor: true, ViewerCanDelete: true},
+		}}},
+	})
+	m, _, _ = m.Update(tea.KeyPressMsg{Text: "}"})
+
+	cmd := m.actOnEntry("delete")
+	require.NotNil(t, cmd)
+	confirm, ok := cmd().(section.ConfirmMsg)
+	require.True(t, ok, "the comment is only deleted once confirmed")
+	require.Equal(t, "Are you sure you want to delete @octo's comment on issue #3? (y/N) ",
+		confirm.Prompt)
+	require.NotNil(t, confirm.Action)
+}
+
+func TestLoadProjectItems(t *testing.T) {
+	m := NewModel(newTestContext(t))
+	require.Nil(t, m.LoadProjectItems(), "there is no issue to fetch the project items of")
+
+	m.SetRow(&data.IssueData{Url: "https://github.com/dlvhdr/gh-dash/issues/1"})
+	require.NotNil(t, m.LoadProjectItems())
+	require.Nil(t, m.LoadProjectItems(), "the project items are only fetched once")
+
+	data.SetFetchProjectItems(true)
+	defer data.SetFetchProjectItems(false)
+	m.SetRow(&data.IssueData{Url: "https://github.com/dlvhdr/gh-dash/issues/2"})
+	require.Nil(t, m.LoadProjectItems(), "the search already fetched the project items")
+}
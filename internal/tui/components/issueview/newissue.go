@@ -0,0 +1,397 @@
+package issueview
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/cmpcontroller"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/fuzzyselect"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/issuessection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+)
+
+type newIssueField int
+
+const (
+	newIssueFieldRepo newIssueField = iota
+	newIssueFieldTemplate
+	newIssueFieldTitle
+	newIssueFieldBody
+	newIssueFieldLabels
+	newIssueFieldAssignees
+	newIssueFieldCreate
+)
+
+var newIssueFields = []newIssueField{
+	newIssueFieldRepo,
+	newIssueFieldTemplate,
+	newIssueFieldTitle,
+	newIssueFieldBody,
+	newIssueFieldLabels,
+	newIssueFieldAssignees,
+	newIssueFieldCreate,
+}
+
+// blankTemplate is the template option of an issue that doesn't start from a template.
+const blankTemplate = -1
+
+type newIssueState struct {
+	open  bool
+	field newIssueField
+	repo  string
+	// templates are the issue templates of repo, nil while they're being fetched
+	templates    []data.IssueTemplate
+	templatesErr error
+	template     int
+	title        string
+	body         string
+	labels       []string
+	assignees    []string
+}
+
+// IssueTemplatesFetchedMsg holds the issue templates of the repo of a new issue.
+type IssueTemplatesFetchedMsg struct {
+	Repo      string
+	Templates []data.IssueTemplate
+	Err       error
+}
+
+type newIssueKeyMap struct {
+	Up      key.Binding
+	Down    key.Binding
+	Prev    key.Binding
+	Next    key.Binding
+	Confirm key.Binding
+	Cancel  key.Binding
+}
+
+// newIssueKeys are only active while the new issue form is open.
+var newIssueKeys = newIssueKeyMap{
+	Up: key.NewBinding(
+		key.WithKeys("up", "k"),
+		key.WithHelp("↑/k", "up"),
+	),
+	Down: key.NewBinding(
+		key.WithKeys("down", "j"),
+		key.WithHelp("↓/j", "down"),
+	),
+	Prev: key.NewBinding(
+		key.WithKeys("left", "h"),
+		key.WithHelp("←/h", "previous template"),
+	),
+	Next: key.NewBinding(
+		key.WithKeys("right", "l", "space"),
+		key.WithHelp("←/→", "change template"),
+	),
+	Confirm: key.NewBinding(
+		key.WithKeys("enter"),
+		key.WithHelp("enter", "edit/create"),
+	),
+	Cancel: key.NewBinding(
+		key.WithKeys("esc", "q"),
+		key.WithHelp("esc", "cancel"),
+	),
+}
+
+func (m *Model) IsNewIssueFormOpen() bool {
+	return m.newIssue.open
+}
+
+// SetIsCreatingIssue opens or closes the form of a new issue. The issue goes to the repo of the
+// current directory by default, or else to the repo of the shown issue.
+func (m *Model) SetIsCreatingIssue(isCreating bool) tea.Cmd {
+	if !isCreating {
+		if m.isEditingNewIssue() {
+			m.editor.Exit()
+		}
+		m.newIssue = newIssueState{}
+		return nil
+	}
+
+	var repo string
+	if m.ctx.HasGHRepo() {
+		repo = m.ctx.GHRepo.Owner + "/" + m.ctx.GHRepo.Name
+	} else if m.issue != nil {
+		repo = m.issue.Data.GetRepoNameWithOwner()
+	}
+
+	m.newIssue = newIssueState{open: true, field: newIssueFieldTitle}
+	if repo == "" {
+		m.newIssue.field = newIssueFieldRepo
+	}
+	return m.setNewIssueRepo(repo)
+}
+
+func (m *Model) isEditingNewIssue() bool {
+	mode := m.editor.Mode()
+	return mode == cmpcontroller.ModeNewIssue || mode == cmpcontroller.ModeNewIssueList
+}
+
+// setNewIssueRepo changes the repo of the new issue and fetches its templates.
+func (m *Model) setNewIssueRepo(repo string) tea.Cmd {
+	m.newIssue.repo = repo
+	m.newIssue.templates = nil
+	m.newIssue.templatesErr = nil
+	m.newIssue.template = blankTemplate
+	if repo == "" {
+		return nil
+	}
+
+	return func() tea.Msg {
+		templates, err := data.FetchIssueTemplates(repo)
+		return IssueTemplatesFetchedMsg{Repo: repo, Templates: templates, Err: err}
+	}
+}
+
+// SetIssueTemplates shows the fetched templates in the new issue form, starting an issue
+// without a title or description from the first one. It reports whether the form shows them.
+func (m *Model) SetIssueTemplates(msg IssueTemplatesFetchedMsg) bool {
+	if !m.newIssue.open || msg.Repo != m.newIssue.repo {
+		return false
+	}
+
+	m.newIssue.templates = msg.Templates
+	m.newIssue.templatesErr = msg.Err
+	if m.newIssue.templates == nil {
+		m.newIssue.templates = []data.IssueTemplate{}
+	}
+	if len(msg.Templates) > 0 && m.newIssue.title == "" && m.newIssue.body == "" {
+		m.applyIssueTemplate(0)
+	}
+	return true
+}
+
+// applyIssueTemplate replaces the title, description, labels and assignees of the new issue
+// with the ones of the template.
+func (m *Model) applyIssueTemplate(i int) {
+	m.newIssue.template = i
+	if i == blankTemplate {
+		m.newIssue.title, m.newIssue.body = "", ""
+		m.newIssue.labels, m.newIssue.assignees = nil, nil
+		return
+	}
+
+	template := m.newIssue.templates[i]
+	m.newIssue.title = template.Title
+	m.newIssue.body = template.Body
+	m.newIssue.labels = slices.Clone(template.Labels)
+	m.newIssue.assignees = slices.Clone(template.Assignees)
+}
+
+func (m *Model) updateNewIssue(msg tea.KeyMsg) tea.Cmd {
+	i := max(0, slices.Index(newIssueFields, m.newIssue.field))
+
+	switch {
+	case key.Matches(msg, newIssueKeys.Cancel):
+		m.newIssue = newIssueState{}
+	case key.Matches(msg, newIssueKeys.Up):
+		m.newIssue.field = newIssueFields[max(0, i-1)]
+	case key.Matches(msg, newIssueKeys.Down):
+		m.newIssue.field = newIssueFields[min(len(newIssueFields)-1, i+1)]
+	case key.Matches(msg, newIssueKeys.Prev):
+		m.changeIssueTemplate(-1)
+	case key.Matches(msg, newIssueKeys.Next):
+		m.changeIssueTemplate(1)
+	case key.Matches(msg, newIssueKeys.Confirm):
+		switch m.newIssue.field {
+		case newIssueFieldTemplate:
+			m.changeIssueTemplate(1)
+		case newIssueFieldCreate:
+			return m.submitNewIssue()
+		default:
+			return m.editNewIssueField()
+		}
+	}
+	return nil
+}
+
+// changeIssueTemplate cycles through a blank issue and the templates of the repo.
+func (m *Model) changeIssueTemplate(delta int) {
+	if m.newIssue.field != newIssueFieldTemplate {
+		return
+	}
+	n := len(m.newIssue.templates) + 1
+	option := ((m.newIssue.template+1+delta)%n + n) % n
+	m.applyIssueTemplate(option - 1)
+}
+
+func (m *Model) editNewIssueField() tea.Cmd {
+	owner, name, _ := strings.Cut(m.newIssue.repo, "/")
+	opts := cmpcontroller.EnterOptions{
+		Mode: cmpcontroller.ModeNewIssue,
+		Repo: cmpcontroller.RepoRef{NameWithOwner: m.newIssue.repo, Owner: owner, Name: name},
+	}
+
+	switch m.newIssue.field {
+	case newIssueFieldRepo:
+		opts.Prompt, opts.InitialValue = constants.IssueRepoPrompt, m.newIssue.repo
+	case newIssueFieldTitle:
+		opts.Prompt, opts.InitialValue = constants.IssueTitlePrompt, m.newIssue.title
+	case newIssueFieldBody:
+		opts.Prompt, opts.InitialValue = constants.IssueBodyPrompt, m.newIssue.body
+	case newIssueFieldLabels:
+		m.editor.SetAutocompleteSource(&fuzzyselect.LabelSource{})
+		opts.Mode, opts.Prompt = cmpcontroller.ModeNewIssueList, constants.IssueLabelsPrompt
+		opts.InitialValue = strings.Join(append(slices.Clone(m.newIssue.labels), ""), ", ")
+		opts.EnterFetch = cmpcontroller.FetchSilent
+	case newIssueFieldAssignees:
+		m.editor.SetAutocompleteSource(&fuzzyselect.UserMentionSource{WithAtSymbol: false})
+		opts.Mode, opts.Prompt = cmpcontroller.ModeNewIssueList, constants.IssueAssigneesPrompt
+		opts.InitialValue = strings.Join(m.newIssue.assignees, " ")
+		opts.EnterFetch = cmpcontroller.FetchSilent
+	}
+
+	cmd := m.editor.Enter(opts)
+	if opts.Mode == cmpcontroller.ModeNewIssueList {
+		m.editor.ShowCompletions()
+	}
+	return cmd
+}
+
+// setNewIssueField sets the field of the new issue that was edited to the submitted value.
+func (m *Model) setNewIssueField(value string) tea.Cmd {
+	switch m.newIssue.field {
+	case newIssueFieldRepo:
+		if repo := strings.TrimSpace(value); repo != m.newIssue.repo {
+			return m.setNewIssueRepo(repo)
+		}
+	case newIssueFieldTitle:
+		title, _, _ := strings.Cut(strings.TrimSpace(value), "\n")
+		m.newIssue.title = title
+	case newIssueFieldBody:
+		m.newIssue.body = strings.TrimSpace(value)
+	case newIssueFieldLabels:
+		m.newIssue.labels = fuzzyselect.CurrentLabels(value)
+	case newIssueFieldAssignees:
+		m.newIssue.assignees = fuzzyselect.AllWords(value)
+	}
+	return nil
+}
+
+// submitNewIssue creates the issue, or moves to the first required field that's empty.
+func (m *Model) submitNewIssue() tea.Cmd {
+	state := m.newIssue
+	switch {
+	case state.repo == "":
+		m.newIssue.field = newIssueFieldRepo
+		return nil
+	case strings.TrimSpace(state.title) == "":
+		m.newIssue.field = newIssueFieldTitle
+		return nil
+	}
+	m.newIssue = newIssueState{}
+
+	sid := tasks.SectionIdentifier{Id: m.sectionId, Type: issuessection.SectionType}
+	return tasks.CreateIssue(m.ctx, sid, tasks.IssueDraft{
+		Repo:      state.repo,
+		Title:     strings.TrimSpace(state.title),
+		Body:      state.body,
+		Labels:    state.labels,
+		Assignees: state.assignees,
+	})
+}
+
+func (m *Model) renderNewIssueForm() string {
+	selected := lipgloss.NewStyle().Bold(true).Foreground(m.ctx.Theme.PrimaryText)
+	faint := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText)
+	label := lipgloss.NewStyle().Width(14).Foreground(m.ctx.Theme.SecondaryText)
+	orFaint := func(value string, placeholder string) string {
+		if value == "" {
+			return faint.Render(placeholder)
+		}
+		return value
+	}
+
+	rows := []string{m.ctx.Styles.Common.MainTextStyle.Render("New issue")}
+	for _, field := range newIssueFields {
+		var name, value string
+		switch field {
+		case newIssueFieldRepo:
+			name, value = "Repository", orFaint(m.newIssue.repo, "required")
+		case newIssueFieldTemplate:
+			name, value = "Template", m.renderTemplateOption()
+		case newIssueFieldTitle:
+			name, value = "Title", orFaint(m.newIssue.title, "required")
+		case newIssueFieldBody:
+			name = "Description"
+			value = orFaint(strings.ReplaceAll(m.newIssue.body, "\n", " "), "empty")
+		case newIssueFieldLabels:
+			name, value = "Labels", orFaint(strings.Join(m.newIssue.labels, ", "), "none")
+		case newIssueFieldAssignees:
+			name, value = "Assignees", orFaint(strings.Join(m.newIssue.assignees, " "), "none")
+		case newIssueFieldCreate:
+			name = "Create issue"
+		}
+
+		prefix := "  "
+		if field == m.newIssue.field {
+			prefix = constants.SelectionIcon + " "
+			name = selected.Render(name)
+		}
+		if field == newIssueFieldCreate {
+			rows = append(rows, "")
+		}
+		row := lipgloss.JoinHorizontal(lipgloss.Top, prefix, label.Render(name), value)
+		rows = append(rows, ansi.Truncate(row, m.getIndentedContentWidth()-4, constants.Ellipsis))
+	}
+
+	if i := m.newIssue.template; i != blankTemplate && m.newIssue.templates[i].About != "" {
+		rows = append(rows, "", faint.Render(ansi.Truncate(m.newIssue.templates[i].About,
+			m.getIndentedContentWidth()-4, constants.Ellipsis)))
+	}
+
+	help := make([]string, 0)
+	for _, b := range []key.Binding{
+		newIssueKeys.Up, newIssueKeys.Down, newIssueKeys.Next, newIssueKeys.Confirm,
+		newIssueKeys.Cancel,
+	} {
+		help = append(help, fmt.Sprintf("%s %s", b.Help().Key, b.Help().Desc))
+	}
+	rows = append(rows, "", faint.Italic(true).Render(strings.Join(help, " • ")))
+
+	form := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder(), true).
+		BorderForeground(m.ctx.Theme.PrimaryBorder).
+		Padding(0, 1).
+		Width(m.getIndentedContentWidth()).
+		Render(lipgloss.JoinVertical(lipgloss.Left, rows...))
+
+	if m.isEditingNewIssue() {
+		form = lipgloss.JoinVertical(lipgloss.Left, form,
+			m.ctx.Styles.Sidebar.InputBox.Render(m.editor.View()))
+	}
+	return form
+}
+
+func (m *Model) renderTemplateOption() string {
+	faint := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText)
+	switch {
+	case m.newIssue.repo == "":
+		return faint.Render("set a repository first")
+	case m.newIssue.templatesErr != nil:
+		return faint.Render("failed to fetch templates")
+	case m.newIssue.templates == nil:
+		return faint.Render("Loading" + constants.Ellipsis)
+	case len(m.newIssue.templates) == 0:
+		return faint.Render("no templates")
+	}
+
+	option := "Blank issue"
+	if m.newIssue.template != blankTemplate {
+		option = m.newIssue.templates[m.newIssue.template].Name
+	}
+	if m.newIssue.field != newIssueFieldTemplate {
+		return option
+	}
+	return faint.Render("‹ ") +
+		lipgloss.NewStyle().Bold(true).Foreground(m.ctx.Theme.PrimaryText).Render(option) +
+		faint.Render(" ›")
+}
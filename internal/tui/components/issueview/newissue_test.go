@@ -0,0 +1,107 @@
+package issueview
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/cli/go-gh/v2/pkg/repository"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+func newIssueFormTestModel(t *testing.T) Model {
+	t.Helper()
+	m := newTestModelForAction(t)
+	m.issue.Data.Repository = data.Repository{NameWithOwner: "o/r"}
+	m.ctx.StartTask = func(task context.Task) tea.Cmd { return nil }
+	m.SetWidth(80)
+	return m
+}
+
+var testIssueTemplates = []data.IssueTemplate{
+	{
+		Name:   "Bug report",
+		About:  "Something isn't working",
+		Title:  "[Bug]: ",
+		Body:   "### What happened?",
+		Labels: []string{"bug"},
+	},
+	{Name: "Feature request", Body: "### Problem"},
+}
+
+func TestNewIssueFormDefaultRepo(t *testing.T) {
+	m := newIssueFormTestModel(t)
+	require.NotNil(t, m.SetIsCreatingIssue(true), "the templates of the repo should be fetched")
+	require.Equal(t, "o/r", m.newIssue.repo, "the repo of the shown issue is the fallback")
+	require.Equal(t, newIssueFieldTitle, m.newIssue.field)
+	require.Contains(t, ansi.Strip(m.View()), "Loading")
+
+	m.ctx.GHRepo = &repository.Repository{Owner: "dlvhdr", Name: "gh-dash"}
+	m.SetIsCreatingIssue(true)
+	require.Equal(t, "dlvhdr/gh-dash", m.newIssue.repo)
+
+	m.ctx.GHRepo = nil
+	m.issue = nil
+	require.Nil(t, m.SetIsCreatingIssue(true))
+	require.Equal(t, newIssueFieldRepo, m.newIssue.field, "the repo has to be set first")
+}
+
+func TestNewIssueFormTemplates(t *testing.T) {
+	m := newIssueFormTestModel(t)
+	m.SetIsCreatingIssue(true)
+
+	require.False(t, m.SetIssueTemplates(IssueTemplatesFetchedMsg{
+		Repo: "o/other", Templates: testIssueTemplates}))
+	require.True(t, m.SetIssueTemplates(IssueTemplatesFetchedMsg{
+		Repo: "o/r", Templates: testIssueTemplates}))
+	require.Equal(t, "[Bug]: ", m.newIssue.title, "the first template should be applied")
+	require.Equal(t, []string{"bug"}, m.newIssue.labels)
+	view := ansi.Strip(m.View())
+	require.Contains(t, view, "Bug report")
+	require.Contains(t, view, "Something isn't working")
+
+	m, _, _ = m.Update(tea.KeyPressMsg{Code: 'k', Text: "k"})
+	require.Equal(t, newIssueFieldTemplate, m.newIssue.field)
+	m, _, _ = m.Update(tea.KeyPressMsg{Code: 'l', Text: "l"})
+	require.Equal(t, "### Problem", m.newIssue.body)
+	require.Empty(t, m.newIssue.labels)
+
+	m, _, _ = m.Update(tea.KeyPressMsg{Code: 'l', Text: "l"})
+	require.Equal(t, blankTemplate, m.newIssue.template)
+	require.Empty(t, m.newIssue.body)
+	m, _, _ = m.Update(tea.KeyPressMsg{Code: 'h', Text: "h"})
+	require.Equal(t, 1, m.newIssue.template)
+}
+
+func TestNewIssueFormSubmit(t *testing.T) {
+	m := newIssueFormTestModel(t)
+	m.SetIsCreatingIssue(true)
+	m.SetIssueTemplates(IssueTemplatesFetchedMsg{Repo: "o/r", Templates: []data.IssueTemplate{}})
+
+	m.newIssue.field = newIssueFieldCreate
+	m, cmd, _ := m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	require.Nil(t, cmd)
+	require.Equal(t, newIssueFieldTitle, m.newIssue.field, "issues need a title")
+
+	m, _, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	require.True(t, m.IsTextInputBoxFocused())
+	m.editor.SetValue("Crash on start\nignored")
+	m, _, _ = m.Update(tea.KeyPressMsg{Code: 'd', Mod: tea.ModCtrl})
+	require.False(t, m.IsTextInputBoxFocused())
+	require.True(t, m.IsNewIssueFormOpen(), "editing a field keeps the form open")
+	require.Equal(t, "Crash on start", m.newIssue.title)
+
+	m.newIssue.field = newIssueFieldLabels
+	m, _, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	m.editor.SetValue("bug, help wanted, ")
+	m, _, _ = m.Update(tea.KeyPressMsg{Code: 'd', Mod: tea.ModCtrl})
+	require.Equal(t, []string{"bug", "help wanted"}, m.newIssue.labels)
+
+	m.newIssue.field = newIssueFieldCreate
+	m, cmd, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	require.False(t, m.IsNewIssueFormOpen(), "the form closes once submitted")
+}
@@ -0,0 +1,94 @@
+package issueview
+
+import (
+	"fmt"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/cmpcontroller"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/fuzzyselect"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/issuessection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
+)
+
+// IsSelectionKey returns whether the key moves the timeline selection, so the sidebar has to
+// scroll to the selected entry.
+func IsSelectionKey(msg tea.KeyMsg) bool {
+	return key.Matches(msg, keys.IssueKeys.PrevComment, keys.IssueKeys.NextComment)
+}
+
+// SelectionOffset returns the line of the selected entry in the rendered view.
+func (m *Model) SelectionOffset() int {
+	if !m.hasData() || m.entry == "" {
+		return 0
+	}
+
+	_, line := m.renderActivity()
+	return lipgloss.Height(m.viewHeader()) - 1 + line
+}
+
+// reactionSubject returns what reactions are toggled on: the selected comment, or the issue
+// when no comment is selected.
+func (m *Model) reactionSubject() (tasks.ReactionSubject, data.ReactionGroups) {
+	if entry := m.selectedEntry(); entry != nil && entry.Id != "" {
+		return tasks.ReactionSubject{
+			Id:   entry.Id,
+			Name: entry.Name(m.subjectName()),
+		}, entry.ReactionGroups
+	}
+	return tasks.ReactionSubject{
+		Id:   m.issue.Data.Id,
+		Name: m.subjectName(),
+	}, m.issue.Data.ReactionGroups
+}
+
+func (m *Model) GetIsReacting() bool {
+	return m.editor.Mode() == cmpcontroller.ModeReaction
+}
+
+// SetIsReacting enters or exits picking a reaction to toggle on the issue or the selected
+// comment.
+func (m *Model) SetIsReacting(isReacting bool) tea.Cmd {
+	if m.issue == nil {
+		return nil
+	}
+
+	if !isReacting {
+		if m.editor.Mode() == cmpcontroller.ModeReaction {
+			m.editor.Exit()
+		}
+		return nil
+	}
+
+	subject, groups := m.reactionSubject()
+	m.editor.SetAutocompleteSource(&fuzzyselect.ReactionSource{Groups: groups})
+	cmd := m.editor.Enter(cmpcontroller.EnterOptions{
+		Mode:   cmpcontroller.ModeReaction,
+		Prompt: fmt.Sprintf(constants.ReactionPrompt, subject.Name),
+		Repo:   m.repoRef(),
+	})
+	m.editor.ShowCompletions()
+	return cmd
+}
+
+// react toggles the picked reaction on the issue or the selected comment, removing it when
+// the user already reacted with it.
+func (m *Model) react(value string) tea.Cmd {
+	content, ok := fuzzyselect.ParseReaction(value)
+	if !ok {
+		return nil
+	}
+
+	subject, groups := m.reactionSubject()
+	if subject.Id == "" {
+		return nil
+	}
+	sid := tasks.SectionIdentifier{Id: m.sectionId, Type: issuessection.SectionType}
+	return tasks.ReactOnIssue(m.ctx, sid, m.issue.Data, subject, content,
+		groups.Get(content).ViewerHasReacted)
+}
@@ -0,0 +1,135 @@
+package issueview
+
+import (
+	"errors"
+	"fmt"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/common"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/cmpcontroller"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/fuzzyselect"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/issuessection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+)
+
+// subjectName names the issue in prompts and task texts.
+func (m *Model) subjectName() string {
+	return fmt.Sprintf("issue #%d", m.issue.Data.Number)
+}
+
+// timelineEntries returns the comments and events of the issue, oldest first.
+func (m *Model) timelineEntries() []common.TimelineEntry {
+	if !m.hasData() {
+		return nil
+	}
+
+	var entries []common.TimelineEntry
+	for _, c := range m.issue.Data.Comments.Nodes {
+		entries = append(entries, common.TimelineEntry{
+			Id:             c.Id,
+			Typename:       data.TimelineIssueComment,
+			Author:         c.Author.Login,
+			Body:           c.Body,
+			UpdatedAt:      c.UpdatedAt,
+			Meta:           c.CommentMeta,
+			ReactionGroups: c.ReactionGroups,
+		})
+	}
+	entries = append(entries, common.TimelineEntriesFromEvents(m.issue.Data.TimelineItems)...)
+	common.SortTimeline(entries)
+	return entries
+}
+
+func (m *Model) selectedEntry() *common.TimelineEntry {
+	return common.FindTimelineEntry(m.timelineEntries(), m.entry)
+}
+
+func (m *Model) GetIsActingOnEntry() bool {
+	return m.editor.Mode() == cmpcontroller.ModeTimelineAction
+}
+
+// SetIsActingOnEntry enters or exits picking an action on the selected entry of the timeline.
+func (m *Model) SetIsActingOnEntry(isActing bool) tea.Cmd {
+	if m.issue == nil {
+		return nil
+	}
+
+	if !isActing {
+		if m.editor.Mode() == cmpcontroller.ModeTimelineAction {
+			m.editor.Exit()
+		}
+		return nil
+	}
+
+	entry := m.selectedEntry()
+	if entry == nil || len(entry.Actions()) == 0 {
+		m.ctx.Error = errors.New("select a comment or event of the timeline first")
+		return nil
+	}
+
+	m.editor.SetAutocompleteSource(&fuzzyselect.TimelineActionSource{Actions: entry.Actions()})
+	cmd := m.editor.Enter(cmpcontroller.EnterOptions{
+		Mode:   cmpcontroller.ModeTimelineAction,
+		Prompt: fmt.Sprintf(constants.TimelineActionPrompt, entry.Name(m.subjectName())),
+		Repo:   m.repoRef(),
+	})
+	m.editor.ShowCompletions()
+	return cmd
+}
+
+// actOnEntry runs the picked action on the selected entry of the timeline.
+func (m *Model) actOnEntry(value string) tea.Cmd {
+	entry := m.selectedEntry()
+	if entry == nil {
+		return nil
+	}
+	action, ok := fuzzyselect.ParseTimelineAction(value, entry.Actions())
+	if !ok {
+		return nil
+	}
+
+	sid := tasks.SectionIdentifier{Id: m.sectionId, Type: issuessection.SectionType}
+	comment := tasks.TimelineComment{
+		Id:       entry.Id,
+		Typename: entry.Typename,
+		Body:     entry.Body,
+		Name:     entry.Name(m.subjectName()),
+	}
+	switch action {
+	case common.TimelineQuoteReply:
+		m.editor.SetAutocompleteSource(&fuzzyselect.UserMentionSource{WithAtSymbol: true})
+		return m.editor.Enter(cmpcontroller.EnterOptions{
+			Mode:                             cmpcontroller.ModeComment,
+			Prompt:                           m.prompt(constants.CommentPrompt),
+			InitialValue:                     common.QuoteReply(entry.Body),
+			Repo:                             m.repoRef(),
+			EnterFetch:                       cmpcontroller.FetchSilent,
+			ConfirmDiscardOnCancel:           true,
+			HideAutocompleteWhenContextEmpty: true,
+		})
+	case common.TimelineCopyLink:
+		return tasks.CopyLink(m.ctx, entry.Url())
+	case common.TimelineEdit:
+		cmd, err := tasks.EditIssueComment(m.ctx, sid, m.issue.Data, comment)
+		if err != nil {
+			m.ctx.Error = err
+		}
+		return cmd
+	case common.TimelineDelete:
+		return func() tea.Msg {
+			return section.ConfirmMsg{
+				Prompt: fmt.Sprintf("Are you sure you want to delete %s? (y/N) ", comment.Name),
+				Action: func() tea.Cmd {
+					return tasks.DeleteIssueComment(m.ctx, sid, m.issue.Data, comment)
+				},
+			}
+		}
+	case common.TimelineHideOutdated:
+		return tasks.HideIssueComment(m.ctx, sid, m.issue.Data, comment)
+	}
+	return nil
+}
@@ -112,6 +112,18 @@ func (m *Model) PrevItem() int {
 	return m.currId
 }
 
+// SetCurrItem moves to the item at id, scrolling the viewport as needed.
+func (m *Model) SetCurrItem(id int) int {
+	id = utils.Max(utils.Min(id, m.NumCurrentItems-1), 0)
+	for m.currId < id {
+		m.NextItem()
+	}
+	for m.currId > id {
+		m.PrevItem()
+	}
+	return m.currId
+}
+
 func (m *Model) FirstItem() int {
 	m.currId = 0
 	m.viewport.GotoTop()
@@ -1,7 +1,6 @@
 package notificationssection
 
 import (
-	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -13,7 +12,8 @@ import (
 	"github.com/cli/go-gh/v2/pkg/browser"
 
 	"github.com/dlvhdr/gh-dash/v4/internal/data"
-	"github.com/dlvhdr/gh-dash/v4/internal/tui/common"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/notificationrow"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
 )
@@ -58,6 +58,65 @@ func (m *Model) markAsDone() tea.Cmd {
 	})
 }
 
+// markSelectedAsDone marks the selected notifications as done, reporting a single task.
+func (m *Model) markSelectedAsDone() tea.Cmd {
+	selected := m.GetSelectedRows()
+	if len(selected) == 0 {
+		return nil
+	}
+
+	count := len(selected)
+	taskId := fmt.Sprintf("notification_bulk_done_%d_%d", count, time.Now().UnixNano())
+	task := context.Task{
+		Id:           taskId,
+		StartText:    fmt.Sprintf("Marking %d notifications as done", count),
+		FinishedText: fmt.Sprintf("%d notifications marked as done", count),
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+
+	type doneEntry struct {
+		id        string
+		updatedAt time.Time
+	}
+	entries := make([]doneEntry, 0, count)
+	for _, row := range selected {
+		n := row.(*notificationrow.Data)
+		entries = append(entries, doneEntry{n.GetId(), n.Notification.UpdatedAt})
+	}
+
+	startCmd := m.Ctx.StartTask(task)
+	return tea.Batch(startCmd, func() tea.Msg {
+		doneStore := data.GetDoneStore()
+		msgs := make([]tea.Msg, 0, count)
+		var failed int
+		var lastErr error
+		for _, e := range entries {
+			err := markNotificationDoneFunc(e.id)
+			if err != nil {
+				failed++
+				lastErr = err
+			} else {
+				// Persist to done store so it stays hidden across sessions
+				doneStore.MarkDone(e.id, e.updatedAt)
+			}
+			msgs = append(msgs, UpdateNotificationMsg{Id: e.id, IsRemoved: err == nil})
+		}
+
+		var err error
+		if lastErr != nil {
+			err = fmt.Errorf("%d of %d failed: %w", failed, count, lastErr)
+		}
+		return constants.TaskFinishedMsg{
+			SectionId:   m.Id,
+			SectionType: SectionType,
+			TaskId:      taskId,
+			Err:         err,
+			Msg:         tasks.BulkUpdateMsg{Msgs: msgs},
+		}
+	})
+}
+
 // markAllAsDone marks all currently visible notifications in this section as done.
 // "All" refers to the notifications currently loaded in m.Notifications, not all
 // notifications on GitHub.
@@ -269,13 +328,15 @@ func (m *Model) openInBrowser() tea.Cmd {
 // CheckoutPR checks out a PR. This is a standalone function that can be called
 // from ui.go with the PR details from the notification view.
 func CheckoutPR(ctx *context.ProgramContext, prNumber int, repoName string) (tea.Cmd, error) {
-	repoPath, ok := common.GetRepoLocalPath(repoName, ctx.Config.RepoPaths)
-	if !ok {
-		return nil, errors.New(
-			"local path to repo not specified, set one in your config.yml under repoPaths",
-		)
-	}
+	return tasks.WithRepoPath(ctx, repoName, func(repoPath string) tea.Cmd {
+		if ctx.Config.Worktrees.Enabled {
+			return tasks.CheckoutPRWorktree(ctx, repoPath, repoName, prNumber)
+		}
+		return checkoutPR(ctx, repoPath, prNumber)
+	})
+}
 
+func checkoutPR(ctx *context.ProgramContext, repoPath string, prNumber int) tea.Cmd {
 	taskId := fmt.Sprintf("checkout_%d", prNumber)
 	task := context.Task{
 		Id:           taskId,
@@ -300,5 +361,5 @@ func CheckoutPR(ctx *context.ProgramContext, prNumber int, repoName string) (tea
 		c.Dir = repoPath
 		err := c.Run()
 		return constants.TaskFinishedMsg{TaskId: taskId, Err: err}
-	}), nil
+	})
 }
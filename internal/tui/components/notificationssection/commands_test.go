@@ -1,6 +1,7 @@
 package notificationssection
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
 	"testing"
@@ -11,6 +12,8 @@ import (
 	"github.com/dlvhdr/gh-dash/v4/internal/config"
 	"github.com/dlvhdr/gh-dash/v4/internal/data"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/notificationrow"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/theme"
 )
@@ -206,6 +209,89 @@ func TestMarkAsDoneStoresCorrectTimestamp(t *testing.T) {
 	// (because justAfterT1 < t2). The test would fail here.
 }
 
+// TestMarkSelectedAsDone verifies that only the selected notifications are marked as done
+// and that a failure for one of them doesn't stop the others.
+func TestMarkSelectedAsDone(t *testing.T) {
+	origFunc := markNotificationDoneFunc
+	markNotificationDoneFunc = func(id string) error {
+		if id == "notif-C" {
+			return errors.New("not found")
+		}
+		return nil
+	}
+	defer func() { markNotificationDoneFunc = origFunc }()
+
+	tempDir, err := os.MkdirTemp("", "gh-dash-markselected-test")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	store := data.NewDoneStoreForTesting(filepath.Join(tempDir, "done.json"))
+	restoreStore := data.OverrideDoneStoreForTesting(store)
+	defer restoreStore()
+
+	t1 := time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC)
+	m := Model{
+		Notifications: []notificationrow.Data{
+			{Notification: data.NotificationData{Id: "notif-A", UpdatedAt: t1}},
+			{Notification: data.NotificationData{Id: "notif-B", UpdatedAt: t1}},
+			{Notification: data.NotificationData{Id: "notif-C", UpdatedAt: t1}},
+		},
+		sessionMarkedDone: make(map[string]bool),
+		sessionMarkedRead: make(map[string]bool),
+	}
+	m.Ctx = &context.ProgramContext{
+		StartTask: noopStartTask,
+	}
+	m.ToggleRowSelection("notif-A")
+	m.ToggleRowSelection("notif-C")
+
+	cmd := m.markSelectedAsDone()
+	if cmd == nil {
+		t.Fatal("markSelectedAsDone() returned nil cmd")
+	}
+
+	var finished *constants.TaskFinishedMsg
+	switch msg := cmd().(type) {
+	case constants.TaskFinishedMsg:
+		finished = &msg
+	case tea.BatchMsg:
+		for _, c := range msg {
+			if c == nil {
+				continue
+			}
+			if msg, ok := c().(constants.TaskFinishedMsg); ok {
+				finished = &msg
+			}
+		}
+	}
+	if finished == nil {
+		t.Fatal("markSelectedAsDone() should finish with a TaskFinishedMsg")
+	}
+	if finished.Err == nil {
+		t.Error("a failed notification should be reported as an error")
+	}
+
+	update, ok := finished.Msg.(tasks.BulkUpdateMsg)
+	if !ok || len(update.Msgs) != 2 {
+		t.Fatalf("expected a BulkUpdateMsg with 2 messages, got %#v", finished.Msg)
+	}
+	if msg := update.Msgs[0].(UpdateNotificationMsg); msg.Id != "notif-A" || !msg.IsRemoved {
+		t.Errorf("notif-A should be removed, got %#v", msg)
+	}
+	if msg := update.Msgs[1].(UpdateNotificationMsg); msg.Id != "notif-C" || msg.IsRemoved {
+		t.Errorf("notif-C should be kept after failing, got %#v", msg)
+	}
+
+	if !store.IsDone("notif-A", t1) {
+		t.Error("DoneStore should have notif-A marked done")
+	}
+	if store.IsDone("notif-B", t1) || store.IsDone("notif-C", t1) {
+		t.Error("only the selected notifications that succeeded should be marked done")
+	}
+}
+
 func TestUpdateNotificationKeepsCursorOnNewLastItem(t *testing.T) {
 	cfg, err := config.ParseConfig(config.Location{
 		ConfigFlag:       "../../../config/testdata/test-config.yml",
@@ -17,6 +17,7 @@ import (
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/notificationrow"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/table"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
@@ -241,6 +242,8 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 				action := m.GetPromptConfirmationAction()
 				if input == "Y" || input == "y" {
 					switch action {
+					case section.ConfirmAction:
+						cmd = m.RunConfirmedAction()
 					case "done":
 						cmd = m.markAsDone()
 					case "done_all":
@@ -258,7 +261,9 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 
 		switch {
 		case key.Matches(msg, keys.NotificationKeys.MarkAsDone):
-			if m.GetCurrRow() != nil {
+			if m.SelectionCount() > 0 {
+				cmd = m.markSelectedAsDone()
+			} else if m.GetCurrRow() != nil {
 				cmd = m.markAsDone()
 			}
 			return m, cmd
@@ -297,6 +302,7 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 		case key.Matches(msg, keys.NotificationKeys.SortByRepo):
 			m.toggleSortOrder()
 			m.Table.SetRows(m.BuildRows())
+			m.SyncSelection(m.rowKeys())
 			return m, nil
 
 		case key.Matches(msg, keys.NotificationKeys.ToggleSmartFiltering):
@@ -384,8 +390,18 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 			}
 		}
 
+	case tasks.BulkUpdateMsg:
+		cmds := make([]tea.Cmd, 0, len(msg.Msgs))
+		for _, updateMsg := range msg.Msgs {
+			_, updateCmd := m.Update(updateMsg)
+			cmds = append(cmds, updateCmd)
+		}
+		m.ClearSelection()
+		return m, tea.Batch(cmds...)
+
 	case SectionNotificationsFetchedMsg:
 		if m.LastFetchTaskId == msg.TaskId {
+			m.IsStale = false
 			if m.PageInfo != nil {
 				// Append to existing notifications (pagination)
 				m.Notifications = append(m.Notifications, msg.Notifications...)
@@ -428,6 +444,7 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 
 	search, searchCmd := m.SearchBar.Update(msg)
 	m.SearchBar = search
+	m.SyncSelection(m.rowKeys())
 
 	prompt, promptCmd := m.PromptConfirmationBox.Update(msg)
 	m.PromptConfirmationBox = prompt
@@ -532,6 +549,35 @@ func (m *Model) GetCurrRow() data.RowData {
 	return &m.Notifications[idx]
 }
 
+func (m *Model) rowKeys() []string {
+	keys := make([]string, 0, len(m.Notifications))
+	for _, n := range m.Notifications {
+		keys = append(keys, n.GetId())
+	}
+	return keys
+}
+
+// ToggleSelection marks or unmarks the current notification for a bulk action.
+func (m *Model) ToggleSelection() {
+	notification := m.GetCurrNotification()
+	if notification == nil {
+		return
+	}
+	m.ToggleRowSelection(notification.GetId())
+	m.SyncSelection(m.rowKeys())
+}
+
+// GetSelectedRows returns the marked notifications in the order they are shown.
+func (m *Model) GetSelectedRows() []data.RowData {
+	var rows []data.RowData
+	for i := range m.Notifications {
+		if m.Selection[m.Notifications[i].GetId()] {
+			rows = append(rows, &m.Notifications[i])
+		}
+	}
+	return rows
+}
+
 func (m *Model) GetCurrNotification() *notificationrow.Data {
 	idx := m.Table.GetCurrItem()
 	if idx < 0 || idx >= len(m.Notifications) {
@@ -574,6 +620,7 @@ func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
 	}
 
 	taskId := fmt.Sprintf("fetching_notifications_%d_%s", m.Id, time.Now().String())
+	isFirstFetch := m.LastFetchTaskId == ""
 	m.LastFetchTaskId = taskId
 	task := context.Task{
 		Id:           taskId,
@@ -596,6 +643,15 @@ func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
 	// Capture config limit for the closure
 	limit := m.Ctx.Config.Defaults.NotificationsLimit
 
+	// Only the first page is cached, keyed by the resolved search value
+	if pageInfo == nil {
+		m.CacheKey = data.SectionCacheKey(m.Type, m.GetSearchValue(), limit)
+	}
+	cacheKey := m.CacheKey
+	if isFirstFetch {
+		m.warmStartFromCache()
+	}
+
 	// Build reason filter map for O(1) lookup
 	reasonFilterMap := make(map[string]bool, len(filters.ReasonFilters))
 	for _, reason := range filters.ReasonFilters {
@@ -783,6 +839,10 @@ func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
 				"nextPage", lastPageInfo.EndCursor)
 		}
 
+		if pageInfo == nil {
+			data.GetSectionCache().Put(cacheKey, notifications)
+		}
+
 		return constants.TaskFinishedMsg{
 			SectionId:   m.Id,
 			SectionType: m.Type,
@@ -812,6 +872,33 @@ func (m *Model) ResetRows() {
 	m.BaseModel.ResetRows()
 }
 
+// warmStartFromCache shows the last known notifications for the section,
+// marked as stale, until the fetch that is in flight replaces them.
+// Notifications marked as done since they were cached are left out.
+func (m *Model) warmStartFromCache() {
+	var cached []notificationrow.Data
+	fetchedAt, ok := data.GetSectionCache().Get(m.CacheKey, m.CacheMaxAge(), &cached)
+	if !ok {
+		return
+	}
+
+	doneStore := data.GetDoneStore()
+	notifications := make([]notificationrow.Data, 0, len(cached))
+	for _, n := range cached {
+		if doneStore.IsDone(n.Notification.Id, n.Notification.UpdatedAt) {
+			continue
+		}
+		notifications = append(notifications, n)
+	}
+
+	m.Notifications = notifications
+	m.TotalCount = len(notifications)
+	m.IsStale = true
+	m.Table.SetRows(m.BuildRows())
+	m.UpdateLastUpdated(fetchedAt)
+	m.UpdateTotalItemsCount(m.TotalCount)
+}
+
 // FetchAllSections creates and fetches all notification sections based on config.
 // Returns sections and a batch command to fetch all data.
 func FetchAllSections(
@@ -907,15 +994,19 @@ func (m *Model) SetIsLoading(val bool) {
 
 func (m Model) GetPagerContent() string {
 	pagerContent := ""
+	lastUpdated := m.LastUpdated().Format("01/02 15:04:05")
+	if m.IsStale {
+		lastUpdated = "Cached " + lastUpdated
+	}
 	if m.TotalCount > 0 {
 		pagerContent = fmt.Sprintf(
 			"%v %v • %v %v/%v",
 			constants.WaitingIcon,
-			m.LastUpdated().Format("01/02 15:04:05"),
+			lastUpdated,
 			m.SingularForm,
 			m.Table.GetCurrItem()+1,
 			m.TotalCount,
-		)
+		) + m.SelectionStatus()
 	}
 	pager := m.Ctx.Styles.ListViewPort.PagerStyle.Render(pagerContent)
 	return pager
@@ -97,6 +97,12 @@ func (m *Model) SetPendingPRAction(action string) string {
 		actionDisplay = "mark as ready"
 	case "approveWorkflows":
 		actionDisplay = "approve all workflows for"
+	case "rerunFailedWorkflows":
+		actionDisplay = "re-run the failed workflows of"
+	case "rerunAllWorkflows":
+		actionDisplay = "re-run all workflows of"
+	case "cancelWorkflows":
+		actionDisplay = "cancel the running workflows of"
 	}
 	return fmt.Sprintf(
 		"Are you sure you want to %s PR #%d? (y/N)",
@@ -0,0 +1,67 @@
+package projectrow
+
+import (
+	"fmt"
+
+	"charm.land/lipgloss/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/table"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+// Item is a card of a project board. Cards are rows with a single cell, the state and title of
+// the item above where it comes from.
+type Item struct {
+	Ctx  *context.ProgramContext
+	Data data.ProjectItem
+}
+
+func (item *Item) ToTableRow() table.Row {
+	return table.Row{
+		fmt.Sprintf("%s %s\n%s", item.renderState(), item.renderTitle(), item.renderOrigin()),
+	}
+}
+
+func (item *Item) getTextStyle() lipgloss.Style {
+	return components.GetIssueTextStyle(item.Ctx)
+}
+
+func (item *Item) renderState() string {
+	state := item.Data.GetContent().State
+	style := lipgloss.NewStyle()
+	switch item.Data.Type {
+	case data.ProjectItemPullRequest:
+		switch state {
+		case "OPEN":
+			return style.Foreground(item.Ctx.Styles.Colors.OpenPR).Render(constants.OpenIcon)
+		case "MERGED":
+			return style.Foreground(item.Ctx.Styles.Colors.MergedPR).Render(constants.MergedIcon)
+		default:
+			return style.Foreground(item.Ctx.Styles.Colors.ClosedPR).Render(constants.ClosedIcon)
+		}
+	case data.ProjectItemIssue:
+		if state == "OPEN" {
+			return style.Foreground(item.Ctx.Styles.Colors.OpenIssue).Render("")
+		}
+		return item.getTextStyle().Render("")
+	default:
+		return style.Foreground(item.Ctx.Theme.FaintText).Render(constants.DraftIcon)
+	}
+}
+
+func (item *Item) renderTitle() string {
+	return item.Ctx.Styles.Common.MainTextStyle.Render(item.Data.GetTitle())
+}
+
+func (item *Item) renderOrigin() string {
+	style := lipgloss.NewStyle().Foreground(item.Ctx.Theme.FaintText)
+	if item.Data.Type == data.ProjectItemDraftIssue {
+		return style.Render("Draft")
+	}
+	content := item.Data.GetContent()
+	return style.Render(fmt.Sprintf("%s#%d @%s", content.Repository.NameWithOwner,
+		content.Number, content.Author.Login))
+}
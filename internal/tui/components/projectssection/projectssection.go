@@ -0,0 +1,666 @@
+package projectssection
+
+import (
+	"fmt"
+	"time"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/projectrow"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prrow"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/table"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+const SectionType = "project"
+
+// minColumnWidth is the narrowest a column of the board gets, columns that don't fit are
+// scrolled out of view.
+const minColumnWidth = 32
+
+// cardHeight is the height of the cards of the board, which show the title of an item above
+// where it comes from.
+const cardHeight = 2
+
+type Model struct {
+	section.BaseModel
+	Project     data.ProjectData
+	StatusField data.ProjectStatusField
+	Items       []data.ProjectItem
+	statusField string
+	columns     []column
+	currColumn  int
+	firstColumn int
+	// contents are the issues and PRs of the items that were fetched for the preview pane, by
+	// item id
+	contents map[string]itemContent
+}
+
+// column is a column of the board with the items that have one of the status options.
+type column struct {
+	// status has an empty id for the column of the items without a status
+	status data.ProjectFieldOption
+	items  []int
+	table  table.Model
+}
+
+type itemContent struct {
+	row data.RowData
+	err error
+}
+
+func NewModel(
+	id int,
+	ctx *context.ProgramContext,
+	cfg config.ProjectsSectionConfig,
+	lastUpdated time.Time,
+	createdAt time.Time,
+) Model {
+	m := Model{statusField: cfg.StatusField}
+	if m.statusField == "" {
+		m.statusField = data.DefaultProjectStatusField
+	}
+	m.BaseModel = section.NewModel(
+		ctx,
+		section.NewSectionOptions{
+			Id:     id,
+			Config: cfg.ToSectionConfig(),
+			Type:   SectionType,
+			// The table of the section only shows the loading and empty states, the items are
+			// in the tables of the columns
+			Columns:     []table.Column{{Title: "", Grow: utils.BoolPtr(true)}},
+			Singular:    m.GetItemSingularForm(),
+			Plural:      m.GetItemPluralForm(),
+			LastUpdated: lastUpdated,
+			CreatedAt:   createdAt,
+		},
+	)
+	m.Items = []data.ProjectItem{}
+
+	return m
+}
+
+func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.KeyPressMsg:
+
+		if m.IsSearchFocused() {
+			switch msg.String() {
+			case "ctrl+c", "esc":
+				m.SearchBar.SetValue(m.SearchValue)
+				blinkCmd := m.SetIsSearching(false)
+				return m, blinkCmd
+
+			case "enter":
+				m.SearchValue = m.SearchBar.Value()
+				m.SyncSmartFilterWithSearchValue()
+				m.SetIsSearching(false)
+				m.ResetRows()
+				return m, tea.Batch(m.FetchNextPageSectionRows()...)
+			}
+
+			break
+		}
+
+		if key.Matches(msg, keys.ProjectKeys.ToggleSmartFiltering) {
+			if m.HasCurrentRepoNameInConfiguredFilter() || !m.HasRepoNameInConfiguredFilter() {
+				m.IsFilteredByCurrentRemote = !m.IsFilteredByCurrentRemote
+			}
+			searchValue := m.GetSearchValue()
+			if m.SearchValue != searchValue {
+				m.SearchValue = searchValue
+				m.SearchBar.SetValue(searchValue)
+				m.SetIsSearching(false)
+				m.ResetRows()
+				return m, tea.Batch(m.FetchNextPageSectionRows()...)
+			}
+		}
+
+	case tasks.UpdateProjectItemMsg:
+		if msg.Status == nil {
+			break
+		}
+		for i := range m.Items {
+			if m.Items[i].Id == msg.ItemId {
+				m.Items[i].SetStatus(*msg.Status)
+				m.buildColumns()
+				break
+			}
+		}
+
+	case SectionProjectFetchedMsg:
+		if m.LastFetchTaskId == msg.TaskId {
+			m.IsStale = false
+			m.setProject(msg.Project)
+			m.SetIsLoading(false)
+			m.PageInfo = &data.PageInfo{}
+			m.UpdateLastUpdated(time.Now())
+		}
+	}
+
+	search, searchCmd := m.SearchBar.Update(msg)
+	m.SearchBar = search
+
+	prompt, promptCmd := m.PromptConfirmationBox.Update(msg)
+	m.PromptConfirmationBox = prompt
+
+	table, tableCmd := m.Table.Update(msg)
+	m.Table = table
+
+	return m, tea.Batch(cmd, searchCmd, promptCmd, tableCmd)
+}
+
+func (m *Model) setProject(res data.ProjectResponse) {
+	m.Project = res.Project
+	m.StatusField = res.StatusField
+	m.Items = res.Items
+	m.TotalCount = len(res.Items)
+	m.buildColumns()
+	m.UpdateTotalItemsCount(m.TotalCount)
+}
+
+// buildColumns groups the items by their status, in the order of the status options. The
+// items without a status are in a first column that's only shown when there are any. The
+// current item stays selected, even when it moved to another column.
+func (m *Model) buildColumns() {
+	currItem := m.currItem()
+	tables := make(map[string]table.Model, len(m.columns))
+	for _, col := range m.columns {
+		tables[col.status.Id] = col.table
+	}
+
+	noStatus := column{status: data.ProjectFieldOption{Name: "No Status"}}
+	columns := make([]column, 0, len(m.StatusField.Options)+1)
+	byStatus := make(map[string]int, len(m.StatusField.Options))
+	for _, option := range m.StatusField.Options {
+		byStatus[option.Id] = len(columns)
+		columns = append(columns, column{status: option})
+	}
+	for i, item := range m.Items {
+		if c, ok := byStatus[item.GetStatusOptionId()]; ok {
+			columns[c].items = append(columns[c].items, i)
+		} else {
+			noStatus.items = append(noStatus.items, i)
+		}
+	}
+	if len(noStatus.items) > 0 {
+		columns = append([]column{noStatus}, columns...)
+	}
+
+	currColumn := min(m.currColumn, max(len(columns)-1, 0))
+	currRow := -1
+	for c := range columns {
+		t, ok := tables[columns[c].status.Id]
+		if !ok {
+			t = m.newColumnTable()
+		}
+		columns[c].table = t
+		for row, i := range columns[c].items {
+			if currItem != nil && m.Items[i].Id == currItem.Id {
+				currColumn, currRow = c, row
+			}
+		}
+	}
+	m.columns = columns
+	m.currColumn = currColumn
+
+	m.syncColumns()
+	if currRow >= 0 {
+		m.columns[m.currColumn].table.SetCurrItem(currRow)
+	}
+}
+
+func (m *Model) newColumnTable() table.Model {
+	t := table.NewModel(
+		*m.Ctx,
+		constants.Dimensions{},
+		m.LastUpdated(),
+		m.CreatedAt(),
+		[]table.Column{{Title: "", Grow: utils.BoolPtr(true)}},
+		nil,
+		m.SingularForm,
+		utils.StringPtr(m.Ctx.Styles.Section.EmptyStateStyle.Render("No items")),
+		"Loading...",
+		false,
+	)
+	t.SetContentHeight(cardHeight)
+	return t
+}
+
+// syncColumns renders the rows and titles of the columns and sizes the columns to fit the
+// section, scrolling the current column into view.
+func (m *Model) syncColumns() {
+	if len(m.columns) == 0 {
+		return
+	}
+
+	dimensions := m.GetDimensions()
+	numShown := min(max(dimensions.Width/minColumnWidth, 1), len(m.columns))
+	if m.currColumn < m.firstColumn {
+		m.firstColumn = m.currColumn
+	}
+	if m.currColumn >= m.firstColumn+numShown {
+		m.firstColumn = m.currColumn - numShown + 1
+	}
+	m.firstColumn = min(m.firstColumn, len(m.columns)-numShown)
+
+	columnWidth := dimensions.Width / numShown
+	for c := range m.columns {
+		col := &m.columns[c]
+		col.table.UpdateProgramContext(m.Ctx)
+		col.table.SetDimensions(constants.Dimensions{
+			// A column is separated from the previous one by a space
+			Width:  max(columnWidth-1, 0),
+			Height: max(dimensions.Height-2, 0),
+		})
+		col.table.Columns[0].Title = m.renderColumnTitle(c)
+		rows := make([]table.Row, 0, len(col.items))
+		for _, i := range col.items {
+			card := projectrow.Item{Ctx: m.Ctx, Data: m.Items[i]}
+			rows = append(rows, card.ToTableRow())
+		}
+		col.table.SetRows(rows)
+		col.table.SetCurrItem(col.table.GetCurrItem())
+		col.table.UpdateTotalItemsCount(len(rows))
+	}
+}
+
+func (m *Model) renderColumnTitle(c int) string {
+	col := m.columns[c]
+	title := fmt.Sprintf("%s %d", col.status.Name, len(col.items))
+	if c != m.currColumn {
+		return title
+	}
+	return lipgloss.NewStyle().
+		Background(m.Ctx.Theme.SelectedBackground).
+		Padding(0, 1).
+		Render(title)
+}
+
+func (m *Model) View() string {
+	if len(m.columns) == 0 {
+		return m.BaseModel.View()
+	}
+
+	numShown := min(max(m.GetDimensions().Width/minColumnWidth, 1), len(m.columns))
+	columns := make([]string, 0, numShown)
+	for _, col := range m.columns[m.firstColumn : m.firstColumn+numShown] {
+		columns = append(columns, lipgloss.NewStyle().PaddingLeft(1).Render(col.table.View()))
+	}
+
+	return m.Ctx.Styles.Section.ContainerStyle.
+		Width(m.Ctx.MainContentWidth).
+		Render(
+			lipgloss.JoinVertical(
+				lipgloss.Left,
+				m.SearchBar.View(m.Ctx),
+				lipgloss.JoinHorizontal(lipgloss.Top, columns...),
+			),
+		)
+}
+
+func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
+	m.BaseModel.UpdateProgramContext(ctx)
+	m.syncColumns()
+}
+
+// PrevColumn selects the column left of the current one.
+func (m *Model) PrevColumn() {
+	m.selectColumn(m.currColumn - 1)
+}
+
+// NextColumn selects the column right of the current one.
+func (m *Model) NextColumn() {
+	m.selectColumn(m.currColumn + 1)
+}
+
+func (m *Model) selectColumn(c int) {
+	if c < 0 || c >= len(m.columns) {
+		return
+	}
+	m.currColumn = c
+	m.syncColumns()
+}
+
+// MoveCurrItem sets the status of the current item to the option delta columns away from its
+// column. Items can't be moved to the column of the items without a status.
+func (m *Model) MoveCurrItem(delta int) tea.Cmd {
+	item := m.currItem()
+	target := m.currColumn + delta
+	if item == nil || target < 0 || target >= len(m.columns) ||
+		m.columns[target].status.Id == "" {
+		return nil
+	}
+
+	return tasks.MoveProjectItem(
+		m.Ctx,
+		tasks.SectionIdentifier{Id: m.Id, Type: SectionType},
+		m.Project.Id,
+		m.StatusField.Id,
+		item,
+		m.columns[target].status,
+	)
+}
+
+func (m *Model) currTable() *table.Model {
+	if len(m.columns) == 0 {
+		return &m.Table
+	}
+	return &m.columns[m.currColumn].table
+}
+
+func (m *Model) currItem() *data.ProjectItem {
+	if len(m.columns) == 0 {
+		return nil
+	}
+	col := m.columns[m.currColumn]
+	row := col.table.GetCurrItem()
+	if row < 0 || row >= len(col.items) {
+		return nil
+	}
+	return &m.Items[col.items[row]]
+}
+
+// ItemContentFetchedMsg carries the issue or PR of a project item, fetched to show it in the
+// preview pane.
+type ItemContentFetchedMsg struct {
+	SectionId int
+	ItemId    string
+	Content   data.RowData
+	Err       error
+}
+
+// LoadCurrItemContent fetches the issue or PR of the current item, unless it was already
+// fetched.
+func (m *Model) LoadCurrItemContent() tea.Cmd {
+	item := m.currItem()
+	if item == nil || item.Type == data.ProjectItemDraftIssue {
+		return nil
+	}
+	if _, ok := m.contents[item.Id]; ok {
+		return nil
+	}
+	if m.contents == nil {
+		m.contents = make(map[string]itemContent)
+	}
+	m.contents[item.Id] = itemContent{}
+
+	sectionId := m.Id
+	itemId := item.Id
+	url := item.GetUrl()
+	isPR := item.Type == data.ProjectItemPullRequest
+	return func() tea.Msg {
+		msg := ItemContentFetchedMsg{SectionId: sectionId, ItemId: itemId}
+		if isPR {
+			pr, err := data.FetchPullRequest(url)
+			if err != nil {
+				msg.Err = err
+				return msg
+			}
+			prData := pr.ToPullRequestData()
+			msg.Content = &prrow.Data{Primary: &prData, Enriched: pr, IsEnriched: true}
+			return msg
+		}
+
+		issue, err := data.FetchIssue(url)
+		if err != nil {
+			msg.Err = err
+			return msg
+		}
+		msg.Content = &issue
+		return msg
+	}
+}
+
+// SetItemContent stores the fetched issue or PR of an item. It returns whether the item is the
+// current one, so the preview pane should be updated.
+func (m *Model) SetItemContent(msg ItemContentFetchedMsg) bool {
+	if _, ok := m.contents[msg.ItemId]; !ok {
+		return false
+	}
+	m.contents[msg.ItemId] = itemContent{row: msg.Content, err: msg.Err}
+	item := m.currItem()
+	return item != nil && item.Id == msg.ItemId
+}
+
+// GetItemContentErr returns the error fetching the issue or PR of the item, if any.
+func (m *Model) GetItemContentErr(itemId string) error {
+	return m.contents[itemId].err
+}
+
+func (m *Model) BuildRows() []table.Row {
+	return m.currTable().Rows
+}
+
+func (m *Model) NumRows() int {
+	if len(m.columns) == 0 {
+		return 0
+	}
+	return len(m.columns[m.currColumn].items)
+}
+
+// GetCurrRow returns the issue or PR of the current item once it was fetched, so the preview
+// pane shows it like in the PRs and issues views. Until then, it returns the item.
+func (m *Model) GetCurrRow() data.RowData {
+	item := m.currItem()
+	if item == nil {
+		return nil
+	}
+	if content := m.contents[item.Id]; content.row != nil {
+		return content.row
+	}
+	curr := *item
+	return &curr
+}
+
+func (m *Model) CurrRow() int {
+	return m.currTable().GetCurrItem()
+}
+
+func (m *Model) NextRow() int {
+	return m.currTable().NextItem()
+}
+
+func (m *Model) PrevRow() int {
+	return m.currTable().PrevItem()
+}
+
+func (m *Model) FirstItem() int {
+	return m.currTable().FirstItem()
+}
+
+func (m *Model) LastItem() int {
+	return m.currTable().LastItem()
+}
+
+// ToggleSelection does nothing as project items don't support bulk actions.
+func (m *Model) ToggleSelection() {}
+
+func (m *Model) GetSelectedRows() []data.RowData {
+	return nil
+}
+
+// FetchNextPageSectionRows fetches the project. All the items of the board are fetched at
+// once, so there is no next page.
+func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
+	if m == nil {
+		return nil
+	}
+
+	if m.PageInfo != nil && !m.PageInfo.HasNextPage {
+		return nil
+	}
+
+	var cmds []tea.Cmd
+
+	taskId := fmt.Sprintf("fetching_project_%d_%s", m.Id, time.Now().String())
+	isFirstFetch := m.LastFetchTaskId == ""
+	m.LastFetchTaskId = taskId
+	task := context.Task{
+		Id:        taskId,
+		StartText: fmt.Sprintf(`Fetching project for "%s"`, m.Config.Title),
+		FinishedText: fmt.Sprintf(
+			`Project for "%s" has been fetched`,
+			m.Config.Title,
+		),
+		State: context.TaskStart,
+		Error: nil,
+	}
+	startCmd := m.Ctx.StartTask(task)
+	cmds = append(cmds, startCmd)
+
+	limit := m.Config.Limit
+	if limit == nil {
+		limit = &m.Ctx.Config.Defaults.ProjectsLimit
+	}
+	filters := m.GetFilters()
+	statusField := m.statusField
+	m.CacheKey = data.SectionCacheKey(m.Type, statusField+"|"+filters, *limit)
+	cacheKey := m.CacheKey
+
+	if isFirstFetch {
+		m.warmStartFromCache()
+	}
+
+	fetchCmd := func() tea.Msg {
+		res, err := data.FetchProject(filters, statusField, *limit)
+		if err != nil {
+			return constants.TaskFinishedMsg{
+				SectionId:   m.Id,
+				SectionType: m.Type,
+				TaskId:      taskId,
+				Err:         err,
+			}
+		}
+
+		data.GetSectionCache().Put(cacheKey, res)
+
+		return constants.TaskFinishedMsg{
+			SectionId:   m.Id,
+			SectionType: m.Type,
+			TaskId:      taskId,
+			Msg: SectionProjectFetchedMsg{
+				Project: res,
+				TaskId:  taskId,
+			},
+		}
+	}
+	cmds = append(cmds, fetchCmd)
+
+	return cmds
+}
+
+// warmStartFromCache shows the last known board for the section, marked as stale, until the
+// fetch that is in flight replaces it.
+func (m *Model) warmStartFromCache() {
+	var cached data.ProjectResponse
+	fetchedAt, ok := data.GetSectionCache().Get(m.CacheKey, m.CacheMaxAge(), &cached)
+	if !ok {
+		return
+	}
+
+	m.setProject(cached)
+	m.IsStale = true
+	m.UpdateLastUpdated(fetchedAt)
+}
+
+func (m *Model) UpdateLastUpdated(t time.Time) {
+	m.Table.UpdateLastUpdated(t)
+	for c := range m.columns {
+		m.columns[c].table.UpdateLastUpdated(t)
+	}
+}
+
+func (m *Model) ResetRows() {
+	m.Items = nil
+	m.columns = nil
+	m.currColumn = 0
+	m.firstColumn = 0
+	m.contents = nil
+	m.BaseModel.ResetRows()
+}
+
+func FetchAllSections(
+	ctx *context.ProgramContext,
+) (sections []section.Section, fetchAllCmd tea.Cmd) {
+	sectionConfigs := ctx.Config.ProjectsSections
+	fetchProjectsCmds := make([]tea.Cmd, 0, len(sectionConfigs))
+	sections = make([]section.Section, 0, len(sectionConfigs))
+	for i, sectionConfig := range sectionConfigs {
+		sectionModel := NewModel(
+			i+1,
+			ctx,
+			sectionConfig,
+			time.Now(),
+			time.Now(),
+		) // 0 is the search section
+		sections = append(sections, &sectionModel)
+		fetchProjectsCmds = append(
+			fetchProjectsCmds,
+			sectionModel.FetchNextPageSectionRows()...)
+	}
+	return sections, tea.Batch(fetchProjectsCmds...)
+}
+
+type SectionProjectFetchedMsg struct {
+	Project data.ProjectResponse
+	TaskId  string
+}
+
+func (m Model) GetItemSingularForm() string {
+	return "Item"
+}
+
+func (m Model) GetItemPluralForm() string {
+	return "Items"
+}
+
+func (m Model) GetTotalCount() int {
+	return m.TotalCount
+}
+
+func (m *Model) GetIsLoading() bool {
+	return m.IsLoading
+}
+
+func (m *Model) SetIsLoading(val bool) {
+	m.IsLoading = val
+	m.Table.SetIsLoading(val)
+}
+
+func (m *Model) GetPagerContent() string {
+	pagerContent := ""
+	lastUpdated := m.LastUpdated().Format("01/02 15:04:05")
+	if m.IsStale {
+		lastUpdated = "Cached " + lastUpdated
+	}
+	if len(m.columns) > 0 {
+		col := m.columns[m.currColumn]
+		pagerContent = fmt.Sprintf(
+			"%v %v • %v %v • %v %v/%v • %v %v/%v",
+			constants.WaitingIcon,
+			lastUpdated,
+			constants.ProjectIcon,
+			m.Project.Title,
+			col.status.Name,
+			m.currColumn+1,
+			len(m.columns),
+			m.SingularForm,
+			col.table.GetCurrItem()+1,
+			len(col.items),
+		)
+	}
+	pager := m.Ctx.Styles.ListViewPort.PagerStyle.Render(pagerContent)
+	return pager
+}
@@ -0,0 +1,142 @@
+package projectssection
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/theme"
+)
+
+var (
+	todo       = data.ProjectFieldOption{Id: "1", Name: "Todo"}
+	inProgress = data.ProjectFieldOption{Id: "2", Name: "In Progress"}
+	done       = data.ProjectFieldOption{Id: "3", Name: "Done"}
+)
+
+func newProjectItem(id string, title string, status *data.ProjectFieldOption) data.ProjectItem {
+	item := data.ProjectItem{Id: id, Type: data.ProjectItemIssue}
+	item.Content.Issue.Title = title
+	item.Content.Issue.State = "OPEN"
+	if status != nil {
+		item.SetStatus(*status)
+	}
+	return item
+}
+
+func newTestModel(t *testing.T) Model {
+	t.Helper()
+
+	cfg, err := config.ParseConfig(config.Location{
+		ConfigFlag:       "../../../config/testdata/test-config.yml",
+		SkipGlobalConfig: true,
+	})
+	require.NoError(t, err)
+
+	thm := theme.ParseTheme(&cfg)
+	ctx := &context.ProgramContext{
+		Config:            &cfg,
+		Theme:             thm,
+		Styles:            context.InitStyles(thm),
+		MainContentWidth:  140,
+		MainContentHeight: 40,
+		StartTask: func(task context.Task) tea.Cmd {
+			return nil
+		},
+	}
+
+	m := NewModel(1, ctx, config.ProjectsSectionConfig{
+		Title:   "Roadmap",
+		Filters: "project:dlvhdr/1",
+	}, time.Now(), time.Now())
+	m.LastFetchTaskId = "fetching_project"
+	m.Update(SectionProjectFetchedMsg{
+		TaskId: "fetching_project",
+		Project: data.ProjectResponse{
+			Project: data.ProjectData{Id: "PVT_1", Title: "Roadmap"},
+			StatusField: data.ProjectStatusField{
+				Id:      "PVTSSF_1",
+				Options: []data.ProjectFieldOption{todo, inProgress, done},
+			},
+			Items: []data.ProjectItem{
+				newProjectItem("PVTI_1", "Board view", &todo),
+				newProjectItem("PVTI_2", "Custom fields", &todo),
+				newProjectItem("PVTI_3", "Triage", nil),
+				newProjectItem("PVTI_4", "Releases view", &done),
+			},
+		},
+	})
+	return m
+}
+
+func TestBoardColumns(t *testing.T) {
+	m := newTestModel(t)
+
+	require.Len(t, m.columns, 4, "items without a status get their own column")
+	require.Equal(t, "No Status", m.columns[0].status.Name)
+	require.Equal(t, "Triage", m.GetCurrRow().GetTitle())
+
+	m.NextColumn()
+	require.Equal(t, 2, m.NumRows())
+	m.NextRow()
+	require.Equal(t, "Custom fields", m.GetCurrRow().GetTitle())
+
+	m.NextColumn()
+	require.Equal(t, 0, m.NumRows())
+	require.Nil(t, m.GetCurrRow())
+
+	view := ansi.Strip(m.View())
+	require.Contains(t, view, "Todo 2")
+	require.Contains(t, view, "In Progress 0")
+	require.Contains(t, view, "Board view")
+}
+
+func TestMoveCurrItem(t *testing.T) {
+	m := newTestModel(t)
+
+	require.Nil(t, m.MoveCurrItem(-1), "items can't be moved out of the board")
+	m.NextColumn()
+	require.Nil(t, m.MoveCurrItem(-1), "items can't be moved to the column without a status")
+	require.NotNil(t, m.MoveCurrItem(1))
+
+	m.NextRow()
+	m.Update(tasks.UpdateProjectItemMsg{ItemId: "PVTI_2"})
+	require.Equal(t, "Custom fields", m.GetCurrRow().GetTitle(), "failed moves are ignored")
+
+	m.Update(tasks.UpdateProjectItemMsg{ItemId: "PVTI_2", Status: &done})
+	require.Equal(t, "Done", m.columns[m.currColumn].status.Name,
+		"the moved item stays selected")
+	require.Equal(t, "Custom fields", m.GetCurrRow().GetTitle())
+	require.Equal(t, 2, m.NumRows())
+}
+
+func TestItemContent(t *testing.T) {
+	m := newTestModel(t)
+
+	require.NotNil(t, m.LoadCurrItemContent())
+	require.Nil(t, m.LoadCurrItemContent(), "the content is only fetched once")
+
+	issue := &data.IssueData{Title: "Triage the backlog"}
+	require.False(t, m.SetItemContent(ItemContentFetchedMsg{ItemId: "PVTI_1", Content: issue}),
+		"items that weren't requested are ignored")
+	require.True(t, m.SetItemContent(ItemContentFetchedMsg{ItemId: "PVTI_3", Content: issue}))
+	require.Equal(t, issue, m.GetCurrRow())
+
+	m.NextColumn()
+	m.LoadCurrItemContent()
+	require.True(t, m.SetItemContent(ItemContentFetchedMsg{
+		ItemId: "PVTI_1",
+		Err:    errors.New("boom"),
+	}))
+	require.IsType(t, &data.ProjectItem{}, m.GetCurrRow())
+	require.EqualError(t, m.GetItemContentErr("PVTI_1"), "boom")
+	require.Nil(t, m.LoadCurrItemContent(), "failed fetches aren't retried until a refresh")
+}
@@ -8,6 +8,7 @@ import (
 	"charm.land/lipgloss/v2/compat"
 	checks "github.com/dlvhdr/x/gh-checks"
 
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
 	"github.com/dlvhdr/gh-dash/v4/internal/git"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/common"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components"
@@ -23,6 +24,8 @@ type PullRequest struct {
 	Branch         git.Branch
 	Columns        []table.Column
 	ShowAuthorIcon bool
+	// ProjectFields are the project fields shown after the other columns
+	ProjectFields []string
 }
 
 func (pr *PullRequest) getTextStyle() lipgloss.Style {
@@ -236,6 +239,13 @@ func (pr *PullRequest) renderAssignees() string {
 	return pr.getTextStyle().Render(strings.Join(assignees, ","))
 }
 
+func (pr *PullRequest) renderMilestone() string {
+	if pr.Data.Primary == nil || pr.Data.Primary.Milestone == nil {
+		return ""
+	}
+	return pr.getTextStyle().Render(pr.Data.Primary.Milestone.Title)
+}
+
 func (pr *PullRequest) renderLabels(isSelected bool) string {
 	if pr.Data == nil || pr.Data.Primary == nil || len(pr.Data.Primary.Labels.Nodes) == 0 {
 		return ""
@@ -385,12 +395,19 @@ func (pr *PullRequest) RenderMergeStateStatus() string {
 }
 
 func (pr *PullRequest) ToTableRow(isSelected bool) table.Row {
+	var projectItems data.ProjectItems
+	if pr.Data.Primary != nil {
+		projectItems = pr.Data.Primary.ProjectItems
+	}
+	projectFields := components.RenderProjectFields(pr.Ctx, projectItems, pr.ProjectFields)
+
 	if !pr.Ctx.Config.Theme.Ui.Table.Compact {
-		return table.Row{
+		return append(table.Row{
 			pr.renderState(),
 			pr.renderExtendedTitle(isSelected),
 			pr.renderLabels(isSelected),
 			pr.renderAssignees(),
+			pr.renderMilestone(),
 			pr.renderBaseName(),
 			pr.renderNumComments(),
 			pr.renderReviewStatus(),
@@ -398,16 +415,17 @@ func (pr *PullRequest) ToTableRow(isSelected bool) table.Row {
 			pr.RenderLines(isSelected),
 			pr.renderUpdateAt(),
 			pr.renderCreatedAt(),
-		}
+		}, projectFields...)
 	}
 
-	return table.Row{
+	return append(table.Row{
 		pr.renderState(),
 		pr.renderRepoName(),
 		pr.renderTitle(),
 		pr.renderAuthor(),
 		pr.renderLabels(isSelected),
 		pr.renderAssignees(),
+		pr.renderMilestone(),
 		pr.renderBaseName(),
 		pr.renderNumComments(),
 		pr.renderReviewStatus(),
@@ -415,5 +433,5 @@ func (pr *PullRequest) ToTableRow(isSelected bool) table.Row {
 		pr.RenderLines(isSelected),
 		pr.renderUpdateAt(),
 		pr.renderCreatedAt(),
-	}
+	}, projectFields...)
 }
@@ -9,7 +9,7 @@ import (
 
 	tea "charm.land/bubbletea/v2"
 
-	"github.com/dlvhdr/gh-dash/v4/internal/tui/common"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
 )
@@ -21,15 +21,16 @@ func (m *Model) checkout() (tea.Cmd, error) {
 	}
 
 	repoName := pr.GetRepoNameWithOwner()
-	repoPath, ok := common.GetRepoLocalPath(repoName, m.Ctx.Config.RepoPaths)
-
-	if !ok {
-		return nil, errors.New(
-			"local path to repo not specified, set one in your config.yml under repoPaths",
-		)
-	}
-
 	prNumber := pr.GetNumber()
+	return tasks.WithRepoPath(m.Ctx, repoName, func(repoPath string) tea.Cmd {
+		if m.Ctx.Config.Worktrees.Enabled {
+			return tasks.CheckoutPRWorktree(m.Ctx, repoPath, repoName, prNumber)
+		}
+		return m.checkoutPR(repoPath, prNumber)
+	})
+}
+
+func (m *Model) checkoutPR(repoPath string, prNumber int) tea.Cmd {
 	taskId := fmt.Sprintf("checkout_%d", prNumber)
 	task := context.Task{
 		Id:           taskId,
@@ -44,7 +45,7 @@ func (m *Model) checkout() (tea.Cmd, error) {
 			"gh",
 			"pr",
 			"checkout",
-			fmt.Sprint(m.GetCurrRow().GetNumber()),
+			fmt.Sprint(prNumber),
 		)
 		userHomeDir, _ := os.UserHomeDir()
 		if strings.HasPrefix(repoPath, "~") {
@@ -54,5 +55,5 @@ func (m *Model) checkout() (tea.Cmd, error) {
 		c.Dir = repoPath
 		err := c.Run()
 		return constants.TaskFinishedMsg{TaskId: taskId, Err: err}
-	}), nil
+	})
 }
@@ -25,7 +25,8 @@ const SectionType = "pr"
 
 type Model struct {
 	section.BaseModel
-	Prs []prrow.Data
+	Prs           []prrow.Data
+	projectFields []string
 }
 
 func NewModel(
@@ -50,6 +51,9 @@ func NewModel(
 		},
 	)
 	m.Prs = []prrow.Data{}
+	m.projectFields = section.ProjectFieldNames(config.MergeProjectFieldColumns(
+		ctx.Config.Defaults.Layout.Prs.ProjectFields, cfg.Layout.ProjectFields))
+	m.Sorting = section.NewSorting(sortColumns, cfg.SortBy, cfg.SortDirection, cfg.GroupBy)
 
 	return m
 }
@@ -91,20 +95,37 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 				action := m.GetPromptConfirmationAction()
 				pr := m.GetCurrRow()
 				sid := tasks.SectionIdentifier{Id: m.Id, Type: SectionType}
+				selected := m.GetSelectedRows()
 				if input == "Y" || input == "y" {
 					switch action {
+					case section.ConfirmAction:
+						cmd = m.RunConfirmedAction()
 					case "close":
-						cmd = tasks.ClosePR(m.Ctx, sid, pr)
+						if len(selected) > 0 {
+							cmd = tasks.ClosePRs(m.Ctx, sid, selected)
+						} else {
+							cmd = tasks.ClosePR(m.Ctx, sid, pr)
+						}
 					case "reopen":
-						cmd = tasks.ReopenPR(m.Ctx, sid, pr)
+						if len(selected) > 0 {
+							cmd = tasks.ReopenPRs(m.Ctx, sid, selected)
+						} else {
+							cmd = tasks.ReopenPR(m.Ctx, sid, pr)
+						}
 					case "ready":
 						cmd = tasks.PRReady(m.Ctx, sid, pr)
 					case "merge":
-						cmd = tasks.MergePR(m.Ctx, sid, pr)
+						cmd = tasks.MergePR(m.Ctx, sid, pr, tasks.DefaultMergeOptions(m.Ctx, pr))
 					case "update":
 						cmd = tasks.UpdatePR(m.Ctx, sid, pr)
 					case "approveWorkflows":
 						cmd = tasks.ApproveWorkflows(m.Ctx, sid, pr)
+					case "rerunFailedWorkflows":
+						cmd = tasks.RerunFailedWorkflows(m.Ctx, sid, pr)
+					case "rerunAllWorkflows":
+						cmd = tasks.RerunAllWorkflows(m.Ctx, sid, pr)
+					case "cancelWorkflows":
+						cmd = tasks.CancelWorkflows(m.Ctx, sid, pr)
 					}
 				}
 
@@ -153,6 +174,30 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 
 		case key.Matches(msg, keys.PRKeys.WatchChecks):
 			cmd = m.watchChecks()
+
+		case key.Matches(msg, keys.PRKeys.SortBy):
+			m.Sorting = section.NextSortColumn(sortColumns, m.Sorting)
+			m.sortPrs()
+
+		case key.Matches(msg, keys.PRKeys.ReverseSort):
+			m.Sorting.Desc = !m.Sorting.Desc
+			m.sortPrs()
+
+		case key.Matches(msg, keys.PRKeys.GroupBy):
+			m.Sorting = section.NextGroupBy(groupColumns, m.Sorting)
+			m.sortPrs()
+
+		case key.Matches(msg, keys.PRKeys.ToggleGroup):
+			m.Table.ToggleCurrGroup()
+		}
+
+	case tasks.UpdateProjectFieldMsg:
+		for i := range m.Prs {
+			if pr := m.Prs[i].Primary; pr != nil && pr.Url == msg.Url {
+				pr.ProjectItems.SetFieldValue(msg.ItemId, msg.Field, msg.Value)
+				m.Prs[i].Enriched.ProjectItems.SetFieldValue(msg.ItemId, msg.Field, msg.Value)
+				m.Table.SetRows(m.BuildRows())
+			}
 		}
 
 	case tasks.UpdatePRMsg:
@@ -161,6 +206,13 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 				continue
 			}
 
+			if msg.Title != nil {
+				currPr.Primary.Title = *msg.Title
+				currPr.Enriched.Title = *msg.Title
+			}
+			if msg.Body != nil {
+				currPr.Enriched.Body = *msg.Body
+			}
 			if msg.IsClosed != nil {
 				if *msg.IsClosed {
 					currPr.Primary.State = "CLOSED"
@@ -180,24 +232,82 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 				currPr.Primary.Assignees.Nodes = removeAssignees(
 					currPr.Primary.Assignees.Nodes, msg.RemovedAssignees.Nodes)
 			}
+			if msg.AddedReviewRequests != nil {
+				currPr.Enriched.ReviewRequests = addReviewRequests(
+					currPr.Enriched.ReviewRequests, msg.AddedReviewRequests.Nodes)
+			}
+			if msg.RemovedReviewRequests != nil {
+				currPr.Enriched.ReviewRequests = removeReviewRequests(
+					currPr.Enriched.ReviewRequests, msg.RemovedReviewRequests.Nodes)
+			}
 			if msg.Labels != nil {
 				currPr.Primary.Labels.Nodes = msg.Labels.Nodes
 			}
+			if msg.AddedLabels != nil {
+				currPr.Primary.Labels.Nodes = addLabels(
+					currPr.Primary.Labels.Nodes, msg.AddedLabels.Nodes)
+			}
+			if msg.NewReview != nil {
+				currPr.Enriched.Reviews.Nodes = append(
+					currPr.Enriched.Reviews.Nodes, *msg.NewReview)
+				currPr.Enriched.Reviews.TotalCount++
+				currPr.Primary.Reviews.TotalCount++
+			}
+			if msg.ReviewDecision != nil {
+				currPr.Primary.ReviewDecision = *msg.ReviewDecision
+			}
+			if msg.ReviewThread != nil {
+				updateReviewThread(&currPr.Enriched.ReviewThreads, *msg.ReviewThread)
+			}
+			if msg.Reaction != nil {
+				updateReactions(&currPr.Enriched, *msg.Reaction)
+			}
+			if msg.Comment != nil {
+				updateComment(&currPr, *msg.Comment)
+			}
 			if msg.ReadyForReview != nil && *msg.ReadyForReview {
 				currPr.Primary.IsDraft = false
 			}
+			if msg.IsInMergeQueue != nil {
+				currPr.Primary.IsInMergeQueue = *msg.IsInMergeQueue
+				currPr.Enriched.IsInMergeQueue = *msg.IsInMergeQueue
+			}
+			if msg.AutoMerge != nil {
+				currPr.Enriched.AutoMergeRequest = msg.AutoMerge.Request
+			}
+			if msg.Milestone != nil {
+				currPr.Primary.Milestone = msg.Milestone.Milestone
+			}
 			if msg.IsMerged != nil && *msg.IsMerged {
 				currPr.Primary.State = "MERGED"
 				currPr.Primary.Mergeable = ""
 			}
+			if msg.Checks != nil && len(msg.Checks.Nodes) > 0 {
+				currPr.Primary.Commits.SetState(
+					msg.Checks.Nodes[0].Commit.StatusCheckRollup.State)
+				if currPr.IsEnriched {
+					currPr.Enriched.Commits = *msg.Checks
+				}
+			}
 			m.Prs[i] = currPr
 			m.SetIsLoading(false)
 			m.Table.SetRows(m.BuildRows())
 			break
 		}
 
+	case tasks.BulkUpdateMsg:
+		cmds := make([]tea.Cmd, 0, len(msg.Msgs))
+		for _, updateMsg := range msg.Msgs {
+			_, updateCmd := m.Update(updateMsg)
+			cmds = append(cmds, updateCmd)
+		}
+		m.ClearSelection()
+		return m, tea.Batch(cmds...)
+
 	case SectionPullRequestsFetchedMsg:
 		if m.LastFetchTaskId == msg.TaskId {
+			m.IsStale = false
+			m.TrackSearchOrder(prKeys(msg.Prs), m.PageInfo == nil)
 			if m.PageInfo != nil {
 				m.Prs = append(m.Prs, msg.Prs...)
 			} else {
@@ -206,6 +316,7 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 			m.TotalCount = msg.TotalCount
 			m.PageInfo = &msg.PageInfo
 			m.SetIsLoading(false)
+			m.sortPrs()
 			m.Table.SetRows(m.BuildRows())
 			m.Table.UpdateLastUpdated(time.Now())
 			m.UpdateTotalItemsCount(m.TotalCount)
@@ -214,6 +325,7 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 
 	search, searchCmd := m.SearchBar.Update(msg)
 	m.Table.SetRows(m.BuildRows())
+	m.SyncSelection(m.rowKeys())
 	m.SearchBar = search
 
 	prompt, promptCmd := m.PromptConfirmationBox.Update(msg)
@@ -236,6 +348,26 @@ func (m *Model) EnrichPR(data data.EnrichedPullRequestData) {
 	}
 }
 
+// UpdateChecks sets the polled checks of the last commit of a PR, so its CI column and checks
+// tab show them without refetching the section.
+func (m *Model) UpdateChecks(prUrl string, commits data.LastCommitWithStatusChecks) {
+	updated := false
+	for i, currPr := range m.Prs {
+		if currPr.Primary.Url != prUrl || len(commits.Nodes) == 0 {
+			continue
+		}
+
+		m.Prs[i].Primary.Commits.SetState(commits.Nodes[0].Commit.StatusCheckRollup.State)
+		if m.Prs[i].IsEnriched {
+			m.Prs[i].Enriched.Commits = commits
+		}
+		updated = true
+	}
+	if updated {
+		m.Table.SetRows(m.BuildRows())
+	}
+}
+
 func GetSectionColumns(
 	cfg config.PrsSectionConfig,
 	ctx *context.ProgramContext,
@@ -258,6 +390,10 @@ func GetSectionColumns(
 		dLayout.Assignees,
 		sLayout.Assignees,
 	)
+	milestoneLayout := config.MergeColumnConfigs(
+		dLayout.Milestone,
+		sLayout.Milestone,
+	)
 	baseLayout := config.MergeColumnConfigs(dLayout.Base, sLayout.Base)
 	numCommentsLayout := config.MergeColumnConfigs(
 		dLayout.NumComments,
@@ -271,9 +407,11 @@ func GetSectionColumns(
 	ciLayout := config.MergeColumnConfigs(dLayout.Ci, sLayout.Ci)
 	labelsLayout := config.MergeColumnConfigs(dLayout.Labels, sLayout.Labels)
 	linesLayout := config.MergeColumnConfigs(dLayout.Lines, sLayout.Lines)
+	projectFieldColumns := section.ProjectFieldColumns(
+		config.MergeProjectFieldColumns(dLayout.ProjectFields, sLayout.ProjectFields))
 
 	if !ctx.Config.Theme.Ui.Table.Compact {
-		return []table.Column{
+		return append([]table.Column{
 			{
 				Title:  "",
 				Width:  utils.IntPtr(3),
@@ -294,6 +432,11 @@ func GetSectionColumns(
 				Width:  assigneesLayout.Width,
 				Hidden: assigneesLayout.Hidden,
 			},
+			{
+				Title:  "Milestone",
+				Width:  milestoneLayout.Width,
+				Hidden: milestoneLayout.Hidden,
+			},
 			{
 				Title:  "Base",
 				Width:  baseLayout.Width,
@@ -330,10 +473,10 @@ func GetSectionColumns(
 				Width:  createdAtLayout.Width,
 				Hidden: createdAtLayout.Hidden,
 			},
-		}
+		}, projectFieldColumns...)
 	}
 
-	return []table.Column{
+	return append([]table.Column{
 		{
 			Title:  "",
 			Width:  utils.IntPtr(3),
@@ -364,6 +507,11 @@ func GetSectionColumns(
 			Width:  assigneesLayout.Width,
 			Hidden: assigneesLayout.Hidden,
 		},
+		{
+			Title:  "Milestone",
+			Width:  milestoneLayout.Width,
+			Hidden: milestoneLayout.Hidden,
+		},
 		{
 			Title:  "Base",
 			Width:  baseLayout.Width,
@@ -400,7 +548,7 @@ func GetSectionColumns(
 			Width:  createdAtLayout.Width,
 			Hidden: createdAtLayout.Hidden,
 		},
-	}
+	}, projectFieldColumns...)
 }
 
 func (m Model) BuildRows() []table.Row {
@@ -411,6 +559,7 @@ func (m Model) BuildRows() []table.Row {
 			Ctx:     m.Ctx,
 			Data:    &currPr,
 			Columns: m.Table.Columns, ShowAuthorIcon: m.ShowAuthorIcon,
+			ProjectFields: m.projectFields,
 		}
 		rows = append(
 			rows,
@@ -445,6 +594,31 @@ func (m *Model) GetCurrRow() data.RowData {
 	return &pr
 }
 
+func (m *Model) rowKeys() []string {
+	return prKeys(m.Prs)
+}
+
+// ToggleSelection marks or unmarks the current PR for a bulk action.
+func (m *Model) ToggleSelection() {
+	pr := m.GetCurrRow()
+	if pr == nil {
+		return
+	}
+	m.ToggleRowSelection(pr.GetUrl())
+	m.SyncSelection(m.rowKeys())
+}
+
+// GetSelectedRows returns the marked PRs in the order they are shown.
+func (m *Model) GetSelectedRows() []data.RowData {
+	var rows []data.RowData
+	for i := range m.Prs {
+		if m.Selection[m.Prs[i].Primary.Url] {
+			rows = append(rows, &m.Prs[i])
+		}
+	}
+	return rows
+}
+
 func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
 	if m == nil {
 		return nil
@@ -476,12 +650,21 @@ func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
 	startCmd := m.Ctx.StartTask(task)
 	cmds = append(cmds, startCmd)
 
-	fetchCmd := func() tea.Msg {
-		limit := m.Config.Limit
-		if limit == nil {
-			limit = &m.Ctx.Config.Defaults.PrsLimit
-		}
+	limit := m.Config.Limit
+	if limit == nil {
+		limit = &m.Ctx.Config.Defaults.PrsLimit
+	}
+	isFirstPage := m.PageInfo == nil
+	if isFirstPage {
+		m.CacheKey = data.SectionCacheKey(m.Type, m.GetFilters(), *limit)
+	}
+	cacheKey := m.CacheKey
+
+	if isFirstFetch {
+		m.warmStartFromCache()
+	}
 
+	fetchCmd := func() tea.Msg {
 		res, err := data.FetchPullRequests(m.GetFilters(), *limit, m.PageInfo)
 		if err != nil {
 			return constants.TaskFinishedMsg{
@@ -492,10 +675,11 @@ func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
 			}
 		}
 
-		prs := make([]prrow.Data, 0)
-		for _, pr := range res.Prs {
-			prs = append(prs, prrow.Data{Primary: &pr})
+		if isFirstPage {
+			data.GetSectionCache().Put(cacheKey, res)
 		}
+
+		prs := toRowsData(res.Prs)
 		return constants.TaskFinishedMsg{
 			SectionId:   m.Id,
 			SectionType: m.Type,
@@ -511,7 +695,7 @@ func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
 	cmds = append(cmds, fetchCmd)
 
 	m.IsLoading = true
-	if isFirstFetch {
+	if isFirstFetch && !m.IsStale {
 		m.SetIsLoading(true)
 		cmds = append(cmds, m.Table.StartLoadingSpinner())
 	}
@@ -519,6 +703,33 @@ func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
 	return cmds
 }
 
+// warmStartFromCache shows the last known results for the section, marked as
+// stale, until the fetch that is in flight replaces them.
+func (m *Model) warmStartFromCache() {
+	var cached data.PullRequestsResponse
+	fetchedAt, ok := data.GetSectionCache().Get(m.CacheKey, m.CacheMaxAge(), &cached)
+	if !ok {
+		return
+	}
+
+	m.Prs = toRowsData(cached.Prs)
+	m.TotalCount = cached.TotalCount
+	m.IsStale = true
+	m.TrackSearchOrder(m.rowKeys(), true)
+	m.sortPrs()
+	m.Table.SetRows(m.BuildRows())
+	m.Table.UpdateLastUpdated(fetchedAt)
+	m.UpdateTotalItemsCount(m.TotalCount)
+}
+
+func toRowsData(prs []data.PullRequestData) []prrow.Data {
+	rows := make([]prrow.Data, 0, len(prs))
+	for _, pr := range prs {
+		rows = append(rows, prrow.Data{Primary: &pr})
+	}
+	return rows
+}
+
 func (m *Model) ResetRows() {
 	m.Prs = nil
 	m.BaseModel.ResetRows()
@@ -578,10 +789,158 @@ func removeAssignees(
 	return newAssignees
 }
 
+func hasReviewRequest(requests []data.ReviewRequestNode, request data.ReviewRequestNode) bool {
+	return slices.ContainsFunc(requests, func(r data.ReviewRequestNode) bool {
+		return r.GetReviewerDisplayName() == request.GetReviewerDisplayName()
+	})
+}
+
+func addReviewRequests(
+	requests data.ReviewRequests,
+	added []data.ReviewRequestNode,
+) data.ReviewRequests {
+	for _, request := range added {
+		if !hasReviewRequest(requests.Nodes, request) {
+			requests.Nodes = append(requests.Nodes, request)
+		}
+	}
+	requests.TotalCount = len(requests.Nodes)
+	return requests
+}
+
+func removeReviewRequests(
+	requests data.ReviewRequests,
+	removed []data.ReviewRequestNode,
+) data.ReviewRequests {
+	nodes := []data.ReviewRequestNode{}
+	for _, request := range requests.Nodes {
+		if !hasReviewRequest(removed, request) {
+			nodes = append(nodes, request)
+		}
+	}
+	requests.Nodes = nodes
+	requests.TotalCount = len(nodes)
+	return requests
+}
+
+func addLabels(labels, addedLabels []data.Label) []data.Label {
+	newLabels := labels
+	for _, label := range addedLabels {
+		hasLabel := func(l data.Label) bool { return l.Name == label.Name }
+		if !slices.ContainsFunc(newLabels, hasLabel) {
+			newLabels = append(newLabels, label)
+		}
+	}
+
+	return newLabels
+}
+
 func assigneesContains(assignees []data.Assignee, assignee data.Assignee) bool {
 	return slices.Contains(assignees, assignee)
 }
 
+func updateReviewThread(threads *data.ReviewThreadsWithComments, update tasks.ReviewThreadUpdate) {
+	for i := range threads.Nodes {
+		thread := &threads.Nodes[i]
+		if thread.Id != update.Id {
+			continue
+		}
+		if update.NewComment != nil {
+			thread.Comments.Nodes = append(thread.Comments.Nodes, *update.NewComment)
+			thread.Comments.TotalCount++
+		}
+		if update.IsResolved != nil {
+			thread.IsResolved = *update.IsResolved
+		}
+		return
+	}
+}
+
+// updateReactions toggles the user's reaction on the PR or the comment it was made on.
+func updateReactions(pr *data.EnrichedPullRequestData, update tasks.ReactionUpdate) {
+	toggle := func(groups data.ReactionGroups) data.ReactionGroups {
+		return groups.WithReaction(update.Content, !update.Removed)
+	}
+	if pr.Id == update.SubjectId {
+		pr.ReactionGroups = toggle(pr.ReactionGroups)
+		return
+	}
+	for i := range pr.Comments.Nodes {
+		if comment := &pr.Comments.Nodes[i]; comment.Id == update.SubjectId {
+			comment.ReactionGroups = toggle(comment.ReactionGroups)
+			return
+		}
+	}
+	for i := range pr.Reviews.Nodes {
+		if review := &pr.Reviews.Nodes[i]; review.Id == update.SubjectId {
+			review.ReactionGroups = toggle(review.ReactionGroups)
+			return
+		}
+	}
+	for i := range pr.ReviewThreads.Nodes {
+		comments := pr.ReviewThreads.Nodes[i].Comments.Nodes
+		for j := range comments {
+			if comments[j].Id == update.SubjectId {
+				comments[j].ReactionGroups = toggle(comments[j].ReactionGroups)
+				return
+			}
+		}
+	}
+}
+
+// updateComment applies an edited, deleted or hidden comment, review or review comment to the
+// PR.
+func updateComment(pr *prrow.Data, update tasks.CommentUpdate) {
+	apply := func(body *string, meta *data.CommentMeta) {
+		if update.Body != nil {
+			*body = *update.Body
+		}
+		if update.IsMinimized {
+			meta.IsMinimized = true
+		}
+	}
+
+	enriched := &pr.Enriched
+	for i := range enriched.Comments.Nodes {
+		if comment := &enriched.Comments.Nodes[i]; comment.Id == update.Id {
+			if update.IsDeleted {
+				enriched.Comments.Nodes = slices.Delete(enriched.Comments.Nodes, i, i+1)
+				enriched.Comments.TotalCount--
+				pr.Primary.Comments.TotalCount--
+				return
+			}
+			apply(&comment.Body, &comment.CommentMeta)
+			return
+		}
+	}
+	for i := range enriched.Reviews.Nodes {
+		if review := &enriched.Reviews.Nodes[i]; review.Id == update.Id {
+			if update.IsDeleted {
+				enriched.Reviews.Nodes = slices.Delete(enriched.Reviews.Nodes, i, i+1)
+				enriched.Reviews.TotalCount--
+				pr.Primary.Reviews.TotalCount--
+				return
+			}
+			apply(&review.Body, &review.CommentMeta)
+			return
+		}
+	}
+	for i := range enriched.ReviewThreads.Nodes {
+		comments := &enriched.ReviewThreads.Nodes[i].Comments
+		for j := range comments.Nodes {
+			if comment := &comments.Nodes[j]; comment.Id == update.Id {
+				if update.IsDeleted {
+					comments.Nodes = slices.Delete(comments.Nodes, j, j+1)
+					comments.TotalCount--
+					return
+				}
+				apply(&comment.Body, &comment.CommentMeta)
+				return
+			}
+		}
+	}
+}
+
 func (m Model) GetItemSingularForm() string {
 	return "PR"
 }
@@ -607,16 +966,21 @@ func (m Model) GetPagerContent() string {
 	} else {
 		timeElapsed = fmt.Sprintf("~%v ago", timeElapsed)
 	}
+	updatedText := "Updated"
+	if m.IsStale {
+		updatedText = "Cached"
+	}
 	if m.TotalCount > 0 {
 		pagerContent = fmt.Sprintf(
-			"%v Updated %v • %v %v/%v (fetched %v)",
+			"%v %v %v • %v %v/%v (fetched %v)",
 			constants.WaitingIcon,
+			updatedText,
 			timeElapsed,
 			m.SingularForm,
 			m.Table.GetCurrItem()+1,
 			m.TotalCount,
 			len(m.Table.Rows),
-		)
+		) + m.SortingStatus() + m.SelectionStatus()
 	}
 	pager := m.Ctx.Styles.ListViewPort.PagerStyle.Render(pagerContent)
 	return pager
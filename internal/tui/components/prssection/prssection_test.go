@@ -1,22 +1,32 @@
 package prssection
 
 import (
+	"encoding/json"
 	"testing"
+	"time"
 
 	tea "charm.land/bubbletea/v2"
+	checks "github.com/dlvhdr/x/gh-checks"
 	"github.com/stretchr/testify/require"
 
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
 	"github.com/dlvhdr/gh-dash/v4/internal/data"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prompt"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prrow"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/table"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/theme"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
 )
 
 // newTestModel creates a minimal Model with the prompt confirmation box
 // focused and a single PR row so that GetCurrRow returns non-nil.
 func newTestModel(action string) Model {
 	ctx := &context.ProgramContext{
+		Config: &config.Config{},
 		StartTask: func(task context.Task) tea.Cmd {
 			return func() tea.Msg { return nil }
 		},
@@ -130,3 +140,159 @@ func TestConfirmation_AllActions(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateReviewThread(t *testing.T) {
+	threads := data.ReviewThreadsWithComments{
+		Nodes: []data.ReviewThread{{Id: "PRRT_1"}, {Id: "PRRT_2"}},
+	}
+	resolved := true
+
+	updateReviewThread(&threads, tasks.ReviewThreadUpdate{
+		Id:         "PRRT_2",
+		NewComment: &data.ReviewComment{Body: "done"},
+		IsResolved: &resolved,
+	})
+
+	require.False(t, threads.Nodes[0].IsResolved)
+	require.Empty(t, threads.Nodes[0].Comments.Nodes)
+	require.True(t, threads.Nodes[1].IsResolved)
+	require.Len(t, threads.Nodes[1].Comments.Nodes, 1)
+	require.Equal(t, "done", threads.Nodes[1].Comments.Nodes[0].Body)
+}
+
+func TestUpdateReactions(t *testing.T) {
+	pr := data.EnrichedPullRequestData{Id: "PR_1"}
+	pr.Comments.Nodes = []data.Comment{{Id: "IC_1"}}
+	pr.ReviewThreads.Nodes = []data.ReviewThread{{Id: "PRRT_1"}}
+	pr.ReviewThreads.Nodes[0].Comments.Nodes = []data.ReviewComment{{Id: "PRRC_1"}}
+
+	updateReactions(&pr, tasks.ReactionUpdate{SubjectId: "PR_1", Content: "HEART"})
+	updateReactions(&pr, tasks.ReactionUpdate{SubjectId: "PRRC_1", Content: "EYES"})
+
+	require.True(t, pr.ReactionGroups.Get("HEART").ViewerHasReacted)
+	require.Empty(t, pr.Comments.Nodes[0].ReactionGroups)
+	require.Equal(t, 1,
+		pr.ReviewThreads.Nodes[0].Comments.Nodes[0].ReactionGroups.Get("EYES").Reactors.TotalCount)
+
+	updateReactions(&pr, tasks.ReactionUpdate{SubjectId: "PR_1", Content: "HEART", Removed: true})
+	require.Zero(t, pr.ReactionGroups.Get("HEART").Reactors.TotalCount)
+}
+
+func TestGetSelectedRows_KeepsShownOrder(t *testing.T) {
+	m := newTestModel("")
+	m.Prs = []prrow.Data{
+		{Primary: &data.PullRequestData{Number: 1, Url: "https://github.com/o/r/pull/1"}},
+		{Primary: &data.PullRequestData{Number: 2, Url: "https://github.com/o/r/pull/2"}},
+		{Primary: &data.PullRequestData{Number: 3, Url: "https://github.com/o/r/pull/3"}},
+	}
+	m.ToggleRowSelection("https://github.com/o/r/pull/3")
+	m.ToggleRowSelection("https://github.com/o/r/pull/1")
+
+	rows := m.GetSelectedRows()
+
+	require.Len(t, rows, 2)
+	require.Equal(t, 1, rows[0].GetNumber())
+	require.Equal(t, 3, rows[1].GetNumber())
+}
+
+func TestAddLabels_SkipsExistingLabels(t *testing.T) {
+	labels := []data.Label{{Name: "bug", Color: "ff0000"}}
+
+	got := addLabels(labels, []data.Label{{Name: "bug"}, {Name: "docs"}})
+
+	require.Equal(t, []data.Label{{Name: "bug", Color: "ff0000"}, {Name: "docs"}}, got)
+}
+
+func TestReviewRequests_AddAndRemove(t *testing.T) {
+	user := data.ReviewRequestNode{}
+	user.RequestedReviewer.User.Login = "octo"
+	team := data.ReviewRequestNode{}
+	team.RequestedReviewer.Team.Slug = "maintainers"
+	requests := data.ReviewRequests{TotalCount: 1, Nodes: []data.ReviewRequestNode{user}}
+
+	requests = addReviewRequests(requests, []data.ReviewRequestNode{user, team})
+	require.Equal(t, 2, requests.TotalCount, "already requested reviewers aren't added twice")
+	require.Equal(t, []data.ReviewRequestNode{user, team}, requests.Nodes)
+
+	requests = removeReviewRequests(requests, []data.ReviewRequestNode{user})
+	require.Equal(t, data.ReviewRequests{TotalCount: 1, Nodes: []data.ReviewRequestNode{team}},
+		requests)
+}
+
+func TestSortPrs_GroupsByLabelAndSortsByLines(t *testing.T) {
+	m := newTestModel("")
+	m.Prs = []prrow.Data{
+		{Primary: &data.PullRequestData{Url: "1", Additions: 10}},
+		{Primary: &data.PullRequestData{Url: "2", Additions: 500, Labels: data.PRLabels{
+			Nodes: []data.Label{{Name: "bug"}},
+		}}},
+		{Primary: &data.PullRequestData{Url: "3", Additions: 80}},
+	}
+	m.TrackSearchOrder(m.rowKeys(), true)
+	m.Sorting = section.NewSorting(sortColumns, "lines", "", "label")
+
+	m.sortPrs()
+
+	require.Equal(t, []string{"2", "3", "1"}, m.rowKeys())
+
+	m.Sorting = section.Sorting{}
+	m.sortPrs()
+
+	require.Equal(t, []string{"1", "2", "3"}, m.rowKeys())
+}
+
+func TestUpdateChecks(t *testing.T) {
+	m := newTestModel("")
+	// Rebuilding the rows needs a full configuration and theme
+	cfg, err := config.ParseConfig(config.Location{
+		ConfigFlag:       "../../../config/testdata/test-config.yml",
+		SkipGlobalConfig: true,
+	})
+	require.NoError(t, err)
+	m.Ctx.Config = &cfg
+	m.Ctx.Theme = theme.ParseTheme(&cfg)
+	m.Ctx.Styles = context.InitStyles(m.Ctx.Theme)
+	m.Table = table.NewModel(*m.Ctx, constants.Dimensions{Width: 80, Height: 10},
+		time.Now(), time.Now(), GetSectionColumns(config.PrsSectionConfig{}, m.Ctx), nil,
+		"pr", nil, "Loading...", false)
+	m.Prs = []prrow.Data{
+		{Primary: &data.PullRequestData{Url: "1"}},
+		{Primary: &data.PullRequestData{Url: "2"}, IsEnriched: true},
+	}
+	var commits data.LastCommitWithStatusChecks
+	err = json.Unmarshal([]byte(`{"Nodes": [{"Commit": {
+		"CommitUrl": "https://github.com/o/r/commit/abc",
+		"StatusCheckRollup": {"State": "FAILURE"}
+	}}]}`), &commits)
+	require.NoError(t, err)
+
+	m.UpdateChecks("2", commits)
+
+	require.Empty(t, m.Prs[0].Primary.Commits.Nodes, "other PRs are left alone")
+	pr := prrow.PullRequest{Data: &m.Prs[1]}
+	require.Equal(t, checks.CommitStateFailure, pr.GetStatusChecksRollup())
+	require.Equal(t, commits, m.Prs[1].Enriched.Commits)
+}
+
+func TestUpdateComment(t *testing.T) {
+	pr := prrow.Data{Primary: &data.PullRequestData{}}
+	pr.Primary.Comments.TotalCount = 1
+	pr.Enriched.Comments.Nodes = []data.Comment{{Id: "IC_1", Body: "old"}}
+	pr.Enriched.Comments.TotalCount = 1
+	pr.Enriched.Reviews.Nodes = []data.Review{{Id: "PRR_1"}}
+	pr.Enriched.ReviewThreads.Nodes = []data.ReviewThread{{Id: "PRRT_1"}}
+	pr.Enriched.ReviewThreads.Nodes[0].Comments.Nodes = []data.ReviewComment{{Id: "PRRC_1"}}
+
+	updateComment(&pr, tasks.CommentUpdate{Id: "IC_1", Body: utils.StringPtr("new")})
+	require.Equal(t, "new", pr.Enriched.Comments.Nodes[0].Body)
+
+	updateComment(&pr, tasks.CommentUpdate{Id: "PRR_1", IsMinimized: true})
+	require.True(t, pr.Enriched.Reviews.Nodes[0].IsMinimized)
+
+	updateComment(&pr, tasks.CommentUpdate{Id: "PRRC_1", IsDeleted: true})
+	require.Empty(t, pr.Enriched.ReviewThreads.Nodes[0].Comments.Nodes)
+
+	updateComment(&pr, tasks.CommentUpdate{Id: "IC_1", IsDeleted: true})
+	require.Empty(t, pr.Enriched.Comments.Nodes)
+	require.Zero(t, pr.Primary.Comments.TotalCount)
+}
@@ -0,0 +1,131 @@
+package prssection
+
+import (
+	"cmp"
+	"strings"
+
+	checks "github.com/dlvhdr/x/gh-checks"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prrow"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+)
+
+// sortColumns are the columns PRs can be sorted by, in the order the sort key cycles
+// through them.
+var sortColumns = []section.SortColumn[prrow.Data]{
+	{Name: "updatedAt", Desc: true, Compare: func(a, b prrow.Data) int {
+		return a.Primary.UpdatedAt.Compare(b.Primary.UpdatedAt)
+	}},
+	{Name: "createdAt", Desc: true, Compare: func(a, b prrow.Data) int {
+		return a.Primary.CreatedAt.Compare(b.Primary.CreatedAt)
+	}},
+	{Name: "repo", Compare: func(a, b prrow.Data) int {
+		return strings.Compare(a.GetRepoNameWithOwner(), b.GetRepoNameWithOwner())
+	}},
+	{Name: "author", Compare: func(a, b prrow.Data) int {
+		return strings.Compare(a.Primary.Author.Login, b.Primary.Author.Login)
+	}},
+	{Name: "title", Compare: func(a, b prrow.Data) int {
+		return strings.Compare(strings.ToLower(a.Primary.Title), strings.ToLower(b.Primary.Title))
+	}},
+	{Name: "base", Compare: func(a, b prrow.Data) int {
+		return strings.Compare(a.Primary.BaseRefName, b.Primary.BaseRefName)
+	}},
+	{Name: "state", Compare: func(a, b prrow.Data) int {
+		return cmp.Compare(stateRank(a), stateRank(b))
+	}},
+	{Name: "reviewStatus", Compare: func(a, b prrow.Data) int {
+		return cmp.Compare(reviewRank(a), reviewRank(b))
+	}},
+	{Name: "ci", Compare: func(a, b prrow.Data) int {
+		return cmp.Compare(ciRank(a), ciRank(b))
+	}},
+	{Name: "lines", Desc: true, Compare: func(a, b prrow.Data) int {
+		return cmp.Compare(
+			a.Primary.Additions+a.Primary.Deletions,
+			b.Primary.Additions+b.Primary.Deletions,
+		)
+	}},
+	{Name: "numComments", Desc: true, Compare: func(a, b prrow.Data) int {
+		return cmp.Compare(numComments(a), numComments(b))
+	}},
+}
+
+// groupColumns are the ways PRs can be grouped, in the order the group key cycles through
+// them.
+var groupColumns = []section.GroupColumn[prrow.Data]{
+	{Name: "repo", Group: func(pr prrow.Data) string { return pr.GetRepoNameWithOwner() }},
+	{Name: "author", Group: func(pr prrow.Data) string { return pr.Primary.Author.Login }},
+	{Name: "label", None: "No label", Group: func(pr prrow.Data) string {
+		if len(pr.Primary.Labels.Nodes) == 0 {
+			return ""
+		}
+		return pr.Primary.Labels.Nodes[0].Name
+	}},
+}
+
+// stateRank orders PRs from the ones still being worked on to the ones that are done.
+func stateRank(pr prrow.Data) int {
+	switch {
+	case pr.Primary.State == "OPEN" && pr.Primary.IsDraft:
+		return 0
+	case pr.Primary.State == "OPEN" && pr.Primary.IsInMergeQueue:
+		return 2
+	case pr.Primary.State == "OPEN":
+		return 1
+	case pr.Primary.State == "MERGED":
+		return 3
+	default:
+		return 4
+	}
+}
+
+// reviewRank orders PRs from the ones with changes requested to the approved ones.
+func reviewRank(pr prrow.Data) int {
+	switch {
+	case pr.Primary.ReviewDecision == "CHANGES_REQUESTED":
+		return 0
+	case pr.Primary.ReviewDecision == "APPROVED":
+		return 3
+	case pr.Primary.Reviews.TotalCount > 0:
+		return 2
+	default:
+		return 1
+	}
+}
+
+// ciRank orders PRs from failing checks to passing ones, with PRs without checks last.
+func ciRank(pr prrow.Data) int {
+	p := prrow.PullRequest{Data: &pr}
+	switch p.GetStatusChecksRollup() {
+	case checks.CommitStateError, checks.CommitStateFailure:
+		return 0
+	case checks.CommitStateExpected, checks.CommitStatePending:
+		return 1
+	case checks.CommitStateSuccess:
+		return 2
+	default:
+		return 3
+	}
+}
+
+func numComments(pr prrow.Data) int {
+	return pr.Primary.Comments.TotalCount + pr.Primary.ReviewThreads.TotalCount
+}
+
+func (m *Model) sortPrs() {
+	section.SortRows(&m.BaseModel, m.Prs, sortColumns, groupColumns, prKey)
+	m.Table.SetGroups(section.RowGroups(&m.BaseModel, m.Prs, groupColumns))
+}
+
+func prKey(pr prrow.Data) string {
+	return pr.Primary.Url
+}
+
+func prKeys(prs []prrow.Data) []string {
+	keys := make([]string, 0, len(prs))
+	for _, pr := range prs {
+		keys = append(keys, prKey(pr))
+	}
+	return keys
+}
@@ -1,90 +1,25 @@
 package prssection
 
 import (
-	"bytes"
-	"fmt"
-	"os/exec"
-
 	tea "charm.land/bubbletea/v2"
-	"charm.land/log/v2"
-	"github.com/gen2brain/beeep"
 
-	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prrow"
-	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
-	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
-	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/checkswatcher"
 )
 
+// watchChecks adds the current PR to the checks watch list, or removes it when it's already
+// watched.
 func (m *Model) watchChecks() tea.Cmd {
 	pr := m.GetCurrRow()
 	if pr == nil {
 		return nil
 	}
 
-	prNumber := pr.GetNumber()
-	title := pr.GetTitle()
-	repoNameWithOwner := pr.GetRepoNameWithOwner()
-	prData := pr.(*prrow.Data)
-	taskId := fmt.Sprintf("pr_reopen_%d", prNumber)
-	task := context.Task{
-		Id:           taskId,
-		StartText:    fmt.Sprintf("Watching checks for PR #%d", prNumber),
-		FinishedText: fmt.Sprintf("Watching checks for PR #%d", prNumber),
-		State:        context.TaskStart,
-		Error:        nil,
+	return func() tea.Msg {
+		return checkswatcher.ToggleWatchMsg{PR: checkswatcher.PR{
+			Url:               pr.GetUrl(),
+			Number:            pr.GetNumber(),
+			RepoNameWithOwner: pr.GetRepoNameWithOwner(),
+			Title:             pr.GetTitle(),
+		}}
 	}
-	startCmd := m.Ctx.StartTask(task)
-	return tea.Batch(startCmd, func() tea.Msg {
-		c := exec.Command(
-			"gh",
-			"pr",
-			"checks",
-			"--watch",
-			"--fail-fast",
-			fmt.Sprint(prNumber),
-			"-R",
-			repoNameWithOwner,
-		)
-
-		var outb, errb bytes.Buffer
-		c.Stdout = &outb
-		c.Stderr = &errb
-
-		err := c.Start()
-		go func() {
-			err := c.Wait()
-			if err != nil {
-				log.Error("Error waiting for watch command to finish", "err", err,
-					"stderr", errb.String(), "stdout", outb.String())
-			}
-
-			renderedPr := prrow.PullRequest{Ctx: m.Ctx, Data: prData}
-			checksRollup := " Checks are pending"
-			switch renderedPr.GetStatusChecksRollup() {
-			case "SUCCESS":
-				checksRollup = "✅ Checks have passed"
-			case "FAILURE":
-				checksRollup = "❌ Checks have failed"
-			}
-
-			err = beeep.Notify(
-				fmt.Sprintf("gh-dash: %s", title),
-				fmt.Sprintf("PR #%d in %s\n%s", prNumber, repoNameWithOwner, checksRollup),
-				"",
-			)
-			if err != nil {
-				log.Error("Error showing system notification", "err", err)
-			}
-		}()
-
-		return constants.TaskFinishedMsg{
-			SectionId:   m.Id,
-			SectionType: SectionType,
-			TaskId:      taskId,
-			Err:         err,
-			Msg: tasks.UpdatePRMsg{
-				PrNumber: prNumber,
-			},
-		}
-	})
 }
@@ -13,6 +13,7 @@ type PRActionType int
 const (
 	PRActionNone PRActionType = iota
 	PRActionApprove
+	PRActionReview
 	PRActionAssign
 	PRActionUnassign
 	PRActionLabel
@@ -26,6 +27,16 @@ const (
 	PRActionUpdate
 	PRActionSummaryViewMore
 	PRActionApproveWorkflows
+	PRActionRerunFailedWorkflows
+	PRActionRerunAllWorkflows
+	PRActionCancelWorkflows
+	PRActionEditProjectField
+	PRActionMilestone
+	PRActionEdit
+	PRActionRequestReviews
+	PRActionRemoveReviewRequests
+	PRActionReact
+	PRActionTimelineAction
 )
 
 // PRAction represents an action to be performed on a PR.
@@ -43,10 +54,16 @@ func MsgToAction(msg tea.Msg) *PRAction {
 	switch {
 	case key.Matches(keyMsg, keys.PRKeys.Approve):
 		return &PRAction{Type: PRActionApprove}
+	case key.Matches(keyMsg, keys.PRKeys.Review):
+		return &PRAction{Type: PRActionReview}
 	case key.Matches(keyMsg, keys.PRKeys.Assign):
 		return &PRAction{Type: PRActionAssign}
 	case key.Matches(keyMsg, keys.PRKeys.Unassign):
 		return &PRAction{Type: PRActionUnassign}
+	case key.Matches(keyMsg, keys.PRKeys.RequestReviews):
+		return &PRAction{Type: PRActionRequestReviews}
+	case key.Matches(keyMsg, keys.PRKeys.RemoveReviewRequests):
+		return &PRAction{Type: PRActionRemoveReviewRequests}
 	case key.Matches(keyMsg, keys.PRKeys.Label):
 		return &PRAction{Type: PRActionLabel}
 	case key.Matches(keyMsg, keys.PRKeys.Comment):
@@ -69,6 +86,22 @@ func MsgToAction(msg tea.Msg) *PRAction {
 		return &PRAction{Type: PRActionSummaryViewMore}
 	case key.Matches(keyMsg, keys.PRKeys.ApproveWorkflows):
 		return &PRAction{Type: PRActionApproveWorkflows}
+	case key.Matches(keyMsg, keys.PRKeys.RerunFailedWorkflows):
+		return &PRAction{Type: PRActionRerunFailedWorkflows}
+	case key.Matches(keyMsg, keys.PRKeys.RerunAllWorkflows):
+		return &PRAction{Type: PRActionRerunAllWorkflows}
+	case key.Matches(keyMsg, keys.PRKeys.CancelWorkflows):
+		return &PRAction{Type: PRActionCancelWorkflows}
+	case key.Matches(keyMsg, keys.PRKeys.EditProjectField):
+		return &PRAction{Type: PRActionEditProjectField}
+	case key.Matches(keyMsg, keys.PRKeys.Milestone):
+		return &PRAction{Type: PRActionMilestone}
+	case key.Matches(keyMsg, keys.PRKeys.Edit):
+		return &PRAction{Type: PRActionEdit}
+	case key.Matches(keyMsg, keys.PRKeys.React):
+		return &PRAction{Type: PRActionReact}
+	case key.Matches(keyMsg, keys.PRKeys.TimelineAction):
+		return &PRAction{Type: PRActionTimelineAction}
 	}
 
 	return nil
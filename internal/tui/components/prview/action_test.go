@@ -49,6 +49,7 @@ func TestMsgToActionReturnsCorrectActions(t *testing.T) {
 		expectedAction PRActionType
 	}{
 		{"approve key", 'v', PRActionApprove},
+		{"review key", 'f', PRActionReview},
 		{"assign key", 'a', PRActionAssign},
 		{"unassign key", 'A', PRActionUnassign},
 		{"comment key", 'c', PRActionComment},
@@ -92,6 +93,23 @@ func TestMsgToActionReturnsNilForUnknownKeys(t *testing.T) {
 	require.Nil(t, action, "expected nil action for unknown key")
 }
 
+func TestMsgToActionReturnsEditAction(t *testing.T) {
+	action := MsgToAction(tea.KeyPressMsg{Code: 'e', Mod: tea.ModCtrl})
+
+	require.NotNil(t, action)
+	require.Equal(t, PRActionEdit, action.Type)
+}
+
+func TestEditFetchesTheBodyOfUnenrichedPR(t *testing.T) {
+	m := newTestModelForAction(t)
+	m.pr.Data.IsEnriched = false
+
+	cmd, err := m.Edit()
+
+	require.NoError(t, err, "the title and body are fetched before opening the editor")
+	require.NotNil(t, cmd)
+}
+
 func TestIsTextInputBoxFocusedWhenCommenting(t *testing.T) {
 	m := newTestModelForAction(t)
 	cmd := m.SetIsCommenting(true)
@@ -2,117 +2,107 @@ package prview
 
 import (
 	"fmt"
-	"sort"
-	"time"
 
 	"charm.land/glamour/v2"
 	"charm.land/lipgloss/v2"
 
 	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/common"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/cmpcontroller"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/markdown"
 	"github.com/dlvhdr/gh-dash/v4/internal/utils"
 )
 
-type RenderedActivity struct {
-	UpdatedAt      time.Time
-	RenderedString string
-}
-
-func (m *Model) renderActivity() string {
-	width := m.getIndentedContentWidth()
+// renderActivity returns the rendered activity tab and the line the selected entry starts at.
+func (m *Model) renderActivity() (string, int) {
+	width := m.getIndentedContentWidth() - 2
 	markdownRenderer := markdown.GetMarkdownRenderer(width, m.ctx)
 	bodyStyle := lipgloss.NewStyle()
 
-	var activities []RenderedActivity
-	var comments []comment
-
 	if !m.pr.Data.IsEnriched {
-		return bodyStyle.Render("Loading...")
+		return bodyStyle.Render("Loading..."), 0
 	}
 
-	for _, review := range m.pr.Data.Enriched.ReviewThreads.Nodes {
-		path := review.Path
-		line := review.Line
-		for _, c := range review.Comments.Nodes {
-			comments = append(comments, comment{
-				Author:    c.Author.Login,
-				Body:      c.Body,
-				UpdatedAt: c.UpdatedAt,
-				Path:      &path,
-				Line:      &line,
-			})
+	entries := m.timelineEntries()
+	comments := 0
+	body := ""
+	selectedLine := 0
+	if len(entries) == 0 {
+		body = renderEmptyState()
+	} else {
+		type renderedEntry struct {
+			key      string
+			rendered string
+		}
+		var rendered []renderedEntry
+		for _, entry := range entries {
+			r, err := m.renderEntry(entry, markdownRenderer)
+			if err != nil {
+				continue
+			}
+			if entry.Event == nil {
+				comments++
+			}
+			rendered = append(rendered, renderedEntry{key: entry.Key(), rendered: r})
 		}
-	}
-
-	for _, c := range m.pr.Data.Enriched.Comments.Nodes {
-		comments = append(comments, comment{
-			Author:    c.Author.Login,
-			Body:      c.Body,
-			UpdatedAt: c.UpdatedAt,
-		})
-	}
 
-	for _, comment := range comments {
-		renderedComment, err := m.renderComment(comment, markdownRenderer)
-		if err != nil {
-			continue
+		title := m.ctx.Styles.Common.MainTextStyle.MarginBottom(1).Underline(true).Render(
+			fmt.Sprintf("%s  %d comments", constants.CommentsIcon, comments))
+		renderedActivities := []string{title}
+		for _, entry := range rendered {
+			prefix := " "
+			if entry.key != "" && entry.key == m.activity.entry {
+				selectedLine = lipgloss.Height(
+					lipgloss.JoinVertical(lipgloss.Left, renderedActivities...))
+				prefix = constants.SelectionIcon
+			}
+			renderedActivities = append(renderedActivities, lipgloss.JoinHorizontal(
+				lipgloss.Top, prefix, " ", entry.rendered))
 		}
-		activities = append(activities, RenderedActivity{
-			UpdatedAt:      comment.UpdatedAt,
-			RenderedString: renderedComment,
-		})
+		hint := fmt.Sprintf("Select an entry with %s/%s, press %s to react or %s to act on it",
+			keys.PRKeys.PrevDiffHunk.Help().Key,
+			keys.PRKeys.NextDiffHunk.Help().Key,
+			keys.PRKeys.React.Help().Key,
+			keys.PRKeys.TimelineAction.Help().Key,
+		)
+		renderedActivities = append(renderedActivities, "", m.ctx.Styles.Common.FaintTextStyle.
+			Italic(true).Width(m.getIndentedContentWidth()).Render(hint))
+		body = lipgloss.JoinVertical(lipgloss.Left, renderedActivities...)
 	}
 
-	for _, review := range m.pr.Data.Enriched.Reviews.Nodes {
-		renderedReview, err := m.renderReview(review, markdownRenderer)
-		if err != nil {
-			continue
-		}
-		activities = append(activities, RenderedActivity{
-			UpdatedAt:      review.UpdatedAt,
-			RenderedString: renderedReview,
-		})
+	if m.editor.Mode() != cmpcontroller.ModeNone {
+		body = lipgloss.JoinVertical(lipgloss.Left, body,
+			m.ctx.Styles.Sidebar.InputBox.Render(m.editor.View()))
 	}
 
-	sort.Slice(activities, func(i, j int) bool {
-		return activities[i].UpdatedAt.Before(activities[j].UpdatedAt)
-	})
+	return bodyStyle.Render(body), selectedLine
+}
 
-	body := ""
-	if len(activities) == 0 {
-		body = renderEmptyState()
-	} else {
-		var renderedActivities []string
-		for _, activity := range activities {
-			renderedActivities = append(renderedActivities, activity.RenderedString)
-		}
-		title := m.ctx.Styles.Common.MainTextStyle.MarginBottom(1).Underline(true).Render(
-			fmt.Sprintf("%s  %d comments", constants.CommentsIcon, len(activities)))
-		body = lipgloss.JoinVertical(lipgloss.Left, renderedActivities...)
-		body = lipgloss.JoinVertical(lipgloss.Left, title, body)
+func (m *Model) renderEntry(
+	entry common.TimelineEntry,
+	markdownRenderer glamour.TermRenderer,
+) (string, error) {
+	width := m.getIndentedContentWidth() - 2
+	switch {
+	case entry.Event != nil:
+		return common.RenderTimelineEvent(*entry.Event, m.ctx.Styles.Common, width) + "\n", nil
+	case entry.Typename == data.TimelineReview:
+		return m.renderReview(entry, markdownRenderer)
 	}
-
-	return bodyStyle.Render(body)
+	return m.renderComment(entry, markdownRenderer)
 }
 
 func renderEmptyState() string {
 	return lipgloss.NewStyle().Italic(true).Render("No comments...")
 }
 
-type comment struct {
-	Author    string
-	UpdatedAt time.Time
-	Body      string
-	Path      *string
-	Line      *int
-}
-
 func (m *Model) renderComment(
-	comment comment,
+	comment common.TimelineEntry,
 	markdownRenderer glamour.TermRenderer,
 ) (string, error) {
-	width := m.getIndentedContentWidth()
+	width := m.getIndentedContentWidth() - 2
 	authorAndTime := lipgloss.NewStyle().
 		Width(width).
 		BorderStyle(lipgloss.RoundedBorder()).
@@ -127,12 +117,12 @@ func (m *Model) renderComment(
 		))
 
 	var header string
-	if comment.Path != nil && comment.Line != nil {
+	if comment.Path != "" {
 		filePath := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText).Width(width).Render(
 			fmt.Sprintf(
 				"%s#l%d",
-				*comment.Path,
-				*comment.Line,
+				comment.Path,
+				comment.Line,
 			),
 		)
 		header = lipgloss.JoinVertical(lipgloss.Left, authorAndTime, filePath, "")
@@ -140,40 +130,55 @@ func (m *Model) renderComment(
 		header = authorAndTime
 	}
 
+	if comment.Meta.IsMinimized {
+		return lipgloss.JoinVertical(lipgloss.Left, header, renderHidden(m.ctx.Styles.Common)), nil
+	}
+
 	body := lineCleanupRegex.ReplaceAllString(comment.Body, "")
 	body, err := markdownRenderer.Render(body)
 
-	return lipgloss.JoinVertical(
-		lipgloss.Left,
-		header,
-		body,
-	), err
+	parts := []string{header, body}
+	if reactions := common.RenderReactions(comment.ReactionGroups,
+		m.ctx.Styles.Common); reactions != "" {
+		parts = append(parts, reactions, "")
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, parts...), err
 }
 
 func (m *Model) renderReview(
-	review data.Review,
+	review common.TimelineEntry,
 	markdownRenderer glamour.TermRenderer,
 ) (string, error) {
 	header := m.renderReviewHeader(review)
+	if review.Meta.IsMinimized {
+		return lipgloss.JoinVertical(lipgloss.Left, header, renderHidden(m.ctx.Styles.Common)), nil
+	}
+
 	body, err := markdownRenderer.Render(review.Body)
-	return lipgloss.JoinVertical(
-		lipgloss.Left,
-		header,
-		body,
-	), err
+	parts := []string{header, body}
+	if reactions := common.RenderReactions(review.ReactionGroups,
+		m.ctx.Styles.Common); reactions != "" {
+		parts = append(parts, reactions, "")
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, parts...), err
 }
 
-func (m *Model) renderReviewHeader(review data.Review) string {
+func (m *Model) renderReviewHeader(review common.TimelineEntry) string {
 	return lipgloss.JoinHorizontal(lipgloss.Top,
-		m.renderReviewDecision(review.State),
+		m.renderReviewDecision(review.ReviewState),
 		" ",
-		m.ctx.Styles.Common.MainTextStyle.Render(review.Author.Login),
+		m.ctx.Styles.Common.MainTextStyle.Render(review.Author),
 		" ",
 		lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText).Render(
 			"reviewed "+utils.TimeElapsed(review.UpdatedAt)),
 	)
 }
 
+// renderHidden stands in for the body of a minimized comment or review.
+func renderHidden(styles common.CommonStyles) string {
+	return styles.FaintTextStyle.Italic(true).Render("This was hidden.") + "\n"
+}
+
 func (m *Model) renderReviewDecision(decision string) string {
 	switch decision {
 	case "PENDING":
@@ -0,0 +1,81 @@
+package prview
+
+import (
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/common"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/cmpcontroller"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/fuzzyselect"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prssection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+)
+
+// bulkModes are the inputs that can apply to several PRs at once.
+var bulkModes = []cmpcontroller.Mode{
+	cmpcontroller.ModeComment,
+	cmpcontroller.ModeApprove,
+	cmpcontroller.ModeAssign,
+	cmpcontroller.ModeUnassign,
+	cmpcontroller.ModeLabel,
+	cmpcontroller.ModeMilestone,
+}
+
+// SetBulkRows makes the next comment, approval, assignment, labeling or milestone apply to all
+// of rows instead of only the previewed PR. A nil rows goes back to acting on the previewed PR.
+func (m *Model) SetBulkRows(rows []data.RowData) {
+	m.bulkRows = rows
+}
+
+func (m *Model) isBulk() bool {
+	return len(m.bulkRows) > 0
+}
+
+// prompt returns the prompt of an input, noting how many PRs it applies to when acting on
+// several of them.
+func (m *Model) prompt(prompt string) string {
+	if !m.isBulk() {
+		return prompt
+	}
+	return common.BulkPrompt(prompt, len(m.bulkRows), "PRs")
+}
+
+// submitBulk runs the action of mode on all bulk rows, reported as a single task.
+func (m *Model) submitBulk(mode cmpcontroller.Mode, value string) tea.Cmd {
+	rows := m.bulkRows
+	m.bulkRows = nil
+	sid := tasks.SectionIdentifier{Id: m.sectionId, Type: prssection.SectionType}
+
+	switch mode {
+	case cmpcontroller.ModeComment:
+		if len(strings.TrimSpace(value)) != 0 {
+			return tasks.CommentOnPRs(m.ctx, sid, rows, value)
+		}
+
+	case cmpcontroller.ModeApprove:
+		return tasks.ApprovePRs(m.ctx, sid, rows, strings.TrimSpace(value))
+
+	case cmpcontroller.ModeAssign:
+		if usernames := fuzzyselect.AllWords(value); len(usernames) > 0 {
+			return tasks.AssignPRs(m.ctx, sid, rows, usernames)
+		}
+
+	case cmpcontroller.ModeUnassign:
+		if usernames := fuzzyselect.AllWords(value); len(usernames) > 0 {
+			return tasks.UnassignPRs(m.ctx, sid, rows, usernames)
+		}
+
+	case cmpcontroller.ModeLabel:
+		if names := fuzzyselect.CurrentLabels(value); len(names) > 0 {
+			labels := common.LabelsWithColors(m.pr.Data.Primary.GetRepoNameWithOwner(), names)
+			return tasks.AddLabelsToPRs(m.ctx, sid, rows, labels)
+		}
+
+	case cmpcontroller.ModeMilestone:
+		milestone := common.MilestoneWithTitle(m.pr.Data.Primary.GetRepoNameWithOwner(), value)
+		return tasks.SetPRsMilestone(m.ctx, sid, rows, milestone)
+	}
+	return nil
+}
@@ -0,0 +1,353 @@
+package prview
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"charm.land/log/v2"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prssection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
+)
+
+const (
+	// maxLogLines is how many lines of a job log are shown, around its first error.
+	maxLogLines = 3000
+	// logContextLines is how many lines are shown above the first error of a log.
+	logContextLines = 5
+)
+
+var logTimestampRegex = regexp.MustCompile(`^\d{4}-\d\d-\d\dT\d\d:\d\d:\d\d(\.\d+)?Z ?`)
+
+type checksState struct {
+	// id is the database id of the selected check run
+	id  int64
+	log checkLogState
+}
+
+type checkLogState struct {
+	// checkRun is the check run whose log is shown, or nil when the log pane is closed
+	checkRun    *data.CheckRun
+	loading     bool
+	err         error
+	lines       []string
+	annotations []data.CheckAnnotation
+	// skipped is the number of lines of the log before the first shown line
+	skipped int
+	// errorLine is the index in lines of the first error, or -1
+	errorLine int
+}
+
+type CheckLogFetchedMsg struct {
+	JobId       int64
+	Log         string
+	Annotations []data.CheckAnnotation
+	Err         error
+}
+
+func (m Model) IsChecksTabSelected() bool {
+	return m.carousel.SelectedItem() == tabs[checksTabIndex]
+}
+
+// IsCheckLogOpen reports whether the Checks tab shows the log of a check run.
+func (m *Model) IsCheckLogOpen() bool {
+	return m.IsChecksTabSelected() && m.checks.log.checkRun != nil
+}
+
+// selectableCheckRuns returns the check runs of GitHub Actions jobs in the order they're
+// listed in the Checks tab. Other check runs have no log to show.
+func (m *Model) selectableCheckRuns() []data.CheckRun {
+	if !m.hasData() || len(m.pr.Data.Enriched.Commits.Nodes) == 0 {
+		return nil
+	}
+
+	byCategory := make(map[CheckCategory][]data.CheckRun)
+	nodes := m.pr.Data.Enriched.Commits.Nodes[0].Commit.StatusCheckRollup.Contexts.Nodes
+	for _, node := range nodes {
+		if node.Typename != "CheckRun" || node.CheckRun.CheckSuite.WorkflowRun.DatabaseId == 0 {
+			continue
+		}
+		category, _ := m.renderCheckRunConclusion(node.CheckRun)
+		byCategory[category] = append(byCategory[category], node.CheckRun)
+	}
+	return slices.Concat(byCategory[CheckFailure], byCategory[CheckWaiting],
+		byCategory[CheckSuccess])
+}
+
+// selectedCheckRun returns the check run whose log is shown, or else the selected one.
+func (m *Model) selectedCheckRun() *data.CheckRun {
+	if m.checks.log.checkRun != nil {
+		return m.checks.log.checkRun
+	}
+	if m.checks.id == 0 {
+		return nil
+	}
+	for _, checkRun := range m.selectableCheckRuns() {
+		if checkRun.DatabaseId == m.checks.id {
+			return &checkRun
+		}
+	}
+	return nil
+}
+
+func (m *Model) selectCheckRun(delta int) {
+	checkRuns := m.selectableCheckRuns()
+	if len(checkRuns) == 0 {
+		return
+	}
+
+	i := slices.IndexFunc(checkRuns, func(c data.CheckRun) bool {
+		return c.DatabaseId == m.checks.id
+	})
+	switch {
+	case i < 0 && delta > 0:
+		i = 0
+	case i < 0:
+		i = len(checkRuns) - 1
+	default:
+		i = max(0, min(len(checkRuns)-1, i+delta))
+	}
+	m.checks.id = checkRuns[i].DatabaseId
+}
+
+func (m *Model) updateChecks(msg tea.KeyMsg) tea.Cmd {
+	switch {
+	case key.Matches(msg, keys.PRKeys.PrevDiffHunk):
+		if m.checks.log.checkRun == nil {
+			m.selectCheckRun(-1)
+		}
+	case key.Matches(msg, keys.PRKeys.NextDiffHunk):
+		if m.checks.log.checkRun == nil {
+			m.selectCheckRun(1)
+		}
+	case key.Matches(msg, keys.PRKeys.ViewCheckLog):
+		if m.checks.log.checkRun != nil {
+			m.checks.log = checkLogState{}
+			return nil
+		}
+		return m.loadCheckLog()
+	case key.Matches(msg, keys.PRKeys.RerunFailedJobs):
+		if checkRun := m.selectedCheckRun(); checkRun != nil {
+			sid := tasks.SectionIdentifier{Id: m.sectionId, Type: prssection.SectionType}
+			return tasks.RerunFailedJobs(m.ctx, sid, m.pr.Data.Primary,
+				checkRun.CheckSuite.WorkflowRun.DatabaseId)
+		}
+	case key.Matches(msg, keys.PRKeys.CancelRun):
+		if checkRun := m.selectedCheckRun(); checkRun != nil {
+			sid := tasks.SectionIdentifier{Id: m.sectionId, Type: prssection.SectionType}
+			return tasks.CancelWorkflowRun(m.ctx, sid, m.pr.Data.Primary,
+				checkRun.CheckSuite.WorkflowRun.DatabaseId)
+		}
+	}
+	return nil
+}
+
+// loadCheckLog opens the log pane of the selected check run and fetches its log and
+// annotations.
+func (m *Model) loadCheckLog() tea.Cmd {
+	checkRun := m.selectedCheckRun()
+	if checkRun == nil {
+		return nil
+	}
+
+	m.checks.log = checkLogState{checkRun: checkRun, loading: true, errorLine: -1}
+	repo := m.pr.Data.Primary.GetRepoNameWithOwner()
+	jobId := checkRun.DatabaseId
+	return func() tea.Msg {
+		logs, err := data.FetchJobLogs(repo, jobId)
+		if err != nil {
+			return CheckLogFetchedMsg{JobId: jobId, Err: err}
+		}
+		annotations, err := data.FetchCheckRunAnnotations(repo, jobId)
+		if err != nil {
+			log.Error("failed fetching check run annotations", "jobId", jobId, "err", err)
+		}
+		return CheckLogFetchedMsg{JobId: jobId, Log: logs, Annotations: annotations}
+	}
+}
+
+// SetCheckLog stores a fetched log and reports whether it's the one the log pane is open for.
+func (m *Model) SetCheckLog(msg CheckLogFetchedMsg) bool {
+	if m.checks.log.checkRun == nil || m.checks.log.checkRun.DatabaseId != msg.JobId {
+		return false
+	}
+
+	m.checks.log.loading = false
+	m.checks.log.err = msg.Err
+	if msg.Err != nil {
+		return true
+	}
+
+	lines := parseJobLog(msg.Log)
+	errorLine := firstErrorLine(lines, msg.Annotations)
+	start, end := logWindow(len(lines), errorLine)
+	if errorLine >= 0 {
+		errorLine -= start
+	}
+	m.checks.log.lines = lines[start:end]
+	m.checks.log.skipped = start
+	m.checks.log.errorLine = errorLine
+	m.checks.log.annotations = msg.Annotations
+	return true
+}
+
+// parseJobLog splits a job log into lines, without the timestamps and colors GitHub adds.
+func parseJobLog(raw string) []string {
+	raw = strings.TrimPrefix(raw, "\ufeff")
+	lines := make([]string, 0)
+	for line := range strings.SplitSeq(strings.TrimRight(raw, "\r\n"), "\n") {
+		line = logTimestampRegex.ReplaceAllString(strings.TrimSuffix(line, "\r"), "")
+		line = ansi.Strip(line)
+		if strings.HasPrefix(line, "##[endgroup]") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// firstErrorLine returns the first line of a log that one of the failure annotations of the
+// job was reported on, falling back to the first line logged as an error. It returns -1 when
+// the log has no errors.
+func firstErrorLine(lines []string, annotations []data.CheckAnnotation) int {
+	messages := make([]string, 0)
+	for _, annotation := range annotations {
+		if annotation.AnnotationLevel != data.AnnotationLevelFailure {
+			continue
+		}
+		message, _, _ := strings.Cut(strings.TrimSpace(annotation.Message), "\n")
+		if message != "" {
+			messages = append(messages, message)
+		}
+	}
+
+	for i, line := range lines {
+		for _, message := range messages {
+			if strings.Contains(line, message) {
+				return i
+			}
+		}
+	}
+	return slices.IndexFunc(lines, func(line string) bool {
+		return strings.HasPrefix(line, "##[error]")
+	})
+}
+
+// logWindow returns the range of the lines of a log that are shown. Long logs are cut around
+// their first error, or to their last lines when they have none.
+func logWindow(total, errorLine int) (int, int) {
+	if total <= maxLogLines {
+		return 0, total
+	}
+	if errorLine < 0 {
+		return total - maxLogLines, total
+	}
+	start := max(0, min(errorLine-logContextLines, total-maxLogLines))
+	return start, start + maxLogLines
+}
+
+// renderCheckLog returns the rendered log pane and the line its first error is on.
+func (m *Model) renderCheckLog() (string, int) {
+	width := m.getIndentedContentWidth()
+	checkRun := *m.checks.log.checkRun
+	_, glyph := m.renderCheckRunConclusion(checkRun)
+	faint := m.ctx.Styles.Common.FaintTextStyle
+
+	parts := []string{
+		m.ctx.Styles.Common.MainTextStyle.MarginBottom(1).Underline(true).Render(" Check Log"),
+		lipgloss.JoinHorizontal(lipgloss.Top, glyph, " ", renderCheckRunName(checkRun)),
+		faint.Italic(true).Width(width).Render(fmt.Sprintf(
+			"Press %s to go back to the checks, %s to re-run the failed jobs or %s to cancel "+
+				"the run",
+			keys.PRKeys.ViewCheckLog.Help().Key,
+			keys.PRKeys.RerunFailedJobs.Help().Key,
+			keys.PRKeys.CancelRun.Help().Key,
+		)),
+		"",
+	}
+
+	switch {
+	case m.checks.log.loading:
+		parts = append(parts, lipgloss.JoinHorizontal(
+			lipgloss.Top,
+			m.ctx.Styles.Common.WaitingGlyph,
+			" ",
+			faint.Render("Loading..."),
+		))
+		return lipgloss.JoinVertical(lipgloss.Left, parts...), 0
+	case m.checks.log.err != nil:
+		parts = append(parts, lipgloss.NewStyle().
+			Foreground(m.ctx.Theme.ErrorText).
+			Width(width).
+			Render(fmt.Sprintf("Failed fetching log: %v", m.checks.log.err)))
+		return lipgloss.JoinVertical(lipgloss.Left, parts...), 0
+	}
+
+	for _, annotation := range m.checks.log.annotations {
+		if rendered := m.renderAnnotation(annotation, width); rendered != "" {
+			parts = append(parts, rendered)
+		}
+	}
+	if m.checks.log.skipped > 0 {
+		parts = append(parts, faint.Italic(true).Render(
+			fmt.Sprintf("%d earlier lines not shown", m.checks.log.skipped)))
+	}
+
+	selectedLine := 0
+	if m.checks.log.errorLine >= 0 {
+		selectedLine = lipgloss.Height(lipgloss.JoinVertical(lipgloss.Left, parts...)) +
+			max(0, m.checks.log.errorLine-logContextLines)
+	}
+
+	lines := make([]string, 0, len(m.checks.log.lines))
+	for i, line := range m.checks.log.lines {
+		lines = append(lines, m.renderLogLine(line, i == m.checks.log.errorLine, width))
+	}
+	parts = append(parts, strings.Join(lines, "\n"))
+	return lipgloss.JoinVertical(lipgloss.Left, parts...), selectedLine
+}
+
+func (m *Model) renderAnnotation(annotation data.CheckAnnotation, width int) string {
+	var style lipgloss.Style
+	switch annotation.AnnotationLevel {
+	case data.AnnotationLevelFailure:
+		style = lipgloss.NewStyle().Foreground(m.ctx.Theme.ErrorText)
+	case data.AnnotationLevelWarning:
+		style = lipgloss.NewStyle().Foreground(m.ctx.Theme.WarningText)
+	default:
+		return ""
+	}
+
+	message, _, _ := strings.Cut(strings.TrimSpace(annotation.Message), "\n")
+	if annotation.Path != "" && annotation.Path != ".github" {
+		message = fmt.Sprintf("%s:%d %s", annotation.Path, annotation.StartLine, message)
+	}
+	return style.Render(truncateDiffLine(message, width))
+}
+
+func (m *Model) renderLogLine(line string, isError bool, width int) string {
+	style := lipgloss.NewStyle().Foreground(m.ctx.Theme.SecondaryText)
+	switch {
+	case strings.HasPrefix(line, "##[group]"):
+		line = strings.TrimPrefix(line, "##[group]")
+		style = style.Foreground(m.ctx.Theme.PrimaryText).Bold(true)
+	case strings.HasPrefix(line, "##[error]"):
+		line = "Error: " + strings.TrimPrefix(line, "##[error]")
+		style = style.Foreground(m.ctx.Theme.ErrorText)
+	case strings.HasPrefix(line, "##[warning]"):
+		line = "Warning: " + strings.TrimPrefix(line, "##[warning]")
+		style = style.Foreground(m.ctx.Theme.WarningText)
+	}
+	if isError {
+		style = style.Foreground(m.ctx.Theme.ErrorText).Background(m.ctx.Theme.SelectedBackground)
+	}
+	return style.Render(truncateDiffLine(line, width))
+}
@@ -0,0 +1,141 @@
+package prview
+
+import (
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	checks "github.com/dlvhdr/x/gh-checks"
+)
+
+func makeWorkflowCheckRun(
+	id int64,
+	name string,
+	status string,
+	conclusion checks.CheckRunState,
+) data.CheckRun {
+	checkRun := makeCheckRun(name, status, conclusion)
+	checkRun.DatabaseId = id
+	checkRun.CheckSuite.WorkflowRun.DatabaseId = 100
+	checkRun.CheckSuite.WorkflowRun.Workflow.Name = "CI"
+	return checkRun
+}
+
+func newCheckLogTestModel(t *testing.T) Model {
+	t.Helper()
+	m := newTestModelForChecks(t, checksTestOptions{
+		checkRuns: []data.CheckRun{
+			makeWorkflowCheckRun(1, "lint", "COMPLETED", "SUCCESS"),
+			makeCheckRun("external", "COMPLETED", "SUCCESS"),
+			makeWorkflowCheckRun(2, "test", "COMPLETED", "FAILURE"),
+			makeWorkflowCheckRun(3, "build", "IN_PROGRESS", ""),
+		},
+		rollupState: "FAILURE",
+	})
+	m.carousel.SetCursor(checksTabIndex)
+	return m
+}
+
+func TestSelectCheckRun(t *testing.T) {
+	m := newCheckLogTestModel(t)
+
+	m.selectCheckRun(1)
+	require.Equal(t, int64(2), m.checks.id, "failures are listed first")
+	m.selectCheckRun(1)
+	require.Equal(t, int64(3), m.checks.id)
+	m.selectCheckRun(1)
+	require.Equal(t, int64(1), m.checks.id, "checks that aren't jobs can't be selected")
+	m.selectCheckRun(1)
+	require.Equal(t, int64(1), m.checks.id)
+
+	checkList, line := m.renderChecks()
+	lines := strings.Split(ansi.Strip(checkList), "\n")
+	require.Contains(t, lines[line], "CI/lint")
+	require.Contains(t, lines[line], "→")
+}
+
+func TestParseJobLog(t *testing.T) {
+	raw := "\ufeff" +
+		"2024-05-01T10:00:00.1234567Z ##[group]Run go test\r\n" +
+		"2024-05-01T10:00:01.0000000Z \x1b[36;1mgo test ./...\x1b[0m\r\n" +
+		"2024-05-01T10:00:02.0000000Z ##[endgroup]\r\n" +
+		"2024-05-01T10:00:03.0000000Z ##[error]Process completed with exit code 1.\r\n"
+
+	require.Equal(t, []string{
+		"##[group]Run go test",
+		"go test ./...",
+		"##[error]Process completed with exit code 1.",
+	}, parseJobLog(raw))
+}
+
+func TestFirstErrorLine(t *testing.T) {
+	lines := []string{
+		"Run go test",
+		"--- FAIL: TestParse",
+		"parse_test.go:12: expected 1, got 2",
+		"##[error]Process completed with exit code 1.",
+	}
+
+	annotations := []data.CheckAnnotation{
+		{
+			AnnotationLevel: data.AnnotationLevelFailure,
+			Message:         "Process completed with exit code 1.",
+		},
+		{AnnotationLevel: data.AnnotationLevelWarning, Message: "Run go test"},
+		{AnnotationLevel: data.AnnotationLevelFailure, Message: "expected 1, got 2\nmore details"},
+	}
+	require.Equal(t, 2, firstErrorLine(lines, annotations),
+		"the annotation reported first in the log is jumped to")
+	require.Equal(t, 3, firstErrorLine(lines, nil))
+	require.Equal(t, -1, firstErrorLine(lines[:3], nil))
+}
+
+func TestLogWindow(t *testing.T) {
+	start, end := logWindow(10, 4)
+	require.Equal(t, []int{0, 10}, []int{start, end})
+
+	start, end = logWindow(maxLogLines+100, -1)
+	require.Equal(t, []int{100, maxLogLines + 100}, []int{start, end})
+
+	start, end = logWindow(maxLogLines*2, 1000)
+	require.Equal(t, []int{1000 - logContextLines, 1000 - logContextLines + maxLogLines},
+		[]int{start, end})
+}
+
+func TestCheckLog(t *testing.T) {
+	m := newCheckLogTestModel(t)
+	m.selectCheckRun(1)
+
+	require.NotNil(t, m.loadCheckLog())
+	require.True(t, m.IsCheckLogOpen())
+	require.Contains(t, ansi.Strip(m.View()), "Loading...")
+
+	require.False(t, m.SetCheckLog(CheckLogFetchedMsg{JobId: 3}), "logs of other jobs are ignored")
+	require.True(t, m.SetCheckLog(CheckLogFetchedMsg{
+		JobId: 2,
+		Log:   "Run go test\nok\n##[error]Process completed with exit code 1.\n",
+		Annotations: []data.CheckAnnotation{{
+			Path:            ".github",
+			AnnotationLevel: data.AnnotationLevelFailure,
+			Message:         "Process completed with exit code 1.",
+		}},
+	}))
+	require.Equal(t, 2, m.checks.log.errorLine)
+
+	view := ansi.Strip(m.View())
+	require.Contains(t, view, "CI/test")
+	require.Contains(t, view, "Error: Process completed with exit code 1.")
+	require.NotContains(t, view, "All Checks", "the log replaces the list of checks")
+
+	checkLog, line := m.renderCheckLog()
+	lines := strings.Split(ansi.Strip(checkLog), "\n")
+	require.Equal(t, "Run go test", strings.TrimSpace(lines[line]))
+
+	m.updateChecks(tea.KeyPressMsg{Text: "E"})
+	require.False(t, m.IsCheckLogOpen())
+	require.Equal(t, int64(2), m.checks.id, "the selection is kept when the log is closed")
+}
@@ -3,11 +3,13 @@ package prview
 import (
 	"fmt"
 	"math"
+	"slices"
 	"strings"
 
 	"charm.land/lipgloss/v2"
 
 	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
 	ghchecks "github.com/dlvhdr/x/gh-checks"
 )
@@ -420,10 +422,15 @@ func renderStatusContextName(statusContext data.StatusContext) string {
 	)
 }
 
-func (sidebar *Model) renderChecks() string {
+// renderChecks returns the rendered list of checks and the line the selected check run is on.
+func (sidebar *Model) renderChecks() (string, int) {
+	titleText := " All Checks"
+	if sidebar.isWatchingChecks() {
+		titleText += " • watching"
+	}
 	title := sidebar.ctx.Styles.Common.MainTextStyle.MarginBottom(1).
 		Underline(true).
-		Render(" All Checks")
+		Render(titleText)
 
 	commits := sidebar.pr.Data.Enriched.Commits.Nodes
 	if len(commits) == 0 {
@@ -431,7 +438,7 @@ func (sidebar *Model) renderChecks() string {
 			lipgloss.Left,
 			title,
 			"Loading...",
-		)
+		), 0
 	}
 
 	failures := make([]string, 0)
@@ -475,6 +482,8 @@ func (sidebar *Model) renderChecks() string {
 
 	// Build a set of reported check names to compare against required checks
 	reportedChecks := make(map[string]bool)
+	selectable := len(sidebar.selectableCheckRuns()) > 0
+	selectedCheck := ""
 
 	for _, node := range lastCommit.Commit.StatusCheckRollup.Contexts.Nodes {
 		var category CheckCategory
@@ -488,6 +497,12 @@ func (sidebar *Model) renderChecks() string {
 			checkName = string(checkRun.Name)
 			name := renderCheckRunName(checkRun)
 			check = lipgloss.JoinHorizontal(lipgloss.Top, renderedStatus, " ", name)
+			if checkRun.DatabaseId != 0 && checkRun.DatabaseId == sidebar.checks.id {
+				check = lipgloss.JoinHorizontal(lipgloss.Top, constants.SelectionIcon, " ", check)
+				selectedCheck = check
+			} else if selectable {
+				check = lipgloss.JoinHorizontal(lipgloss.Top, "  ", check)
+			}
 		case "StatusContext":
 			statusContext := node.StatusContext
 			var status string
@@ -540,7 +555,7 @@ func (sidebar *Model) renderChecks() string {
 				PaddingLeft(2).
 				Width(sidebar.getIndentedContentWidth()).
 				Render("No checks to display..."),
-		)
+		), 0
 	}
 
 	parts := make([]string, 0)
@@ -571,12 +586,32 @@ func (sidebar *Model) renderChecks() string {
 	parts = append(parts, waiting...)
 	parts = append(parts, rest...)
 
-	return lipgloss.JoinVertical(
-		lipgloss.Left,
-		title,
-		lipgloss.NewStyle().PaddingLeft(2).Width(sidebar.getIndentedContentWidth()).Render(
-			lipgloss.JoinVertical(lipgloss.Left, parts...)),
-	)
+	list := lipgloss.NewStyle().PaddingLeft(2).Width(sidebar.getIndentedContentWidth())
+	selectedLine := 0
+	if i := slices.Index(parts, selectedCheck); selectedCheck != "" && i >= 0 {
+		selectedLine = lipgloss.Height(title)
+		if i > 0 {
+			selectedLine += lipgloss.Height(list.Render(lipgloss.JoinVertical(lipgloss.Left,
+				parts[:i]...)))
+		}
+	}
+
+	view := []string{title, list.Render(lipgloss.JoinVertical(lipgloss.Left, parts...))}
+	if selectable {
+		hint := fmt.Sprintf(
+			"Select a check with %s/%s, press %s to show its log, %s to re-run the failed jobs "+
+				"of its workflow or %s to cancel it",
+			keys.PRKeys.PrevDiffHunk.Help().Key,
+			keys.PRKeys.NextDiffHunk.Help().Key,
+			keys.PRKeys.ViewCheckLog.Help().Key,
+			keys.PRKeys.RerunFailedJobs.Help().Key,
+			keys.PRKeys.CancelRun.Help().Key,
+		)
+		view = append(view, "", sidebar.ctx.Styles.Common.FaintTextStyle.Italic(true).
+			Width(sidebar.getIndentedContentWidth()).Render(hint))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, view...), selectedLine
 }
 
 type checksStats struct {
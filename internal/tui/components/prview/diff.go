@@ -0,0 +1,411 @@
+package prview
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
+)
+
+type diffFile struct {
+	OldPath string
+	NewPath string
+	Binary  bool
+	Hunks   []diffHunk
+}
+
+// Path returns the path of the file after the change, or before it if the file was deleted.
+func (f diffFile) Path() string {
+	if f.NewPath == "" || f.NewPath == "/dev/null" {
+		return f.OldPath
+	}
+	return f.NewPath
+}
+
+type diffHunk struct {
+	Header string
+	Lines  []string
+	// Highlighted holds the syntax highlighted code of Lines, without their +/- marker. Lines
+	// that couldn't be highlighted are empty.
+	Highlighted []string
+}
+
+// parseDiff splits the output of `git diff` into files and hunks.
+func parseDiff(raw string) []diffFile {
+	files := make([]diffFile, 0)
+	var file *diffFile
+	var hunk *diffHunk
+
+	for line := range strings.SplitSeq(strings.TrimRight(raw, "\n"), "\n") {
+		line = strings.TrimSuffix(line, "\r")
+		switch {
+		case strings.HasPrefix(line, "diff --git "):
+			files = append(files, diffFile{})
+			file = &files[len(files)-1]
+			hunk = nil
+			paths := strings.TrimPrefix(line, "diff --git ")
+			if i := strings.LastIndex(paths, " b/"); i >= 0 {
+				file.OldPath = strings.TrimPrefix(paths[:i], "a/")
+				file.NewPath = paths[i+3:]
+			}
+		case file == nil:
+			continue
+		case strings.HasPrefix(line, "@@"):
+			file.Hunks = append(file.Hunks, diffHunk{Header: line})
+			hunk = &file.Hunks[len(file.Hunks)-1]
+		case hunk != nil:
+			hunk.Lines = append(hunk.Lines, line)
+		case strings.HasPrefix(line, "--- "):
+			file.OldPath = trimDiffPath(strings.TrimPrefix(line, "--- "), "a/")
+		case strings.HasPrefix(line, "+++ "):
+			file.NewPath = trimDiffPath(strings.TrimPrefix(line, "+++ "), "b/")
+		case strings.HasPrefix(line, "rename from "):
+			file.OldPath = strings.TrimPrefix(line, "rename from ")
+		case strings.HasPrefix(line, "rename to "):
+			file.NewPath = strings.TrimPrefix(line, "rename to ")
+		case strings.HasPrefix(line, "Binary files "):
+			file.Binary = true
+		}
+	}
+
+	return files
+}
+
+func trimDiffPath(path string, prefix string) string {
+	if path == "/dev/null" {
+		return path
+	}
+	return strings.TrimPrefix(path, prefix)
+}
+
+type diffState struct {
+	url     string
+	files   []diffFile
+	loading bool
+	err     error
+	// path is the selected file, shared by the Files Changed and Diff tabs
+	path string
+	hunk int
+}
+
+type DiffFetchedMsg struct {
+	Url  string
+	Diff string
+	Err  error
+}
+
+// LoadDiff fetches the diff of the current PR if the Diff tab is shown and it isn't loaded yet.
+func (m *Model) LoadDiff() tea.Cmd {
+	if !m.hasData() || !m.IsDiffTabSelected() {
+		return nil
+	}
+
+	url := m.pr.Data.Primary.Url
+	if m.diff.url == url {
+		return nil
+	}
+
+	m.diff = diffState{url: url, loading: true, path: m.diff.path}
+	repo := m.pr.Data.Primary.GetRepoNameWithOwner()
+	number := m.pr.Data.Primary.GetNumber()
+	return func() tea.Msg {
+		diff, err := data.FetchPullRequestDiff(repo, number)
+		return DiffFetchedMsg{Url: url, Diff: diff, Err: err}
+	}
+}
+
+// SetDiff stores a fetched diff and reports whether it belongs to the current PR.
+func (m *Model) SetDiff(msg DiffFetchedMsg) bool {
+	if m.diff.url != msg.Url {
+		return false
+	}
+
+	m.diff.loading = false
+	m.diff.err = msg.Err
+	if msg.Err == nil {
+		m.diff.files = parseDiff(msg.Diff)
+		highlightDiff(m.diff.files, m.ctx.HasDarkBackground)
+	}
+	return true
+}
+
+func (m *Model) GoToDiffTab() {
+	m.carousel.SetCursor(diffTabIndex)
+}
+
+func (m Model) IsDiffTabSelected() bool {
+	return m.carousel.SelectedItem() == tabs[diffTabIndex]
+}
+
+// IsSelectionKey reports whether msg moves the selected file, hunk or review thread.
+func IsSelectionKey(msg tea.KeyMsg) bool {
+	return key.Matches(msg, keys.PRKeys.PrevDiffFile, keys.PRKeys.NextDiffFile,
+		keys.PRKeys.PrevDiffHunk, keys.PRKeys.NextDiffHunk)
+}
+
+// IsSelectionTabSelected reports whether the selected tab has something for the selection keys
+// to move, so they aren't taken from the other tabs.
+func (m Model) IsSelectionTabSelected() bool {
+	return m.carousel.SelectedItem() == tabs[filesTabIndex] || m.IsDiffTabSelected() ||
+		m.IsThreadsTabSelected() || m.IsActivityTabSelected() || m.IsChecksTabSelected()
+}
+
+func (m *Model) updateSelection(msg tea.KeyMsg) {
+	switch {
+	case key.Matches(msg, keys.PRKeys.PrevDiffFile):
+		m.selectFile(-1)
+	case key.Matches(msg, keys.PRKeys.NextDiffFile):
+		m.selectFile(1)
+	case key.Matches(msg, keys.PRKeys.PrevDiffHunk):
+		m.selectHunk(-1)
+	case key.Matches(msg, keys.PRKeys.NextDiffHunk):
+		m.selectHunk(1)
+	}
+}
+
+func (m *Model) selectablePaths() []string {
+	paths := make([]string, 0)
+	if m.IsDiffTabSelected() {
+		for _, f := range m.diff.files {
+			paths = append(paths, f.Path())
+		}
+	} else if m.hasData() {
+		for _, f := range m.pr.Data.Enriched.Files.Nodes {
+			paths = append(paths, f.Path)
+		}
+	}
+	return paths
+}
+
+func (m *Model) selectFile(delta int) {
+	paths := m.selectablePaths()
+	if len(paths) == 0 {
+		return
+	}
+
+	i := slices.Index(paths, m.diff.path)
+	switch {
+	case i < 0 && delta > 0:
+		i = 0
+	case i < 0:
+		i = len(paths) - 1
+	default:
+		i = max(0, min(len(paths)-1, i+delta))
+	}
+	m.diff.path = paths[i]
+	m.diff.hunk = 0
+}
+
+func (m *Model) selectHunk(delta int) {
+	if !m.IsDiffTabSelected() {
+		return
+	}
+
+	type position struct {
+		file int
+		hunk int
+	}
+	positions := make([]position, 0)
+	curr := 0
+	for fi, f := range m.diff.files {
+		for hi := range max(1, len(f.Hunks)) {
+			if f.Path() == m.diff.path && hi == m.diff.hunk {
+				curr = len(positions)
+			}
+			positions = append(positions, position{file: fi, hunk: hi})
+		}
+	}
+	if len(positions) == 0 {
+		return
+	}
+
+	if m.diff.path != "" {
+		curr = max(0, min(len(positions)-1, curr+delta))
+	}
+	next := positions[curr]
+	m.diff.path = m.diff.files[next.file].Path()
+	m.diff.hunk = next.hunk
+}
+
+// SelectionOffset returns the line of the selected file, hunk, thread or check in the rendered
+// view, or of the first error of the check log that is shown.
+func (m *Model) SelectionOffset() int {
+	if !m.hasData() {
+		return 0
+	}
+
+	offset := lipgloss.Height(m.viewHeader())
+	switch {
+	case m.IsDiffTabSelected():
+		_, line := m.renderDiff()
+		offset += line
+	case m.IsThreadsTabSelected():
+		_, line := m.renderThreads()
+		offset += line
+	case m.IsActivityTabSelected() && m.activity.entry != "":
+		_, line := m.renderActivity()
+		offset += line
+	case m.IsCheckLogOpen():
+		_, line := m.renderCheckLog()
+		offset += line
+	case m.IsChecksTabSelected() && m.checks.id != 0:
+		_, line := m.renderChecks()
+		offset += lipgloss.Height(m.renderChecksOverview()) + 1 + line
+	case m.carousel.SelectedItem() == tabs[filesTabIndex] && m.diff.path != "":
+		for _, file := range m.pr.Data.Enriched.Files.Nodes {
+			if file.Path == m.diff.path {
+				break
+			}
+			offset += lipgloss.Height(m.renderFile(file))
+		}
+	}
+	return offset
+}
+
+// renderDiff returns the rendered diff and the line the selected hunk starts at.
+func (m *Model) renderDiff() (string, int) {
+	switch {
+	case m.diff.loading:
+		return lipgloss.JoinHorizontal(
+			lipgloss.Top,
+			m.ctx.Styles.Common.WaitingGlyph,
+			" ",
+			m.ctx.Styles.Common.FaintTextStyle.Render("Loading..."),
+		), 0
+	case m.diff.err != nil:
+		return lipgloss.NewStyle().
+			Foreground(m.ctx.Theme.ErrorText).
+			Width(m.getIndentedContentWidth()).
+			Render(fmt.Sprintf("Failed fetching diff: %v", m.diff.err)), 0
+	case len(m.diff.files) == 0:
+		return m.ctx.Styles.Common.FaintTextStyle.Italic(true).Render("No changes."), 0
+	}
+
+	width := m.getIndentedContentWidth()
+	faint := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText)
+	hunkHeader := lipgloss.NewStyle().Foreground(m.ctx.Theme.WarningText)
+	selectedHunkHeader := hunkHeader.Background(m.ctx.Theme.SelectedBackground)
+
+	lines := make([]string, 0)
+	selectedLine := 0
+	for i, file := range m.diff.files {
+		if i > 0 {
+			lines = append(lines, "")
+		}
+
+		isSelectedFile := file.Path() == m.diff.path
+		if isSelectedFile && len(file.Hunks) == 0 {
+			selectedLine = len(lines)
+		}
+		lines = append(lines, m.renderDiffFileHeader(file, isSelectedFile))
+
+		if file.Binary {
+			lines = append(lines, faint.Italic(true).Render("Binary file not shown."))
+			continue
+		}
+		if len(file.Hunks) == 0 {
+			lines = append(lines, faint.Italic(true).Render("No content changes."))
+			continue
+		}
+
+		for hi, hunk := range file.Hunks {
+			style := hunkHeader
+			if isSelectedFile && hi == m.diff.hunk {
+				style = selectedHunkHeader
+				selectedLine = len(lines)
+				if hi == 0 {
+					selectedLine--
+				}
+			}
+			lines = append(lines, style.Render(truncateDiffLine(hunk.Header, width)))
+
+			for li, line := range hunk.Lines {
+				highlighted := ""
+				if li < len(hunk.Highlighted) {
+					highlighted = hunk.Highlighted[li]
+				}
+				lines = append(lines, m.renderDiffLine(line, highlighted, width))
+			}
+		}
+	}
+
+	return strings.Join(lines, "\n"), selectedLine
+}
+
+func (m *Model) renderDiffFileHeader(file diffFile, isSelected bool) string {
+	additions, deletions := 0, 0
+	for _, hunk := range file.Hunks {
+		for _, line := range hunk.Lines {
+			switch {
+			case strings.HasPrefix(line, "+"):
+				additions++
+			case strings.HasPrefix(line, "-"):
+				deletions++
+			}
+		}
+	}
+
+	changeType := "MODIFIED"
+	switch {
+	case file.OldPath == "/dev/null":
+		changeType = "ADDED"
+	case file.NewPath == "/dev/null":
+		changeType = "DELETED"
+	case file.OldPath != file.NewPath:
+		changeType = "RENAMED"
+	}
+
+	prefix := " "
+	pathStyle := lipgloss.NewStyle().Bold(true).Foreground(m.ctx.Theme.SecondaryText)
+	if isSelected {
+		prefix = constants.SelectionIcon
+		pathStyle = pathStyle.Foreground(m.ctx.Theme.PrimaryText)
+	}
+
+	stats := lipgloss.JoinHorizontal(
+		lipgloss.Top,
+		" ",
+		lipgloss.NewStyle().Foreground(m.ctx.Theme.SuccessText).Render(fmt.Sprintf("+%d", additions)),
+		" ",
+		lipgloss.NewStyle().Foreground(m.ctx.Theme.ErrorText).Render(fmt.Sprintf("-%d", deletions)),
+	)
+	start := prefix + " " + m.renderChangeTypeIcon(changeType) + " "
+	remaining := m.getIndentedContentWidth() - lipgloss.Width(start) - lipgloss.Width(stats)
+
+	return lipgloss.JoinHorizontal(
+		lipgloss.Top,
+		start,
+		pathStyle.Render(ansi.Truncate(file.Path(), max(0, remaining), "…")),
+		stats,
+	)
+}
+
+// renderDiffLine colors a single line of a hunk by whether it was added, removed or kept. The
+// code of the line is shown highlighted when there is a highlighted version of it.
+func (m *Model) renderDiffLine(line string, highlighted string, width int) string {
+	style := lipgloss.NewStyle().Foreground(m.ctx.Theme.SecondaryText)
+	switch {
+	case strings.HasPrefix(line, "+"):
+		style = style.Foreground(m.ctx.Theme.SuccessText)
+	case strings.HasPrefix(line, "-"):
+		style = style.Foreground(m.ctx.Theme.ErrorText)
+	case strings.HasPrefix(line, `\`):
+		style = style.Foreground(m.ctx.Theme.FaintText).Italic(true)
+	}
+	if highlighted == "" {
+		return style.Render(truncateDiffLine(line, width))
+	}
+	return ansi.Truncate(style.Render(line[:1])+highlighted, width, "…")
+}
+
+func truncateDiffLine(line string, width int) string {
+	return ansi.Truncate(strings.ReplaceAll(line, "\t", "    "), width, "…")
+}
@@ -0,0 +1,162 @@
+package prview
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+const testDiff = `diff --git a/main.go b/main.go
+index 1111111..2222222 100644
+--- a/main.go
++++ b/main.go
+@@ -1,4 +1,4 @@ package main
+ package main
+-import "fmt"
++import "log"
+
+@@ -10,3 +10,4 @@ func main() {
+ 	run()
++	log.Print("done")
+ }
+diff --git a/old.txt b/new.txt
+similarity index 100%
+rename from old.txt
+rename to new.txt
+diff --git a/gone.md b/gone.md
+deleted file mode 100644
+--- a/gone.md
++++ /dev/null
+@@ -1 +0,0 @@
+-bye
+\ No newline at end of file
+diff --git a/logo.png b/logo.png
+new file mode 100644
+Binary files /dev/null and b/logo.png differ
+`
+
+func TestParseDiff(t *testing.T) {
+	files := parseDiff(testDiff)
+	require.Len(t, files, 4)
+
+	require.Equal(t, "main.go", files[0].Path())
+	require.Len(t, files[0].Hunks, 2)
+	require.Equal(t, "@@ -1,4 +1,4 @@ package main", files[0].Hunks[0].Header)
+	require.Equal(t, []string{" package main", `-import "fmt"`, `+import "log"`, ""},
+		files[0].Hunks[0].Lines)
+
+	require.Equal(t, "old.txt", files[1].OldPath)
+	require.Equal(t, "new.txt", files[1].Path())
+	require.Empty(t, files[1].Hunks)
+
+	require.Equal(t, "gone.md", files[2].Path())
+	require.Equal(t, "/dev/null", files[2].NewPath)
+	require.Equal(t, `\ No newline at end of file`, files[2].Hunks[0].Lines[1])
+
+	require.Equal(t, "logo.png", files[3].Path())
+	require.True(t, files[3].Binary)
+}
+
+func newDiffTestModel(t *testing.T) Model {
+	t.Helper()
+	pr := &data.PullRequestData{Number: 1, Url: "https://github.com/o/r/pull/1"}
+	m := newTestModelWithWidth(t, pr, nil, nil, 80)
+	m.pr.Data.Enriched.Files.Nodes = []data.ChangedFile{
+		{Path: "main.go"},
+		{Path: "new.txt"},
+		{Path: "gone.md"},
+	}
+	return m
+}
+
+func TestDiffSelection(t *testing.T) {
+	t.Run("files tab selects changed files", func(t *testing.T) {
+		m := newDiffTestModel(t)
+		m.carousel.SetCursor(filesTabIndex)
+
+		m.selectFile(1)
+		require.Equal(t, "main.go", m.diff.path)
+		m.selectFile(1)
+		require.Equal(t, "new.txt", m.diff.path)
+		m.selectFile(-5)
+		require.Equal(t, "main.go", m.diff.path)
+	})
+
+	t.Run("hunk navigation crosses files", func(t *testing.T) {
+		m := newDiffTestModel(t)
+		m.GoToDiffTab()
+		m.diff = diffState{url: m.pr.Data.Primary.Url, files: parseDiff(testDiff)}
+
+		m.selectHunk(1)
+		require.Equal(t, "main.go", m.diff.path)
+		require.Equal(t, 0, m.diff.hunk)
+		m.selectHunk(1)
+		require.Equal(t, 1, m.diff.hunk)
+		m.selectHunk(1)
+		require.Equal(t, "new.txt", m.diff.path)
+		require.Equal(t, 0, m.diff.hunk)
+		m.selectHunk(-1)
+		require.Equal(t, "main.go", m.diff.path)
+		require.Equal(t, 1, m.diff.hunk)
+	})
+
+	t.Run("selection offset points at the selected hunk", func(t *testing.T) {
+		m := newDiffTestModel(t)
+		m.GoToDiffTab()
+		m.diff = diffState{
+			url:   m.pr.Data.Primary.Url,
+			files: parseDiff(testDiff),
+			path:  "main.go",
+			hunk:  1,
+		}
+
+		lines := strings.Split(ansi.Strip(m.View()), "\n")
+		offset := m.SelectionOffset()
+		require.Less(t, offset, len(lines))
+		require.Contains(t, lines[offset], "@@ -10,3 +10,4 @@")
+	})
+
+	t.Run("only tabs with a selection take the selection keys", func(t *testing.T) {
+		m := newDiffTestModel(t)
+		require.False(t, m.IsSelectionTabSelected(), "the overview has nothing to select")
+		m.carousel.SetCursor(filesTabIndex)
+		require.True(t, m.IsSelectionTabSelected())
+		m.GoToDiffTab()
+		require.True(t, m.IsSelectionTabSelected())
+	})
+}
+
+func TestSetDiffIgnoresOtherPRs(t *testing.T) {
+	m := newDiffTestModel(t)
+	m.GoToDiffTab()
+	cmd := m.LoadDiff()
+	require.NotNil(t, cmd)
+	require.True(t, m.diff.loading)
+	require.Nil(t, m.LoadDiff(), "diff should only be fetched once")
+
+	require.False(t, m.SetDiff(DiffFetchedMsg{Url: "https://github.com/o/r/pull/2"}))
+	require.True(t, m.diff.loading)
+
+	require.True(t, m.SetDiff(DiffFetchedMsg{Url: m.pr.Data.Primary.Url, Diff: testDiff}))
+	require.False(t, m.diff.loading)
+	require.Len(t, m.diff.files, 4)
+}
+
+func TestHighlightDiff(t *testing.T) {
+	files := parseDiff(testDiff)
+	highlightDiff(files, true)
+
+	lines := files[0].Hunks[0].Highlighted
+	require.Len(t, lines, len(files[0].Hunks[0].Lines))
+	require.Equal(t, `import "log"`, ansi.Strip(lines[2]), "the +/- marker is left out")
+	require.NotEqual(t, ansi.Strip(lines[2]), lines[2], "the code is colored")
+	require.Empty(t, lines[3], "empty lines aren't highlighted")
+
+	require.Nil(t, files[3].Hunks, "binary files have nothing to highlight")
+	require.Nil(t, highlightDiffLines("LICENSE", []string{"+text"}, true),
+		"files without a lexer are only colored by their marker")
+}
@@ -0,0 +1,20 @@
+package prview
+
+import (
+	"errors"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prssection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+)
+
+// Edit opens the title and body of the PR in the editor.
+func (m *Model) Edit() (tea.Cmd, error) {
+	if m.pr == nil || m.pr.Data == nil || m.pr.Data.Primary == nil {
+		return nil, errors.New("no PR selected")
+	}
+
+	sid := tasks.SectionIdentifier{Id: m.sectionId, Type: prssection.SectionType}
+	return tasks.EditPR(m.ctx, sid, m.pr.Data.Primary), nil
+}
@@ -6,6 +6,7 @@ import (
 	"charm.land/lipgloss/v2"
 
 	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
 	"github.com/dlvhdr/gh-dash/v4/internal/utils"
 )
 
@@ -57,6 +58,15 @@ func (m *Model) renderChangedFiles() string {
 		files = append(files, m.renderFile(file))
 	}
 
+	if len(files) > 0 && m.ctx.Config.IsInlineDiff() {
+		files = append(files, "", m.ctx.Styles.Common.FaintTextStyle.Italic(true).Render(
+			fmt.Sprintf("Select a file with %s/%s and press %s to view its diff",
+				keys.PRKeys.PrevDiffFile.Help().Key,
+				keys.PRKeys.NextDiffFile.Help().Key,
+				keys.PRKeys.Diff.Help().Key,
+			)))
+	}
+
 	return lipgloss.JoinVertical(lipgloss.Left, files...)
 }
 
@@ -82,6 +92,13 @@ func (m *Model) renderFile(file data.ChangedFile) string {
 	if len(path) > remaining {
 		path = lipgloss.JoinVertical(lipgloss.Left, path[0:remaining], " "+path[remaining:])
 	}
+	if file.Path == m.diff.path {
+		path = lipgloss.NewStyle().
+			Bold(true).
+			Foreground(m.ctx.Theme.PrimaryText).
+			Background(m.ctx.Theme.SelectedBackground).
+			Render(path)
+	}
 
 	return lipgloss.JoinHorizontal(
 		lipgloss.Top,
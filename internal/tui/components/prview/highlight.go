@@ -0,0 +1,76 @@
+package prview
+
+import (
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"github.com/alecthomas/chroma/v2"
+	"github.com/alecthomas/chroma/v2/lexers"
+	"github.com/alecthomas/chroma/v2/styles"
+)
+
+// highlightDiff highlights the code of the hunk lines by the language of their file.
+func highlightDiff(files []diffFile, hasDarkBackground bool) {
+	for fi := range files {
+		file := &files[fi]
+		if file.Binary {
+			continue
+		}
+		for hi := range file.Hunks {
+			hunk := &file.Hunks[hi]
+			hunk.Highlighted = highlightDiffLines(file.Path(), hunk.Lines, hasDarkBackground)
+		}
+	}
+}
+
+// highlightDiffLines highlights the code of diff lines of the file at path, without their +/-
+// marker. Lines that can't be highlighted are left empty, and nil is returned for files chroma
+// has no lexer for, which are only colored by whether lines were added or removed.
+func highlightDiffLines(path string, lines []string, hasDarkBackground bool) []string {
+	lexer := lexers.Match(path)
+	if lexer == nil {
+		return nil
+	}
+	lexer = chroma.Coalesce(lexer)
+	style := styles.Get("github")
+	if hasDarkBackground {
+		style = styles.Get("github-dark")
+	}
+
+	highlighted := make([]string, len(lines))
+	for i, line := range lines {
+		if line == "" || strings.HasPrefix(line, `\`) {
+			continue
+		}
+		highlighted[i] = highlightCode(lexer, style, line[1:])
+	}
+	return highlighted
+}
+
+// highlightCode renders a line of code with the colors the style gives its tokens.
+func highlightCode(lexer chroma.Lexer, style *chroma.Style, code string) string {
+	code = strings.ReplaceAll(code, "\t", "    ")
+	iterator, err := lexer.Tokenise(nil, code)
+	if err != nil {
+		return ""
+	}
+
+	var b strings.Builder
+	for _, token := range iterator.Tokens() {
+		value := strings.TrimRight(token.Value, "\n")
+		entry := style.Get(token.Type)
+		if !entry.Colour.IsSet() {
+			b.WriteString(value)
+			continue
+		}
+		s := lipgloss.NewStyle().Foreground(lipgloss.Color(entry.Colour.String()))
+		if entry.Bold == chroma.Yes {
+			s = s.Bold(true)
+		}
+		if entry.Italic == chroma.Yes {
+			s = s.Italic(true)
+		}
+		b.WriteString(s.Render(value))
+	}
+	return b.String()
+}
@@ -0,0 +1,343 @@
+package prview
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/common"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/cmpcontroller"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prssection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+)
+
+type mergeAction int
+
+const (
+	mergeActionMerge mergeAction = iota
+	mergeActionEnableAuto
+	mergeActionDisableAuto
+	mergeActionEnqueue
+	mergeActionDequeue
+)
+
+func (a mergeAction) String() string {
+	switch a {
+	case mergeActionEnableAuto:
+		return "Enable auto-merge"
+	case mergeActionDisableAuto:
+		return "Disable auto-merge"
+	case mergeActionEnqueue:
+		return "Add to merge queue"
+	case mergeActionDequeue:
+		return "Remove from merge queue"
+	default:
+		return "Merge now"
+	}
+}
+
+type mergeField int
+
+const (
+	mergeFieldAction mergeField = iota
+	mergeFieldMethod
+	mergeFieldSubject
+	mergeFieldBody
+	mergeFieldDeleteBranch
+)
+
+var mergeMethods = []string{"merge", "squash", "rebase"}
+
+type mergeState struct {
+	open         bool
+	field        mergeField
+	action       mergeAction
+	method       string
+	subject      string
+	body         string
+	deleteBranch bool
+}
+
+type mergeKeyMap struct {
+	Up      key.Binding
+	Down    key.Binding
+	Prev    key.Binding
+	Next    key.Binding
+	Confirm key.Binding
+	Cancel  key.Binding
+}
+
+// mergeKeys are only active while the merge dialog is open.
+var mergeKeys = mergeKeyMap{
+	Up: key.NewBinding(
+		key.WithKeys("up", "k"),
+		key.WithHelp("↑/k", "up"),
+	),
+	Down: key.NewBinding(
+		key.WithKeys("down", "j"),
+		key.WithHelp("↓/j", "down"),
+	),
+	Prev: key.NewBinding(
+		key.WithKeys("left", "h"),
+		key.WithHelp("←/h", "previous option"),
+	),
+	Next: key.NewBinding(
+		key.WithKeys("right", "l", "space"),
+		key.WithHelp("←/→", "change option"),
+	),
+	Confirm: key.NewBinding(
+		key.WithKeys("enter"),
+		key.WithHelp("enter", "edit/confirm"),
+	),
+	Cancel: key.NewBinding(
+		key.WithKeys("esc", "q"),
+		key.WithHelp("esc", "cancel"),
+	),
+}
+
+func (m *Model) IsMergeDialogOpen() bool {
+	return m.merge.open
+}
+
+// SetIsMerging opens or closes the merge dialog, which starts out with the merge strategy
+// configured for the repo.
+func (m *Model) SetIsMerging(isMerging bool) tea.Cmd {
+	if m.pr == nil {
+		return nil
+	}
+
+	if !isMerging {
+		if m.editor.Mode() == cmpcontroller.ModeMergeMessage {
+			m.editor.Exit()
+		}
+		m.merge = mergeState{}
+		return nil
+	}
+
+	m.merge = mergeState{
+		open: true,
+		method: common.GetRepoMergeStrategy(
+			m.pr.Data.Primary.GetRepoNameWithOwner(), m.ctx.Config.MergeStrategies),
+	}
+	m.merge.action = m.mergeActions()[0]
+	return nil
+}
+
+// mergeActions returns what can be done with the PR given its auto-merge and merge queue state.
+func (m *Model) mergeActions() []mergeAction {
+	if !m.pr.Data.IsEnriched {
+		return []mergeAction{mergeActionMerge, mergeActionEnableAuto}
+	}
+
+	enriched := m.pr.Data.Enriched
+	switch {
+	case enriched.IsInMergeQueue:
+		return []mergeAction{mergeActionDequeue}
+	case enriched.IsMergeQueueEnabled:
+		return []mergeAction{mergeActionEnqueue}
+	case enriched.AutoMergeRequest != nil:
+		return []mergeAction{mergeActionDisableAuto, mergeActionMerge}
+	default:
+		return []mergeAction{mergeActionMerge, mergeActionEnableAuto}
+	}
+}
+
+// mergeFields returns the fields of the merge dialog that apply to the selected action.
+func (m *Model) mergeFields() []mergeField {
+	if m.merge.action != mergeActionMerge && m.merge.action != mergeActionEnableAuto {
+		return []mergeField{mergeFieldAction}
+	}
+	if m.merge.method == "rebase" {
+		return []mergeField{mergeFieldAction, mergeFieldMethod, mergeFieldDeleteBranch}
+	}
+	return []mergeField{
+		mergeFieldAction,
+		mergeFieldMethod,
+		mergeFieldSubject,
+		mergeFieldBody,
+		mergeFieldDeleteBranch,
+	}
+}
+
+func (m *Model) updateMerge(msg tea.KeyMsg) tea.Cmd {
+	fields := m.mergeFields()
+	i := max(0, slices.Index(fields, m.merge.field))
+
+	switch {
+	case key.Matches(msg, mergeKeys.Cancel):
+		m.merge = mergeState{}
+	case key.Matches(msg, mergeKeys.Up):
+		m.merge.field = fields[max(0, i-1)]
+	case key.Matches(msg, mergeKeys.Down):
+		m.merge.field = fields[min(len(fields)-1, i+1)]
+	case key.Matches(msg, mergeKeys.Prev):
+		m.changeMergeOption(-1)
+	case key.Matches(msg, mergeKeys.Next):
+		m.changeMergeOption(1)
+	case key.Matches(msg, mergeKeys.Confirm):
+		switch m.merge.field {
+		case mergeFieldSubject, mergeFieldBody:
+			return m.editMergeMessage()
+		case mergeFieldDeleteBranch:
+			m.merge.deleteBranch = !m.merge.deleteBranch
+		default:
+			return m.submitMerge()
+		}
+	}
+	return nil
+}
+
+func (m *Model) changeMergeOption(delta int) {
+	cycle := func(n, i int) int {
+		return ((i+delta)%n + n) % n
+	}
+
+	switch m.merge.field {
+	case mergeFieldAction:
+		actions := m.mergeActions()
+		i := slices.Index(actions, m.merge.action)
+		m.merge.action = actions[cycle(len(actions), i)]
+	case mergeFieldMethod:
+		i := slices.Index(mergeMethods, m.merge.method)
+		m.merge.method = mergeMethods[cycle(len(mergeMethods), i)]
+	case mergeFieldDeleteBranch:
+		m.merge.deleteBranch = !m.merge.deleteBranch
+	}
+}
+
+func (m *Model) editMergeMessage() tea.Cmd {
+	opts := cmpcontroller.EnterOptions{
+		Mode:         cmpcontroller.ModeMergeMessage,
+		Prompt:       constants.SubjectPrompt,
+		InitialValue: m.mergeSubject(),
+	}
+	if m.merge.field == mergeFieldBody {
+		opts.Prompt = constants.MessagePrompt
+		opts.InitialValue = m.merge.body
+	}
+	return m.editor.Enter(opts)
+}
+
+func (m *Model) setMergeMessage(value string) {
+	if m.merge.field == mergeFieldBody {
+		m.merge.body = strings.TrimSpace(value)
+		return
+	}
+	subject, _, _ := strings.Cut(strings.TrimSpace(value), "\n")
+	m.merge.subject = subject
+}
+
+// mergeSubject returns the commit title, falling back to the one GitHub uses for squashes.
+func (m *Model) mergeSubject() string {
+	if m.merge.subject != "" || m.merge.method != "squash" {
+		return m.merge.subject
+	}
+	return fmt.Sprintf("%s (#%d)", m.pr.Data.Primary.Title, m.pr.Data.Primary.Number)
+}
+
+func (m *Model) submitMerge() tea.Cmd {
+	state := m.merge
+	m.merge = mergeState{}
+
+	sid := tasks.SectionIdentifier{Id: m.sectionId, Type: prssection.SectionType}
+	pr := m.pr.Data.Primary
+	switch state.action {
+	case mergeActionDisableAuto:
+		return tasks.DisableAutoMerge(m.ctx, sid, pr)
+	case mergeActionEnqueue, mergeActionDequeue:
+		return tasks.UpdateMergeQueue(m.ctx, sid, pr, m.pr.Data.Enriched.Id,
+			state.action == mergeActionEnqueue)
+	default:
+		return tasks.MergePR(m.ctx, sid, pr, tasks.MergeOptions{
+			Method:       state.method,
+			Subject:      state.subject,
+			Body:         state.body,
+			DeleteBranch: state.deleteBranch,
+			Auto:         state.action == mergeActionEnableAuto,
+		})
+	}
+}
+
+func (m *Model) renderMergeDialog() string {
+	selected := lipgloss.NewStyle().Bold(true).Foreground(m.ctx.Theme.PrimaryText)
+	faint := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText)
+	label := lipgloss.NewStyle().Width(16).Foreground(m.ctx.Theme.SecondaryText)
+
+	rows := []string{
+		m.ctx.Styles.Common.MainTextStyle.Render(
+			fmt.Sprintf("Merge PR #%d", m.pr.Data.Primary.Number)),
+	}
+	for _, field := range m.mergeFields() {
+		var name, value string
+		switch field {
+		case mergeFieldAction:
+			name, value = "Action", m.renderMergeOption(m.merge.action.String(), field)
+		case mergeFieldMethod:
+			name, value = "Method", m.renderMergeOption(m.merge.method, field)
+		case mergeFieldSubject:
+			name, value = "Commit title", m.mergeSubject()
+			if value == "" {
+				value = faint.Render("default")
+			}
+		case mergeFieldBody:
+			name, value = "Commit message", strings.ReplaceAll(m.merge.body, "\n", " ")
+			if value == "" {
+				value = faint.Render("default")
+			}
+		case mergeFieldDeleteBranch:
+			name, value = "Delete branch", "[ ]"
+			if m.merge.deleteBranch {
+				name, value = "Delete branch", "[x]"
+			}
+		}
+
+		prefix := "  "
+		if field == m.merge.field {
+			prefix = constants.SelectionIcon + " "
+			name = selected.Render(name)
+		}
+		row := lipgloss.JoinHorizontal(lipgloss.Top, prefix, label.Render(name), value)
+		rows = append(rows, ansi.Truncate(row, m.getIndentedContentWidth()-4, constants.Ellipsis))
+	}
+
+	if auto := m.pr.Data.Enriched.AutoMergeRequest; m.pr.Data.IsEnriched && auto != nil {
+		rows = append(rows, "", faint.Render(fmt.Sprintf("Auto-merge (%s) is enabled",
+			strings.ToLower(auto.MergeMethod))))
+	}
+
+	help := make([]string, 0)
+	for _, b := range []key.Binding{
+		mergeKeys.Up, mergeKeys.Down, mergeKeys.Next, mergeKeys.Confirm, mergeKeys.Cancel,
+	} {
+		help = append(help, fmt.Sprintf("%s %s", b.Help().Key, b.Help().Desc))
+	}
+	rows = append(rows, "", faint.Italic(true).Render(strings.Join(help, " • ")))
+
+	dialog := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder(), true).
+		BorderForeground(m.ctx.Theme.PrimaryBorder).
+		Padding(0, 1).
+		Width(m.getIndentedContentWidth()).
+		Render(lipgloss.JoinVertical(lipgloss.Left, rows...))
+
+	if m.editor.Mode() == cmpcontroller.ModeMergeMessage {
+		dialog = lipgloss.JoinVertical(lipgloss.Left, dialog,
+			m.ctx.Styles.Sidebar.InputBox.Render(m.editor.View()))
+	}
+	return dialog
+}
+
+func (m *Model) renderMergeOption(option string, field mergeField) string {
+	if field != m.merge.field {
+		return option
+	}
+	return lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText).Render("‹ ") +
+		lipgloss.NewStyle().Bold(true).Foreground(m.ctx.Theme.PrimaryText).Render(option) +
+		lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText).Render(" ›")
+}
@@ -0,0 +1,88 @@
+package prview
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+func newMergeTestModel(t *testing.T) Model {
+	t.Helper()
+	pr := &data.PullRequestData{
+		Number:     7,
+		Title:      "Add feature",
+		Url:        "https://github.com/o/r/pull/7",
+		Repository: data.Repository{NameWithOwner: "o/r"},
+	}
+	m := newTestModelWithWidth(t, pr, nil, nil, 80)
+	m.ctx.Config.MergeStrategies = map[string]string{"o/*": "squash"}
+	m.ctx.StartTask = func(task context.Task) tea.Cmd { return nil }
+	return m
+}
+
+func TestMergeActions(t *testing.T) {
+	m := newMergeTestModel(t)
+	require.Equal(t, []mergeAction{mergeActionMerge, mergeActionEnableAuto}, m.mergeActions())
+
+	m.pr.Data.Enriched.AutoMergeRequest = &data.AutoMergeRequest{MergeMethod: "SQUASH"}
+	require.Equal(t, []mergeAction{mergeActionDisableAuto, mergeActionMerge}, m.mergeActions())
+
+	m.pr.Data.Enriched.IsMergeQueueEnabled = true
+	require.Equal(t, []mergeAction{mergeActionEnqueue}, m.mergeActions())
+
+	m.pr.Data.Enriched.IsInMergeQueue = true
+	require.Equal(t, []mergeAction{mergeActionDequeue}, m.mergeActions())
+}
+
+func TestMergeDialog(t *testing.T) {
+	m := newMergeTestModel(t)
+	m.SetIsMerging(true)
+	require.True(t, m.IsMergeDialogOpen())
+	require.Equal(t, "squash", m.merge.method, "the configured strategy should be preselected")
+
+	view := ansi.Strip(m.View())
+	require.Contains(t, view, "Merge now")
+	require.Contains(t, view, "Add feature (#7)", "squashes default to the PR title")
+
+	down := tea.KeyPressMsg{Code: 'j', Text: "j"}
+	right := tea.KeyPressMsg{Code: 'l', Text: "l"}
+	m, _ = m.Update(down)
+	require.Equal(t, mergeFieldMethod, m.merge.field)
+	m, _ = m.Update(right)
+	require.Equal(t, "rebase", m.merge.method)
+	require.Equal(t, []mergeField{mergeFieldAction, mergeFieldMethod, mergeFieldDeleteBranch},
+		m.mergeFields(), "rebases have no commit message")
+
+	m, _ = m.Update(down)
+	m, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	require.True(t, m.merge.deleteBranch)
+
+	m, cmd := m.Update(tea.KeyPressMsg{Code: 'k', Text: "k"})
+	require.Nil(t, cmd)
+	m, _ = m.Update(tea.KeyPressMsg{Code: 'k', Text: "k"})
+	require.Equal(t, mergeFieldAction, m.merge.field)
+	m, cmd = m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	require.False(t, m.IsMergeDialogOpen(), "the dialog closes once submitted")
+}
+
+func TestMergeDialogCommitMessage(t *testing.T) {
+	m := newMergeTestModel(t)
+	m.SetIsMerging(true)
+	m.merge.field = mergeFieldSubject
+
+	m, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	require.True(t, m.IsTextInputBoxFocused())
+	require.Equal(t, "Add feature (#7)", m.editor.Value())
+
+	m.editor.SetValue("Custom title\nignored")
+	m, _ = m.Update(tea.KeyPressMsg{Code: 'd', Mod: tea.ModCtrl})
+	require.False(t, m.IsTextInputBoxFocused())
+	require.True(t, m.IsMergeDialogOpen(), "editing the message keeps the dialog open")
+	require.Equal(t, "Custom title", m.merge.subject)
+}
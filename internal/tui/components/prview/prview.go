@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"image/color"
 	"regexp"
+	"slices"
 	"strings"
 
 	"charm.land/bubbles/v2/key"
@@ -13,6 +14,7 @@ import (
 	"github.com/dlvhdr/gh-dash/v4/internal/data"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/common"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/carousel"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/checkswatcher"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/cmpcontroller"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/fuzzyselect"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/inputbox"
@@ -40,9 +42,36 @@ type Model struct {
 	carousel        carousel.Model
 	editor          cmpcontroller.Controller
 	summaryViewMore bool
+	diff            diffState
+	threads         threadsState
+	activity        activityState
+	checks          checksState
+	reviewEvent     tasks.ReviewEvent
+	merge           mergeState
+	bulkRows        []data.RowData
+	checksWatcher   *checkswatcher.Watcher
+	// projectFields completes the project fields while editing one, and holds the fields to
+	// resolve the edit with
+	projectFields *fuzzyselect.ProjectFieldSource
 }
 
-var tabs = []string{" Overview", " Activity", " Commits", " Checks", " Files Changed"}
+var tabs = []string{
+	" Overview",
+	" Activity",
+	" Commits",
+	" Checks",
+	" Files Changed",
+	" Diff",
+	" Threads",
+}
+
+const (
+	activityTabIndex = 1
+	checksTabIndex   = 3
+	filesTabIndex    = 4
+	diffTabIndex     = 5
+	threadsTabIndex  = 6
+)
 
 func NewModel(ctx *context.ProgramContext) Model {
 	c := carousel.New(
@@ -62,6 +91,12 @@ func NewModel(ctx *context.ProgramContext) Model {
 }
 
 func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.GetIsReviewing() &&
+		key.Matches(keyMsg, reviewEventKey) {
+		m.cycleReviewEvent()
+		return m, nil
+	}
+
 	cmd, handled := m.editor.Update(msg)
 
 	if msg, ok := msg.(tea.KeyMsg); ok && msg.String() == "ctrl+d" {
@@ -72,6 +107,10 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 			return m, nil
 		}
 
+		if m.isBulk() && slices.Contains(bulkModes, mode) {
+			return m, m.submitBulk(mode, value)
+		}
+
 		sid := tasks.SectionIdentifier{Id: m.sectionId, Type: prssection.SectionType}
 
 		switch mode {
@@ -102,12 +141,57 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 			}
 			return m, nil
 
+		case cmpcontroller.ModeRequestReviewers:
+			reviewers := fuzzyselect.AllWords(value)
+			if len(reviewers) > 0 {
+				return m, tasks.RequestPRReviews(m.ctx, sid, m.pr.Data.Primary, reviewers)
+			}
+			return m, nil
+
+		case cmpcontroller.ModeRemoveReviewers:
+			reviewers := fuzzyselect.AllWords(value)
+			if len(reviewers) > 0 {
+				return m, tasks.RemovePRReviewRequests(m.ctx, sid, m.pr.Data.Primary, reviewers)
+			}
+			return m, nil
+
 		case cmpcontroller.ModeLabel:
 			labels := fuzzyselect.CurrentLabels(value)
 			if len(labels) > 0 || len(m.pr.Data.Primary.Labels.Nodes) > 0 {
 				return m, m.label(labels)
 			}
 			return m, nil
+		case cmpcontroller.ModeMilestone:
+			pr := m.pr.Data.Primary
+			milestone := common.MilestoneWithTitle(pr.GetRepoNameWithOwner(), value)
+			if milestone != nil || pr.Milestone != nil {
+				return m, tasks.SetPRMilestone(m.ctx, sid, pr, milestone)
+			}
+			return m, nil
+
+		case cmpcontroller.ModeProjectField:
+			field, fieldValue, ok := fuzzyselect.ParseProjectFieldValue(value)
+			if ok && m.projectFields != nil {
+				return m, tasks.SetProjectField(m.ctx, sid, m.pr.Data.Primary,
+					m.projectFields.Items, field, fieldValue)
+			}
+			return m, nil
+
+		case cmpcontroller.ModeReply:
+			return m, m.reply(value)
+
+		case cmpcontroller.ModeReview:
+			return m, m.submitReview(value)
+
+		case cmpcontroller.ModeMergeMessage:
+			m.setMergeMessage(value)
+			return m, nil
+
+		case cmpcontroller.ModeReaction:
+			return m, m.react(value)
+
+		case cmpcontroller.ModeTimelineAction:
+			return m, m.actOnEntry(value)
 		}
 	}
 
@@ -115,12 +199,24 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		return m, cmd
 	}
 
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.merge.open {
+		return m, m.updateMerge(keyMsg)
+	}
+
 	if keyMsg, ok := msg.(tea.KeyMsg); ok {
 		switch {
 		case key.Matches(keyMsg, keys.PRKeys.PrevSidebarTab):
 			m.carousel.MoveLeft()
 		case key.Matches(keyMsg, keys.PRKeys.NextSidebarTab):
 			m.carousel.MoveRight()
+		case m.IsThreadsTabSelected():
+			cmd = tea.Batch(cmd, m.updateThreads(keyMsg))
+		case m.IsActivityTabSelected():
+			m.updateActivity(keyMsg)
+		case m.IsChecksTabSelected():
+			cmd = tea.Batch(cmd, m.updateChecks(keyMsg))
+		case IsSelectionKey(keyMsg) && m.IsSelectionTabSelected():
+			m.updateSelection(keyMsg)
 		}
 	}
 
@@ -137,15 +233,28 @@ func (m Model) View() string {
 	case tabs[0]:
 		body.WriteString(m.viewOverviewTab())
 	case tabs[1]:
-		body.WriteString(m.renderActivity())
+		activity, _ := m.renderActivity()
+		body.WriteString(activity)
 	case tabs[2]:
 		body.WriteString(m.renderCommits())
 	case tabs[3]:
+		if m.checks.log.checkRun != nil {
+			checkLog, _ := m.renderCheckLog()
+			body.WriteString(checkLog)
+			break
+		}
 		body.WriteString(m.renderChecksOverview())
 		body.WriteString("\n\n")
-		body.WriteString(m.renderChecks())
+		checks, _ := m.renderChecks()
+		body.WriteString(checks)
 	case tabs[4]:
 		body.WriteString(m.renderChangedFiles())
+	case tabs[5]:
+		diff, _ := m.renderDiff()
+		body.WriteString(diff)
+	case tabs[6]:
+		threads, _ := m.renderThreads()
+		body.WriteString(threads)
 	}
 
 	return lipgloss.JoinVertical(lipgloss.Left,
@@ -190,6 +299,25 @@ func (m *Model) viewOverviewTab() string {
 		body.WriteString("\n\n")
 	}
 
+	milestone := common.RenderMilestone(m.pr.Data.Primary.Milestone, m.ctx.Styles.Common,
+		m.getIndentedContentWidth())
+	if milestone != "" {
+		body.WriteString(milestone)
+		body.WriteString("\n\n")
+	}
+
+	projectItems := m.pr.Data.Primary.ProjectItems
+	if m.pr.Data.IsEnriched {
+		// Enriching always fetches the project items, even when the search leaves them out
+		projectItems = m.pr.Data.Enriched.ProjectItems
+	}
+	projects := common.RenderProjectItems(projectItems, m.ctx.Styles.Common,
+		m.getIndentedContentWidth())
+	if projects != "" {
+		body.WriteString(projects)
+		body.WriteString("\n\n")
+	}
+
 	body.WriteString(m.renderSummary())
 	body.WriteString("\n\n")
 	body.WriteString(
@@ -204,7 +332,10 @@ func (m *Model) viewOverviewTab() string {
 	body.WriteString("\n")
 	body.WriteString(m.renderChecksOverview())
 
-	if m.editor.Mode() != cmpcontroller.ModeNone {
+	if m.merge.open {
+		body.WriteString("\n\n")
+		body.WriteString(m.renderMergeDialog())
+	} else if m.editor.Mode() != cmpcontroller.ModeNone {
 		body.WriteString(m.ctx.Styles.Sidebar.InputBox.Render(m.editor.View()))
 	}
 
@@ -331,14 +462,7 @@ func (m *Model) renderRequestedReviewers() string {
 	}
 
 	reviewStates := make(map[string]string)
-	for _, review := range reviews {
-		login := review.Author.Login
-		existingState := reviewStates[login]
-		// Don't override APPROVED or CHANGES_REQUESTED with COMMENTED
-		if review.State == "COMMENTED" &&
-			(existingState == "APPROVED" || existingState == "CHANGES_REQUESTED") {
-			continue
-		}
+	for login, review := range latestReviews(reviews) {
 		reviewStates[login] = review.State
 	}
 
@@ -506,13 +630,17 @@ func (m *Model) renderSummary() string {
 		"",
 	)
 	sbody := lipgloss.NewStyle().Width(m.getIndentedContentWidth())
+	reactions := common.RenderReactions(m.pr.Data.Enriched.ReactionGroups, m.ctx.Styles.Common)
 	body = strings.TrimSpace(body)
 	if body == "" {
-		return lipgloss.JoinVertical(
-			lipgloss.Left,
+		parts := []string{
 			title,
 			sbody.Italic(true).Foreground(m.ctx.Theme.FaintText).Render("No description provided."),
-		)
+		}
+		if reactions != "" {
+			parts = append(parts, reactions)
+		}
+		return lipgloss.JoinVertical(lipgloss.Left, parts...)
 	}
 
 	markdownRenderer := markdown.GetMarkdownRenderer(width, m.ctx)
@@ -541,13 +669,16 @@ func (m *Model) renderSummary() string {
 		)
 	}
 
-	return lipgloss.JoinVertical(lipgloss.Left, title,
-		lipgloss.NewStyle().
-			Width(width).
-			MaxWidth(width).
-			Align(lipgloss.Left).
-			Render(rendered),
-	)
+	parts := []string{title, lipgloss.NewStyle().
+		Width(width).
+		MaxWidth(width).
+		Align(lipgloss.Left).
+		Render(rendered),
+	}
+	if reactions != "" {
+		parts = append(parts, reactions)
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, parts...)
 }
 
 func (m *Model) SetSectionId(id int) {
@@ -555,6 +686,14 @@ func (m *Model) SetSectionId(id int) {
 }
 
 func (m *Model) SetRow(d *prrow.Data) {
+	if !m.hasData() || d == nil || d.Primary == nil || m.pr.Data.Primary.Url != d.Primary.Url {
+		m.diff = diffState{}
+		m.threads = threadsState{}
+		m.activity = activityState{}
+		m.checks = checksState{}
+		m.merge = mergeState{}
+	}
+
 	if d == nil {
 		m.pr = nil
 	} else {
@@ -631,7 +770,7 @@ func (m *Model) SetIsCommenting(isCommenting bool) tea.Cmd {
 	m.editor.SetAutocompleteSource(&fuzzyselect.UserMentionSource{WithAtSymbol: true})
 	cmd := m.editor.Enter(cmpcontroller.EnterOptions{
 		Mode:                             cmpcontroller.ModeComment,
-		Prompt:                           constants.CommentPrompt,
+		Prompt:                           m.prompt(constants.CommentPrompt),
 		Repo:                             m.repoRef(),
 		EnterFetch:                       cmpcontroller.FetchSilent,
 		ConfirmDiscardOnCancel:           true,
@@ -663,7 +802,7 @@ func (m *Model) SetIsApproving(isApproving bool) tea.Cmd {
 	m.editor.SetAutocompleteSource(&fuzzyselect.UserMentionSource{WithAtSymbol: true})
 	cmd := m.editor.Enter(cmpcontroller.EnterOptions{
 		Mode:                             cmpcontroller.ModeApprove,
-		Prompt:                           constants.ApprovalPrompt,
+		Prompt:                           m.prompt(constants.ApprovalPrompt),
 		InitialValue:                     m.ctx.Config.Defaults.PrApproveComment,
 		Repo:                             m.repoRef(),
 		EnterFetch:                       cmpcontroller.FetchSilent,
@@ -690,14 +829,14 @@ func (m *Model) SetIsAssigning(isAssigning bool) tea.Cmd {
 	}
 
 	initialValue := ""
-	if !m.userAssignedToPr(m.ctx.User) {
+	if m.isBulk() || !m.userAssignedToPr(m.ctx.User) {
 		initialValue = m.ctx.User
 	}
 
 	m.editor.SetAutocompleteSource(&fuzzyselect.UserMentionSource{WithAtSymbol: false})
 	cmd := m.editor.Enter(cmpcontroller.EnterOptions{
 		Mode:                             cmpcontroller.ModeAssign,
-		Prompt:                           constants.AssignPrompt,
+		Prompt:                           m.prompt(constants.AssignPrompt),
 		InitialValue:                     initialValue,
 		Repo:                             m.repoRef(),
 		EnterFetch:                       cmpcontroller.FetchSilent,
@@ -732,10 +871,15 @@ func (m *Model) SetIsUnassigning(isUnassigning bool) tea.Cmd {
 		return nil
 	}
 
+	initialValue := strings.Join(m.prAssignees(), "\n")
+	if m.isBulk() {
+		initialValue = ""
+	}
+
 	cmd := m.editor.Enter(cmpcontroller.EnterOptions{
 		Mode:         cmpcontroller.ModeUnassign,
-		Prompt:       constants.UnassignPrompt,
-		InitialValue: strings.Join(m.prAssignees(), "\n"),
+		Prompt:       m.prompt(constants.UnassignPrompt),
+		InitialValue: initialValue,
 		Repo:         m.repoRef(),
 	})
 	return cmd
@@ -776,6 +920,28 @@ func (m *Model) SetEnrichedPR(data data.EnrichedPullRequestData) {
 	}
 }
 
+// SetChecksWatcher sets the watch list the checks tab tells whether the PR is watched from.
+func (m *Model) SetChecksWatcher(w *checkswatcher.Watcher) {
+	m.checksWatcher = w
+}
+
+// SetChecks sets the polled checks of the last commit of a PR, if it's the one shown.
+func (m *Model) SetChecks(prUrl string, commits data.LastCommitWithStatusChecks) {
+	if !m.hasData() || m.pr.Data.Primary == nil || m.pr.Data.Primary.Url != prUrl ||
+		len(commits.Nodes) == 0 {
+		return
+	}
+	m.pr.Data.Primary.Commits.SetState(commits.Nodes[0].Commit.StatusCheckRollup.State)
+	if m.pr.Data.IsEnriched {
+		m.pr.Data.Enriched.Commits = commits
+	}
+}
+
+func (m *Model) isWatchingChecks() bool {
+	return m.checksWatcher != nil && m.hasData() && m.pr.Data.Primary != nil &&
+		m.checksWatcher.IsWatching(m.pr.Data.Primary.Url)
+}
+
 func (m *Model) GetIsLabeling() bool {
 	return m.editor.Mode() == cmpcontroller.ModeLabel
 }
@@ -793,16 +959,22 @@ func (m *Model) SetIsLabeling(isLabeling bool) tea.Cmd {
 		return nil
 	}
 
+	// Labels can only be added when acting on several PRs, so none are prefilled
+	prompt := constants.LabelPrompt
 	labels := make([]string, 0, len(m.pr.Data.Primary.Labels.Nodes)+1)
-	for _, label := range m.pr.Data.Primary.Labels.Nodes {
-		labels = append(labels, label.Name)
+	if m.isBulk() {
+		prompt = m.prompt(constants.BulkLabelPrompt)
+	} else {
+		for _, label := range m.pr.Data.Primary.Labels.Nodes {
+			labels = append(labels, label.Name)
+		}
 	}
 	labels = append(labels, "")
 
 	m.editor.SetAutocompleteSource(&fuzzyselect.LabelSource{})
 	cmd := m.editor.Enter(cmpcontroller.EnterOptions{
 		Mode:                             cmpcontroller.ModeLabel,
-		Prompt:                           constants.LabelPrompt,
+		Prompt:                           prompt,
 		InitialValue:                     strings.Join(labels, ", "),
 		Repo:                             m.repoRef(),
 		EnterFetch:                       cmpcontroller.FetchSilent,
@@ -813,6 +985,61 @@ func (m *Model) SetIsLabeling(isLabeling bool) tea.Cmd {
 	return cmd
 }
 
+// SetIsSettingMilestone enters or exits setting the milestone of the PR
+func (m *Model) SetIsSettingMilestone(isSetting bool) tea.Cmd {
+	if m.pr == nil || m.pr.Data.Primary == nil {
+		return nil
+	}
+
+	if !isSetting {
+		if m.editor.Mode() == cmpcontroller.ModeMilestone {
+			m.editor.Exit()
+		}
+		return nil
+	}
+
+	var title string
+	if m.pr.Data.Primary.Milestone != nil && !m.isBulk() {
+		title = m.pr.Data.Primary.Milestone.Title
+	}
+
+	m.editor.SetAutocompleteSource(&fuzzyselect.MilestoneSource{})
+	cmd := m.editor.Enter(cmpcontroller.EnterOptions{
+		Mode:         cmpcontroller.ModeMilestone,
+		Prompt:       m.prompt(constants.MilestonePrompt),
+		InitialValue: title,
+		Repo:         m.repoRef(),
+		EnterFetch:   cmpcontroller.FetchSilent,
+	})
+	m.editor.ShowCompletions()
+	return cmd
+}
+
+// SetIsEditingProjectField enters or exits setting a field of the projects of the PR
+func (m *Model) SetIsEditingProjectField(isEditing bool) tea.Cmd {
+	if m.pr == nil || m.pr.Data.Primary == nil {
+		return nil
+	}
+
+	if !isEditing {
+		if m.editor.Mode() == cmpcontroller.ModeProjectField {
+			m.editor.Exit()
+		}
+		return nil
+	}
+
+	m.projectFields = &fuzzyselect.ProjectFieldSource{Url: m.pr.Data.Primary.GetUrl()}
+	m.editor.SetAutocompleteSource(m.projectFields)
+	cmd := m.editor.Enter(cmpcontroller.EnterOptions{
+		Mode:       cmpcontroller.ModeProjectField,
+		Prompt:     constants.ProjectFieldPrompt,
+		Repo:       m.repoRef(),
+		EnterFetch: cmpcontroller.FetchWithLoading,
+	})
+	m.editor.ShowCompletions()
+	return cmd
+}
+
 func (m *Model) repoRef() cmpcontroller.RepoRef {
 	owner, repo := m.pr.Data.Primary.GetRepoNameAndOwner()
 	return cmpcontroller.RepoRef{
@@ -0,0 +1,78 @@
+package prview
+
+import (
+	"fmt"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/cmpcontroller"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/fuzzyselect"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prssection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+)
+
+// reactionSubject returns what reactions are toggled on: the selected comment or review when
+// the Activity tab is shown, the PR otherwise.
+func (m *Model) reactionSubject() (tasks.ReactionSubject, data.ReactionGroups) {
+	if m.IsActivityTabSelected() {
+		if entry := m.selectedEntry(); entry != nil && entry.Id != "" {
+			return tasks.ReactionSubject{
+				Id:   entry.Id,
+				Name: entry.Name(m.subjectName()),
+			}, entry.ReactionGroups
+		}
+	}
+	enriched := m.pr.Data.Enriched
+	return tasks.ReactionSubject{
+		Id:   enriched.Id,
+		Name: m.subjectName(),
+	}, enriched.ReactionGroups
+}
+
+func (m *Model) GetIsReacting() bool {
+	return m.editor.Mode() == cmpcontroller.ModeReaction
+}
+
+// SetIsReacting enters or exits picking a reaction to toggle on the PR or the selected comment or
+// review.
+func (m *Model) SetIsReacting(isReacting bool) tea.Cmd {
+	if m.pr == nil || m.pr.Data.Primary == nil || !m.pr.Data.IsEnriched {
+		return nil
+	}
+
+	if !isReacting {
+		if m.editor.Mode() == cmpcontroller.ModeReaction {
+			m.editor.Exit()
+		}
+		return nil
+	}
+
+	subject, groups := m.reactionSubject()
+	m.editor.SetAutocompleteSource(&fuzzyselect.ReactionSource{Groups: groups})
+	cmd := m.editor.Enter(cmpcontroller.EnterOptions{
+		Mode:   cmpcontroller.ModeReaction,
+		Prompt: fmt.Sprintf(constants.ReactionPrompt, subject.Name),
+		Repo:   m.repoRef(),
+	})
+	m.editor.ShowCompletions()
+	return cmd
+}
+
+// react toggles the picked reaction on the PR or the selected comment or review, removing it
+// when the user already reacted with it.
+func (m *Model) react(value string) tea.Cmd {
+	content, ok := fuzzyselect.ParseReaction(value)
+	if !ok {
+		return nil
+	}
+
+	subject, groups := m.reactionSubject()
+	if subject.Id == "" {
+		return nil
+	}
+	sid := tasks.SectionIdentifier{Id: m.sectionId, Type: prssection.SectionType}
+	return tasks.ReactOnPR(m.ctx, sid, m.pr.Data.Primary, subject, content,
+		groups.Get(content).ViewerHasReacted)
+}
@@ -0,0 +1,64 @@
+package prview
+
+import (
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+func TestReactionSubject(t *testing.T) {
+	m := newTestModel(t, &data.PullRequestData{Number: 7}, nil, nil)
+	enriched := &m.pr.Data.Enriched
+	enriched.Id = "PR_7"
+	now := time.Now()
+	enriched.Comments.Nodes = []data.Comment{
+		{Id: "IC_2", Author: struct{ Login string }{Login: "octo"}, UpdatedAt: now},
+		{Body: "just added", UpdatedAt: now.Add(time.Minute)},
+	}
+	enriched.ReviewThreads.Nodes = []data.ReviewThread{{Id: "PRRT_1"}}
+	enriched.ReviewThreads.Nodes[0].Comments.Nodes = []data.ReviewComment{
+		{Id: "PRRC_1", UpdatedAt: now.Add(-time.Minute)},
+	}
+	enriched.Reviews.Nodes = []data.Review{{
+		Id:          "PRR_1",
+		Author:      struct{ Login string }{Login: "hubot"},
+		CommentMeta: data.CommentMeta{CreatedAt: now.Add(-2 * time.Minute)},
+	}}
+	enriched.TimelineItems.Nodes = []data.TimelineEvent{{Typename: data.TimelineLabeled}}
+	enriched.TimelineItems.Nodes[0].Node.Id = "LE_1"
+	enriched.TimelineItems.Nodes[0].Labeled.CreatedAt = now.Add(-3 * time.Minute)
+	enriched.TimelineItems.Nodes[0].Labeled.Label.Name = "bug"
+
+	subject, _ := m.reactionSubject()
+	require.Equal(t, "PR_7", subject.Id, "the PR is reacted to without a selected comment")
+
+	m.carousel.SetCursor(activityTabIndex)
+	next := tea.KeyPressMsg{Text: "}"}
+	m.updateActivity(next)
+	require.Equal(t, "LE_1", m.activity.entry, "entries are selected oldest first")
+	subject, _ = m.reactionSubject()
+	require.Equal(t, "PR_7", subject.Id, "events can't be reacted to")
+
+	m.updateActivity(next)
+	subject, _ = m.reactionSubject()
+	require.Equal(t, "PRR_1", subject.Id)
+	require.Equal(t, "@hubot's review on pr #7", subject.Name)
+
+	m.updateActivity(next)
+	require.Equal(t, "PRRC_1", m.activity.entry)
+	m.updateActivity(next)
+	m.updateActivity(next)
+	require.Equal(t, "IC_2", m.activity.entry, "comments without an id can't be selected")
+
+	subject, _ = m.reactionSubject()
+	require.Equal(t, "IC_2", subject.Id)
+	require.Equal(t, "@octo's comment on pr #7", subject.Name)
+
+	m.carousel.SetCursor(threadsTabIndex)
+	subject, _ = m.reactionSubject()
+	require.Equal(t, "PR_7", subject.Id, "comments are only reacted to from the Activity tab")
+}
@@ -0,0 +1,76 @@
+package prview
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/cmpcontroller"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/fuzzyselect"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prssection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+)
+
+// reviewEventKey cycles the event of the review being written. It is only active while the
+// review editor is open, so it must not clash with the text area keys.
+var reviewEventKey = key.NewBinding(
+	key.WithKeys("ctrl+r"),
+	key.WithHelp("ctrl+r", "change review type"),
+)
+
+func (m *Model) GetIsReviewing() bool {
+	return m.editor.Mode() == cmpcontroller.ModeReview
+}
+
+// SetIsReviewing enters or exits writing a review, which starts out as a comment
+func (m *Model) SetIsReviewing(isReviewing bool) tea.Cmd {
+	if m.pr == nil {
+		return nil
+	}
+
+	if !isReviewing {
+		if m.editor.Mode() == cmpcontroller.ModeReview {
+			m.editor.Exit()
+		}
+		return nil
+	}
+
+	m.reviewEvent = tasks.ReviewEventComment
+	m.editor.SetAutocompleteSource(&fuzzyselect.UserMentionSource{WithAtSymbol: true})
+	cmd := m.editor.Enter(cmpcontroller.EnterOptions{
+		Mode:                             cmpcontroller.ModeReview,
+		Prompt:                           m.reviewPrompt(),
+		Repo:                             m.repoRef(),
+		EnterFetch:                       cmpcontroller.FetchSilent,
+		ConfirmDiscardOnCancel:           true,
+		HideAutocompleteWhenContextEmpty: true,
+	})
+	return cmd
+}
+
+func (m *Model) reviewPrompt() string {
+	return fmt.Sprintf(constants.ReviewPrompt, m.reviewEvent, reviewEventKey.Help().Key)
+}
+
+func (m *Model) cycleReviewEvent() {
+	i := slices.Index(tasks.ReviewEvents, m.reviewEvent)
+	m.reviewEvent = tasks.ReviewEvents[(i+1)%len(tasks.ReviewEvents)]
+	m.editor.SetPrompt(m.reviewPrompt())
+}
+
+// submitReview submits the review being written. Only approvals may be left without a body.
+func (m *Model) submitReview(body string) tea.Cmd {
+	if len(strings.TrimSpace(body)) == 0 {
+		if m.reviewEvent != tasks.ReviewEventApprove {
+			return nil
+		}
+		body = ""
+	}
+
+	sid := tasks.SectionIdentifier{Id: m.sectionId, Type: prssection.SectionType}
+	return tasks.SubmitReview(m.ctx, sid, m.pr.Data.Primary, m.reviewEvent, body)
+}
@@ -0,0 +1,41 @@
+package prview
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+func TestReviewEventCycling(t *testing.T) {
+	m := newTestModelForAction(t)
+	cmd := m.SetIsReviewing(true)
+	require.NotNil(t, cmd)
+	require.True(t, m.GetIsReviewing())
+	require.Equal(t, tasks.ReviewEventComment, m.reviewEvent)
+
+	ctrlR := tea.KeyPressMsg{Code: 'r', Mod: tea.ModCtrl}
+	m, _ = m.Update(ctrlR)
+	require.Equal(t, tasks.ReviewEventRequestChanges, m.reviewEvent)
+	require.Contains(t, m.reviewPrompt(), "Request changes")
+	m, _ = m.Update(ctrlR)
+	require.Equal(t, tasks.ReviewEventApprove, m.reviewEvent)
+	m, _ = m.Update(ctrlR)
+	require.Equal(t, tasks.ReviewEventComment, m.reviewEvent)
+	require.True(t, m.GetIsReviewing(), "changing the review type keeps the editor open")
+}
+
+func TestSubmitReviewRequiresBody(t *testing.T) {
+	m := newTestModelForAction(t)
+	m.ctx.StartTask = func(task context.Task) tea.Cmd { return nil }
+	m.SetIsReviewing(true)
+
+	require.Nil(t, m.submitReview("  "), "comments need a body")
+	m.reviewEvent = tasks.ReviewEventRequestChanges
+	require.Nil(t, m.submitReview(""), "requesting changes needs a body")
+	m.reviewEvent = tasks.ReviewEventApprove
+	require.NotNil(t, m.submitReview(""), "approvals may be left without a body")
+}
@@ -0,0 +1,149 @@
+package prview
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/cmpcontroller"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/fuzzyselect"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+)
+
+// latestReviews returns the review that counts for each reviewer, their last one. Comments
+// don't override an approval or a request for changes.
+func latestReviews(reviews []data.Review) map[string]data.Review {
+	latest := make(map[string]data.Review)
+	for _, review := range reviews {
+		login := review.Author.Login
+		existingState := latest[login].State
+		if review.State == "COMMENTED" &&
+			(existingState == "APPROVED" || existingState == "CHANGES_REQUESTED") {
+			continue
+		}
+		latest[login] = review
+	}
+	return latest
+}
+
+// rerequestableReviewers returns the reviewers whose review was dismissed, or was submitted on
+// an older commit than the head of the PR, and who weren't asked to review it again yet.
+func (m *Model) rerequestableReviewers() map[string]string {
+	enriched := m.pr.Data.Enriched
+	requested := make(map[string]bool)
+	for _, request := range enriched.ReviewRequests.Nodes {
+		requested[request.GetReviewerDisplayName()] = true
+	}
+
+	rerequestable := make(map[string]string)
+	for login, review := range latestReviews(enriched.Reviews.Nodes) {
+		if requested[login] || login == enriched.Author.Login {
+			continue
+		}
+		isStale := review.Commit != nil && enriched.HeadRefOid != "" &&
+			review.Commit.Oid != enriched.HeadRefOid
+		switch review.State {
+		case "DISMISSED":
+			rerequestable[login] = "review dismissed"
+		case "APPROVED", "CHANGES_REQUESTED":
+			if isStale {
+				rerequestable[login] = "review is stale"
+			}
+		}
+	}
+	return rerequestable
+}
+
+// reviewRequests returns the requested reviewers of the PR, teams written as org/team.
+func (m *Model) reviewRequests() []string {
+	owner, _, _ := strings.Cut(m.pr.Data.Primary.GetRepoNameWithOwner(), "/")
+	reviewers := make([]string, 0, len(m.pr.Data.Enriched.ReviewRequests.Nodes))
+	for _, request := range m.pr.Data.Enriched.ReviewRequests.Nodes {
+		if request.IsTeam() {
+			reviewers = append(reviewers, owner+"/"+request.RequestedReviewer.Team.Slug)
+		} else if name := request.GetReviewerDisplayName(); name != "" {
+			reviewers = append(reviewers, name)
+		}
+	}
+	return reviewers
+}
+
+func (m *Model) GetIsRequestingReviews() bool {
+	return m.editor.Mode() == cmpcontroller.ModeRequestReviewers
+}
+
+// SetIsRequestingReviews enters or exits requesting reviews of the PR. The reviewers whose
+// review can be re-requested are prefilled.
+func (m *Model) SetIsRequestingReviews(isRequesting bool) tea.Cmd {
+	if m.pr == nil || m.pr.Data.Primary == nil {
+		return nil
+	}
+
+	if !isRequesting {
+		if m.editor.Mode() == cmpcontroller.ModeRequestReviewers {
+			m.editor.Exit()
+		}
+		return nil
+	}
+
+	source := &fuzzyselect.ReviewerSource{}
+	initialValue := ""
+	if m.pr.Data.IsEnriched {
+		source.Rerequested = m.rerequestableReviewers()
+		for _, suggested := range m.pr.Data.Enriched.SuggestedReviewers {
+			if !suggested.IsAuthor {
+				source.Suggested = append(source.Suggested, suggested.Reviewer.Login)
+			}
+		}
+		for _, login := range slices.Sorted(maps.Keys(source.Rerequested)) {
+			initialValue += login + " "
+		}
+	}
+
+	m.editor.SetAutocompleteSource(source)
+	cmd := m.editor.Enter(cmpcontroller.EnterOptions{
+		Mode:                             cmpcontroller.ModeRequestReviewers,
+		Prompt:                           constants.RequestReviewersPrompt,
+		InitialValue:                     initialValue,
+		Repo:                             m.repoRef(),
+		EnterFetch:                       cmpcontroller.FetchSilent,
+		HideAutocompleteWhenContextEmpty: false,
+	})
+	m.editor.ShowCompletions()
+	return cmd
+}
+
+func (m *Model) GetIsRemovingReviewRequests() bool {
+	return m.editor.Mode() == cmpcontroller.ModeRemoveReviewers
+}
+
+// SetIsRemovingReviewRequests enters or exits removing review requests of the PR, prefilled
+// with the requested reviewers.
+func (m *Model) SetIsRemovingReviewRequests(isRemoving bool) tea.Cmd {
+	if m.pr == nil || m.pr.Data.Primary == nil {
+		return nil
+	}
+
+	if !isRemoving {
+		if m.editor.Mode() == cmpcontroller.ModeRemoveReviewers {
+			m.editor.Exit()
+		}
+		return nil
+	}
+
+	initialValue := ""
+	if m.pr.Data.IsEnriched {
+		initialValue = strings.Join(m.reviewRequests(), "\n")
+	}
+
+	cmd := m.editor.Enter(cmpcontroller.EnterOptions{
+		Mode:         cmpcontroller.ModeRemoveReviewers,
+		Prompt:       constants.RemoveReviewersPrompt,
+		InitialValue: initialValue,
+		Repo:         m.repoRef(),
+	})
+	return cmd
+}
@@ -422,3 +422,48 @@ func TestRenderSuggestedReviewers(t *testing.T) {
 	require.False(t, strings.Contains(got, "@author"),
 		"expected output to NOT contain '@author' (PR author), got: %q", got)
 }
+
+func TestRerequestableReviewers(t *testing.T) {
+	review := func(login, state, oid string) data.Review {
+		r := data.Review{State: state, Commit: &data.ReviewCommit{Oid: oid}}
+		r.Author.Login = login
+		return r
+	}
+	pending := data.ReviewRequestNode{}
+	pending.RequestedReviewer.User.Login = "pending"
+
+	m := newTestModel(t, &data.PullRequestData{}, []data.Review{
+		review("dismissed", "DISMISSED", "head"),
+		review("stale", "APPROVED", "old"),
+		review("stale", "COMMENTED", "head"),
+		review("fresh", "CHANGES_REQUESTED", "head"),
+		review("commenter", "COMMENTED", "old"),
+		review("pending", "DISMISSED", "old"),
+	}, []data.ReviewRequestNode{pending})
+	m.pr.Data.Enriched.HeadRefOid = "head"
+
+	require.Equal(t, map[string]string{
+		"dismissed": "review dismissed",
+		"stale":     "review is stale",
+	}, m.rerequestableReviewers())
+
+	m.SetIsRequestingReviews(true)
+	require.True(t, m.GetIsRequestingReviews())
+	require.Equal(t, "dismissed stale ", m.editor.Value(),
+		"reviews to re-request should be prefilled")
+}
+
+func TestSetIsRemovingReviewRequests(t *testing.T) {
+	user := data.ReviewRequestNode{}
+	user.RequestedReviewer.User.Login = "octo"
+	team := data.ReviewRequestNode{}
+	team.RequestedReviewer.Team.Slug = "maintainers"
+
+	m := newTestModel(t, &data.PullRequestData{
+		Repository: data.Repository{NameWithOwner: "cli/cli"},
+	}, nil, []data.ReviewRequestNode{user, team})
+
+	m.SetIsRemovingReviewRequests(true)
+	require.True(t, m.GetIsRemovingReviewRequests())
+	require.Equal(t, "octo\ncli/maintainers", m.editor.Value())
+}
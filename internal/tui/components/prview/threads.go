@@ -0,0 +1,348 @@
+package prview
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/glamour/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/cmpcontroller"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/fuzzyselect"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prssection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/markdown"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+// threadContextLines is how many lines of the commented code are shown above a thread.
+const threadContextLines = 4
+
+type threadsState struct {
+	// id is the selected review thread
+	id           string
+	showOutdated bool
+}
+
+func (m *Model) GoToThreadsTab() {
+	m.carousel.SetCursor(threadsTabIndex)
+}
+
+func (m Model) IsThreadsTabSelected() bool {
+	return m.carousel.SelectedItem() == tabs[threadsTabIndex]
+}
+
+// HasSelectedThread reports whether the Threads tab is shown with a thread selected.
+func (m *Model) HasSelectedThread() bool {
+	return m.IsThreadsTabSelected() && m.selectedThread() != nil
+}
+
+// visibleThreads returns the review threads of the PR grouped by file and ordered by line.
+func (m *Model) visibleThreads() []data.ReviewThread {
+	threads := make([]data.ReviewThread, 0)
+	if !m.hasData() {
+		return threads
+	}
+
+	for _, thread := range m.pr.Data.Enriched.ReviewThreads.Nodes {
+		if thread.IsOutdated && !m.threads.showOutdated {
+			continue
+		}
+		threads = append(threads, thread)
+	}
+	slices.SortStableFunc(threads, func(a, b data.ReviewThread) int {
+		return cmp.Or(strings.Compare(a.Path, b.Path), cmp.Compare(threadLine(a), threadLine(b)))
+	})
+	return threads
+}
+
+// threadLine returns the line a thread is on, falling back to the original line for
+// outdated threads that no longer map onto the diff.
+func threadLine(thread data.ReviewThread) int {
+	if thread.Line != 0 {
+		return thread.Line
+	}
+	return thread.OriginalLine
+}
+
+func (m *Model) selectedThread() *data.ReviewThread {
+	if m.threads.id == "" {
+		return nil
+	}
+	for _, thread := range m.visibleThreads() {
+		if thread.Id == m.threads.id {
+			return &thread
+		}
+	}
+	return nil
+}
+
+func (m *Model) selectThread(delta int) {
+	threads := m.visibleThreads()
+	if len(threads) == 0 {
+		return
+	}
+
+	i := slices.IndexFunc(threads, func(t data.ReviewThread) bool { return t.Id == m.threads.id })
+	switch {
+	case i < 0 && delta > 0:
+		i = 0
+	case i < 0:
+		i = len(threads) - 1
+	default:
+		i = max(0, min(len(threads)-1, i+delta))
+	}
+	m.threads.id = threads[i].Id
+}
+
+// selectThreadFile selects the first thread of the previous or next file.
+func (m *Model) selectThreadFile(delta int) {
+	threads := m.visibleThreads()
+	paths := make([]string, 0)
+	for _, thread := range threads {
+		if !slices.Contains(paths, thread.Path) {
+			paths = append(paths, thread.Path)
+		}
+	}
+	if len(paths) == 0 {
+		return
+	}
+
+	i := -1
+	if selected := m.selectedThread(); selected != nil {
+		i = slices.Index(paths, selected.Path)
+	}
+	switch {
+	case i < 0 && delta > 0:
+		i = 0
+	case i < 0:
+		i = len(paths) - 1
+	default:
+		i = max(0, min(len(paths)-1, i+delta))
+	}
+
+	for _, thread := range threads {
+		if thread.Path == paths[i] {
+			m.threads.id = thread.Id
+			return
+		}
+	}
+}
+
+func (m *Model) updateThreads(msg tea.KeyMsg) tea.Cmd {
+	switch {
+	case key.Matches(msg, keys.PRKeys.PrevDiffFile):
+		m.selectThreadFile(-1)
+	case key.Matches(msg, keys.PRKeys.NextDiffFile):
+		m.selectThreadFile(1)
+	case key.Matches(msg, keys.PRKeys.PrevDiffHunk):
+		m.selectThread(-1)
+	case key.Matches(msg, keys.PRKeys.NextDiffHunk):
+		m.selectThread(1)
+	case key.Matches(msg, keys.PRKeys.ToggleOutdated):
+		m.threads.showOutdated = !m.threads.showOutdated
+	case key.Matches(msg, keys.PRKeys.ResolveThread):
+		thread := m.selectedThread()
+		if thread == nil {
+			return nil
+		}
+		sid := tasks.SectionIdentifier{Id: m.sectionId, Type: prssection.SectionType}
+		return tasks.ResolveReviewThread(
+			m.ctx, sid, m.pr.Data.Primary, thread.Id, !thread.IsResolved)
+	}
+	return nil
+}
+
+func (m *Model) GetIsReplying() bool {
+	return m.editor.Mode() == cmpcontroller.ModeReply
+}
+
+// SetIsReplying enters or exits replying to the selected review thread
+func (m *Model) SetIsReplying(isReplying bool) tea.Cmd {
+	if m.pr == nil {
+		return nil
+	}
+
+	if !isReplying {
+		if m.editor.Mode() == cmpcontroller.ModeReply {
+			m.editor.Exit()
+		}
+		return nil
+	}
+
+	m.editor.SetAutocompleteSource(&fuzzyselect.UserMentionSource{WithAtSymbol: true})
+	cmd := m.editor.Enter(cmpcontroller.EnterOptions{
+		Mode:                             cmpcontroller.ModeReply,
+		Prompt:                           constants.ReplyPrompt,
+		Repo:                             m.repoRef(),
+		EnterFetch:                       cmpcontroller.FetchSilent,
+		ConfirmDiscardOnCancel:           true,
+		HideAutocompleteWhenContextEmpty: true,
+	})
+	return cmd
+}
+
+func (m *Model) reply(body string) tea.Cmd {
+	thread := m.selectedThread()
+	if thread == nil || len(strings.TrimSpace(body)) == 0 {
+		return nil
+	}
+
+	sid := tasks.SectionIdentifier{Id: m.sectionId, Type: prssection.SectionType}
+	return tasks.ReplyToReviewThread(m.ctx, sid, m.pr.Data.Primary, thread.Id, body)
+}
+
+// renderThreads returns the rendered threads tab and the line the selected thread starts at.
+func (m *Model) renderThreads() (string, int) {
+	if !m.pr.Data.IsEnriched {
+		return lipgloss.JoinHorizontal(
+			lipgloss.Top,
+			m.ctx.Styles.Common.WaitingGlyph,
+			" ",
+			m.ctx.Styles.Common.FaintTextStyle.Render("Loading..."),
+		), 0
+	}
+
+	threads := m.visibleThreads()
+	outdated := 0
+	for _, thread := range m.pr.Data.Enriched.ReviewThreads.Nodes {
+		if thread.IsOutdated {
+			outdated++
+		}
+	}
+
+	title := fmt.Sprintf("%s  %d threads", constants.CommentsIcon, len(threads))
+	if outdated > 0 && !m.threads.showOutdated {
+		title = fmt.Sprintf("%s (%d outdated hidden)", title, outdated)
+	}
+	parts := []string{
+		m.ctx.Styles.Common.MainTextStyle.MarginBottom(1).Underline(true).Render(title),
+	}
+
+	if len(threads) == 0 {
+		parts = append(parts, lipgloss.NewStyle().Italic(true).Render("No review threads..."))
+	}
+
+	selectedLine := 0
+	markdownRenderer := markdown.GetMarkdownRenderer(m.getIndentedContentWidth()-2, m.ctx)
+	for i, thread := range threads {
+		if i == 0 || threads[i-1].Path != thread.Path {
+			if i > 0 {
+				parts = append(parts, "")
+			}
+			parts = append(parts, lipgloss.NewStyle().
+				Bold(true).
+				Foreground(m.ctx.Theme.PrimaryText).
+				Render(thread.Path))
+		}
+
+		if thread.Id == m.threads.id {
+			selectedLine = lipgloss.Height(lipgloss.JoinVertical(lipgloss.Left, parts...))
+		}
+		parts = append(parts, m.renderThread(thread, markdownRenderer))
+	}
+
+	hint := fmt.Sprintf("Select a thread with %s/%s, press %s to reply or %s to resolve it",
+		keys.PRKeys.PrevDiffHunk.Help().Key,
+		keys.PRKeys.NextDiffHunk.Help().Key,
+		keys.PRKeys.Comment.Help().Key,
+		keys.PRKeys.ResolveThread.Help().Key,
+	)
+	if outdated > 0 {
+		hint = fmt.Sprintf("%s, %s to toggle outdated threads", hint,
+			keys.PRKeys.ToggleOutdated.Help().Key)
+	}
+	parts = append(parts, "", m.ctx.Styles.Common.FaintTextStyle.Italic(true).
+		Width(m.getIndentedContentWidth()).Render(hint))
+
+	if m.editor.Mode() == cmpcontroller.ModeReply {
+		parts = append(parts, m.ctx.Styles.Sidebar.InputBox.Render(m.editor.View()))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, parts...), selectedLine
+}
+
+func (m *Model) renderThread(thread data.ReviewThread, markdownRenderer glamour.TermRenderer) string {
+	width := m.getIndentedContentWidth()
+	faint := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText)
+
+	prefix := " "
+	if thread.Id == m.threads.id {
+		prefix = constants.SelectionIcon
+	}
+	lines := fmt.Sprintf("L%d", threadLine(thread))
+	if thread.StartLine != 0 && thread.StartLine != thread.Line {
+		lines = fmt.Sprintf("L%d-%d", thread.StartLine, thread.Line)
+	}
+	status := lipgloss.NewStyle().Foreground(m.ctx.Theme.WarningText).Render("Unresolved")
+	if thread.IsResolved {
+		status = lipgloss.NewStyle().
+			Foreground(m.ctx.Theme.SuccessText).
+			Render(constants.ApprovedIcon + " Resolved")
+	}
+	header := []string{prefix, " ", faint.Render(lines), " ", status}
+	if thread.IsOutdated {
+		header = append(header, " ", faint.Italic(true).Render("Outdated"))
+	}
+
+	parts := []string{lipgloss.JoinHorizontal(lipgloss.Top, header...)}
+	if len(thread.Comments.Nodes) > 0 {
+		if code := m.renderThreadContext(thread, width); code != "" {
+			parts = append(parts, code)
+		}
+	}
+
+	for _, c := range thread.Comments.Nodes {
+		author := lipgloss.JoinHorizontal(
+			lipgloss.Top,
+			m.ctx.Styles.Common.MainTextStyle.Render("@"+c.Author.Login),
+			" ",
+			faint.Render(utils.TimeElapsed(c.UpdatedAt)),
+		)
+		body, err := markdownRenderer.Render(lineCleanupRegex.ReplaceAllString(c.Body, ""))
+		if err != nil {
+			body = c.Body
+		}
+		parts = append(parts, lipgloss.NewStyle().PaddingLeft(2).Render(
+			lipgloss.JoinVertical(lipgloss.Left, author, strings.TrimRight(body, "\n"))))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, parts...)
+}
+
+// renderThreadContext renders the last lines of the hunk a thread was left on, which end at
+// the commented line.
+func (m *Model) renderThreadContext(thread data.ReviewThread, width int) string {
+	diffHunk := thread.Comments.Nodes[0].DiffHunk
+	lines := strings.Split(strings.TrimRight(diffHunk, "\n"), "\n")
+	lines = slices.DeleteFunc(lines, func(line string) bool {
+		return strings.HasPrefix(line, "@@")
+	})
+	if len(lines) == 0 {
+		return ""
+	}
+	lines = lines[max(0, len(lines)-threadContextLines):]
+
+	highlighted := highlightDiffLines(thread.Path, lines, m.ctx.HasDarkBackground)
+	rendered := make([]string, 0, len(lines))
+	for i, line := range lines {
+		code := ""
+		if highlighted != nil {
+			code = highlighted[i]
+		}
+		rendered = append(rendered, m.renderDiffLine(line, code, width-4))
+	}
+	return lipgloss.NewStyle().
+		MarginLeft(2).
+		PaddingLeft(1).
+		Border(lipgloss.NormalBorder(), false, false, false, true).
+		BorderForeground(m.ctx.Theme.FaintBorder).
+		Render(strings.Join(rendered, "\n"))
+}
@@ -0,0 +1,85 @@
+package prview
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+func newThreadsTestModel(t *testing.T) Model {
+	t.Helper()
+	pr := &data.PullRequestData{Number: 1, Url: "https://github.com/o/r/pull/1"}
+	m := newTestModelWithWidth(t, pr, nil, nil, 80)
+	comment := func(body string) data.ReviewComments {
+		c := data.ReviewComment{
+			Body:     body,
+			DiffHunk: "@@ -1,3 +1,3 @@\n line one\n-old line\n+new line",
+		}
+		c.Author.Login = "octocat"
+		return data.ReviewComments{Nodes: []data.ReviewComment{c}, TotalCount: 1}
+	}
+	m.pr.Data.Enriched.ReviewThreads.Nodes = []data.ReviewThread{
+		{Id: "b20", Path: "b.go", Line: 20, Comments: comment("later in b")},
+		{Id: "a5", Path: "a.go", Line: 5, IsResolved: true, Comments: comment("resolved in a")},
+		{Id: "b3", Path: "b.go", Line: 3, Comments: comment("early in b")},
+		{Id: "old", Path: "a.go", OriginalLine: 9, IsOutdated: true, Comments: comment("outdated")},
+	}
+	m.GoToThreadsTab()
+	return m
+}
+
+func threadIds(threads []data.ReviewThread) []string {
+	ids := make([]string, 0, len(threads))
+	for _, thread := range threads {
+		ids = append(ids, thread.Id)
+	}
+	return ids
+}
+
+func TestVisibleThreads(t *testing.T) {
+	m := newThreadsTestModel(t)
+	require.Equal(t, []string{"a5", "b3", "b20"}, threadIds(m.visibleThreads()))
+
+	m.threads.showOutdated = true
+	require.Equal(t, []string{"a5", "old", "b3", "b20"}, threadIds(m.visibleThreads()))
+}
+
+func TestThreadSelection(t *testing.T) {
+	m := newThreadsTestModel(t)
+	require.False(t, m.HasSelectedThread())
+
+	m.selectThread(1)
+	require.Equal(t, "a5", m.threads.id)
+	m.selectThreadFile(1)
+	require.Equal(t, "b3", m.threads.id)
+	m.selectThread(1)
+	require.Equal(t, "b20", m.threads.id)
+	m.selectThread(1)
+	require.Equal(t, "b20", m.threads.id)
+	m.selectThreadFile(-1)
+	require.Equal(t, "a5", m.threads.id)
+	require.True(t, m.HasSelectedThread())
+
+	m.carousel.SetCursor(0)
+	require.False(t, m.HasSelectedThread(), "threads are only selectable in the Threads tab")
+}
+
+func TestRenderThreads(t *testing.T) {
+	m := newThreadsTestModel(t)
+	m.threads.id = "b20"
+
+	view := ansi.Strip(m.View())
+	require.Contains(t, view, "3 threads (1 outdated hidden)")
+	require.Contains(t, view, "Resolved")
+	require.Contains(t, view, "+new line", "the commented code should be shown")
+	require.NotContains(t, view, "outdated\n")
+
+	lines := strings.Split(view, "\n")
+	offset := m.SelectionOffset()
+	require.Less(t, offset, len(lines))
+	require.Contains(t, lines[offset], "L20")
+}
@@ -0,0 +1,195 @@
+package prview
+
+import (
+	"errors"
+	"fmt"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/common"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/cmpcontroller"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/fuzzyselect"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prssection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
+)
+
+type activityState struct {
+	// entry is the key of the selected entry of the Activity tab, reactions and timeline
+	// actions apply to it instead of the PR
+	entry string
+}
+
+func (m Model) IsActivityTabSelected() bool {
+	return m.carousel.SelectedItem() == tabs[activityTabIndex]
+}
+
+// subjectName names the PR in prompts and task texts.
+func (m *Model) subjectName() string {
+	return fmt.Sprintf("pr #%d", m.pr.Data.Primary.Number)
+}
+
+// timelineEntries returns the comments, reviews, review comments and events of the PR, oldest
+// first.
+func (m *Model) timelineEntries() []common.TimelineEntry {
+	if !m.hasData() || !m.pr.Data.IsEnriched {
+		return nil
+	}
+
+	enriched := m.pr.Data.Enriched
+	var entries []common.TimelineEntry
+	for _, thread := range enriched.ReviewThreads.Nodes {
+		for _, c := range thread.Comments.Nodes {
+			entries = append(entries, common.TimelineEntry{
+				Id:             c.Id,
+				Typename:       data.TimelineReviewComment,
+				Author:         c.Author.Login,
+				Body:           c.Body,
+				UpdatedAt:      c.UpdatedAt,
+				Meta:           c.CommentMeta,
+				ReactionGroups: c.ReactionGroups,
+				Path:           thread.Path,
+				Line:           thread.Line,
+			})
+		}
+	}
+
+	for _, c := range enriched.Comments.Nodes {
+		entries = append(entries, common.TimelineEntry{
+			Id:             c.Id,
+			Typename:       data.TimelineIssueComment,
+			Author:         c.Author.Login,
+			Body:           c.Body,
+			UpdatedAt:      c.UpdatedAt,
+			Meta:           c.CommentMeta,
+			ReactionGroups: c.ReactionGroups,
+		})
+	}
+
+	for _, review := range enriched.Reviews.Nodes {
+		entries = append(entries, common.TimelineEntry{
+			Id:             review.Id,
+			Typename:       data.TimelineReview,
+			Author:         review.Author.Login,
+			Body:           review.Body,
+			UpdatedAt:      review.UpdatedAt,
+			Meta:           review.CommentMeta,
+			ReactionGroups: review.ReactionGroups,
+			ReviewState:    review.State,
+		})
+	}
+
+	entries = append(entries, common.TimelineEntriesFromEvents(enriched.TimelineItems)...)
+	common.SortTimeline(entries)
+	return entries
+}
+
+func (m *Model) selectedEntry() *common.TimelineEntry {
+	return common.FindTimelineEntry(m.timelineEntries(), m.activity.entry)
+}
+
+func (m *Model) updateActivity(msg tea.KeyMsg) {
+	switch {
+	case key.Matches(msg, keys.PRKeys.PrevDiffHunk):
+		m.activity.entry = common.SelectTimelineEntry(m.timelineEntries(), m.activity.entry, -1)
+	case key.Matches(msg, keys.PRKeys.NextDiffHunk):
+		m.activity.entry = common.SelectTimelineEntry(m.timelineEntries(), m.activity.entry, 1)
+	}
+}
+
+// HasSelectedEntry returns whether an entry of the Activity tab is selected and shown, so
+// the timeline actions apply to it.
+func (m *Model) HasSelectedEntry() bool {
+	return m.IsActivityTabSelected() && m.selectedEntry() != nil
+}
+
+func (m *Model) GetIsActingOnEntry() bool {
+	return m.editor.Mode() == cmpcontroller.ModeTimelineAction
+}
+
+// SetIsActingOnEntry enters or exits picking an action on the selected entry of the Activity
+// tab.
+func (m *Model) SetIsActingOnEntry(isActing bool) tea.Cmd {
+	if m.pr == nil || m.pr.Data.Primary == nil || !m.pr.Data.IsEnriched {
+		return nil
+	}
+
+	if !isActing {
+		if m.editor.Mode() == cmpcontroller.ModeTimelineAction {
+			m.editor.Exit()
+		}
+		return nil
+	}
+
+	entry := m.selectedEntry()
+	if entry == nil || len(entry.Actions()) == 0 {
+		m.ctx.Error = errors.New("select a comment, review or event in the Activity tab first")
+		return nil
+	}
+
+	m.editor.SetAutocompleteSource(&fuzzyselect.TimelineActionSource{Actions: entry.Actions()})
+	cmd := m.editor.Enter(cmpcontroller.EnterOptions{
+		Mode:   cmpcontroller.ModeTimelineAction,
+		Prompt: fmt.Sprintf(constants.TimelineActionPrompt, entry.Name(m.subjectName())),
+		Repo:   m.repoRef(),
+	})
+	m.editor.ShowCompletions()
+	return cmd
+}
+
+// actOnEntry runs the picked action on the selected entry of the Activity tab.
+func (m *Model) actOnEntry(value string) tea.Cmd {
+	entry := m.selectedEntry()
+	if entry == nil {
+		return nil
+	}
+	action, ok := fuzzyselect.ParseTimelineAction(value, entry.Actions())
+	if !ok {
+		return nil
+	}
+
+	sid := tasks.SectionIdentifier{Id: m.sectionId, Type: prssection.SectionType}
+	comment := tasks.TimelineComment{
+		Id:       entry.Id,
+		Typename: entry.Typename,
+		Body:     entry.Body,
+		Name:     entry.Name(m.subjectName()),
+	}
+	switch action {
+	case common.TimelineQuoteReply:
+		m.editor.SetAutocompleteSource(&fuzzyselect.UserMentionSource{WithAtSymbol: true})
+		return m.editor.Enter(cmpcontroller.EnterOptions{
+			Mode:                             cmpcontroller.ModeComment,
+			Prompt:                           m.prompt(constants.CommentPrompt),
+			InitialValue:                     common.QuoteReply(entry.Body),
+			Repo:                             m.repoRef(),
+			EnterFetch:                       cmpcontroller.FetchSilent,
+			ConfirmDiscardOnCancel:           true,
+			HideAutocompleteWhenContextEmpty: true,
+		})
+	case common.TimelineCopyLink:
+		return tasks.CopyLink(m.ctx, entry.Url())
+	case common.TimelineEdit:
+		cmd, err := tasks.EditPRComment(m.ctx, sid, m.pr.Data.Primary, comment)
+		if err != nil {
+			m.ctx.Error = err
+		}
+		return cmd
+	case common.TimelineDelete:
+		return func() tea.Msg {
+			return section.ConfirmMsg{
+				Prompt: fmt.Sprintf("Are you sure you want to delete %s? (y/N) ", comment.Name),
+				Action: func() tea.Cmd {
+					return tasks.DeletePRComment(m.ctx, sid, m.pr.Data.Primary, comment)
+				},
+			}
+		}
+	case common.TimelineHideOutdated:
+		return tasks.HidePRComment(m.ctx, sid, m.pr.Data.Primary, comment)
+	}
+	return nil
+}
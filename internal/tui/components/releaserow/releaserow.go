@@ -0,0 +1,87 @@
+package releaserow
+
+import (
+	"fmt"
+
+	"charm.land/lipgloss/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/table"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+type Release struct {
+	Ctx  *context.ProgramContext
+	Data data.ReleaseData
+}
+
+func (release *Release) ToTableRow() table.Row {
+	return table.Row{
+		release.renderState(),
+		release.renderRepoName(),
+		release.renderName(),
+		release.renderTag(),
+		release.renderAuthor(),
+		release.renderNumAssets(),
+		release.renderPublishedAt(),
+	}
+}
+
+func (release *Release) getTextStyle() lipgloss.Style {
+	return components.GetIssueTextStyle(release.Ctx)
+}
+
+func (release *Release) renderState() string {
+	switch {
+	case release.Data.IsDraft:
+		return release.getTextStyle().Render(constants.DraftIcon)
+	case release.Data.IsLatest:
+		return lipgloss.NewStyle().
+			Foreground(release.Ctx.Theme.SuccessText).
+			Render(constants.ReleaseIcon)
+	case release.Data.IsPrerelease:
+		return lipgloss.NewStyle().
+			Foreground(release.Ctx.Theme.WarningText).
+			Render(constants.ReleaseIcon)
+	default:
+		return release.getTextStyle().Render(constants.ReleaseIcon)
+	}
+}
+
+func (release *Release) renderRepoName() string {
+	return release.getTextStyle().Render(release.Data.Repository.Name)
+}
+
+func (release *Release) renderName() string {
+	return release.Ctx.Styles.Common.MainTextStyle.Render(release.Data.GetTitle())
+}
+
+func (release *Release) renderTag() string {
+	return release.getTextStyle().Render(release.Data.TagName)
+}
+
+func (release *Release) renderAuthor() string {
+	return release.getTextStyle().Render(release.Data.Author.Login)
+}
+
+func (release *Release) renderNumAssets() string {
+	return release.getTextStyle().Render(
+		fmt.Sprintf("%d", release.Data.ReleaseAssets.TotalCount))
+}
+
+func (release *Release) renderPublishedAt() string {
+	timeFormat := release.Ctx.Config.Defaults.DateFormat
+
+	publishedAtOutput := ""
+	publishedAt := release.Data.GetPublishedAt()
+	if timeFormat == "" || timeFormat == "relative" {
+		publishedAtOutput = utils.TimeElapsed(publishedAt)
+	} else {
+		publishedAtOutput = publishedAt.Format(timeFormat)
+	}
+
+	return release.getTextStyle().Render(publishedAtOutput)
+}
@@ -0,0 +1,406 @@
+package releasessection
+
+import (
+	"fmt"
+	"time"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/releaserow"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/table"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+const SectionType = "release"
+
+var releaseNumAssetsCellWidth = 6
+
+type Model struct {
+	section.BaseModel
+	Releases []data.ReleaseData
+}
+
+func NewModel(
+	id int,
+	ctx *context.ProgramContext,
+	cfg config.ReleasesSectionConfig,
+	lastUpdated time.Time,
+	createdAt time.Time,
+) Model {
+	m := Model{}
+	m.BaseModel = section.NewModel(
+		ctx,
+		section.NewSectionOptions{
+			Id:          id,
+			Config:      cfg.ToSectionConfig(),
+			Type:        SectionType,
+			Columns:     GetSectionColumns(cfg, ctx),
+			Singular:    m.GetItemSingularForm(),
+			Plural:      m.GetItemPluralForm(),
+			LastUpdated: lastUpdated,
+			CreatedAt:   createdAt,
+		},
+	)
+	m.Releases = []data.ReleaseData{}
+
+	return m
+}
+
+func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.KeyPressMsg:
+
+		if m.IsSearchFocused() {
+			switch msg.String() {
+			case "ctrl+c", "esc":
+				m.SearchBar.SetValue(m.SearchValue)
+				blinkCmd := m.SetIsSearching(false)
+				return m, blinkCmd
+
+			case "enter":
+				m.SearchValue = m.SearchBar.Value()
+				m.SyncSmartFilterWithSearchValue()
+				m.SetIsSearching(false)
+				m.ResetRows()
+				return m, tea.Batch(m.FetchNextPageSectionRows()...)
+			}
+
+			break
+		}
+
+		if key.Matches(msg, keys.ReleaseKeys.ToggleSmartFiltering) {
+			if m.HasCurrentRepoNameInConfiguredFilter() || !m.HasRepoNameInConfiguredFilter() {
+				m.IsFilteredByCurrentRemote = !m.IsFilteredByCurrentRemote
+			}
+			searchValue := m.GetSearchValue()
+			if m.SearchValue != searchValue {
+				m.SearchValue = searchValue
+				m.SearchBar.SetValue(searchValue)
+				m.SetIsSearching(false)
+				m.ResetRows()
+				return m, tea.Batch(m.FetchNextPageSectionRows()...)
+			}
+		}
+
+	case tasks.UpdateReleaseMsg:
+		for i := range m.Releases {
+			if m.Releases[i].Id == msg.ReleaseId {
+				if msg.PublishedAt != nil {
+					m.Releases[i].IsDraft = false
+					m.Releases[i].PublishedAt = msg.PublishedAt
+				}
+				m.Table.SetRows(m.BuildRows())
+				break
+			}
+		}
+
+	case tasks.ReleaseCreatedMsg:
+		m.ResetRows()
+		return m, tea.Batch(m.FetchNextPageSectionRows()...)
+
+	case SectionReleasesFetchedMsg:
+		if m.LastFetchTaskId == msg.TaskId {
+			m.IsStale = false
+			if m.PageInfo != nil {
+				m.Releases = append(m.Releases, msg.Releases...)
+			} else {
+				m.Releases = msg.Releases
+			}
+			m.TotalCount = msg.TotalCount
+			m.SetIsLoading(false)
+			m.PageInfo = &msg.PageInfo
+			m.Table.SetRows(m.BuildRows())
+			m.UpdateLastUpdated(time.Now())
+			m.UpdateTotalItemsCount(m.TotalCount)
+		}
+	}
+
+	search, searchCmd := m.SearchBar.Update(msg)
+	m.SearchBar = search
+
+	prompt, promptCmd := m.PromptConfirmationBox.Update(msg)
+	m.PromptConfirmationBox = prompt
+
+	table, tableCmd := m.Table.Update(msg)
+	m.Table = table
+
+	return m, tea.Batch(cmd, searchCmd, promptCmd, tableCmd)
+}
+
+func GetSectionColumns(
+	cfg config.ReleasesSectionConfig,
+	ctx *context.ProgramContext,
+) []table.Column {
+	dLayout := ctx.Config.Defaults.Layout.Releases
+	sLayout := cfg.Layout
+
+	stateLayout := config.MergeColumnConfigs(dLayout.State, sLayout.State)
+	repoLayout := config.MergeColumnConfigs(dLayout.Repo, sLayout.Repo)
+	nameLayout := config.MergeColumnConfigs(dLayout.Name, sLayout.Name)
+	tagLayout := config.MergeColumnConfigs(dLayout.Tag, sLayout.Tag)
+	authorLayout := config.MergeColumnConfigs(dLayout.Author, sLayout.Author)
+	assetsLayout := config.MergeColumnConfigs(dLayout.Assets, sLayout.Assets)
+	publishedAtLayout := config.MergeColumnConfigs(
+		dLayout.PublishedAt,
+		sLayout.PublishedAt,
+	)
+
+	return []table.Column{
+		{
+			Title:  "",
+			Width:  stateLayout.Width,
+			Hidden: stateLayout.Hidden,
+		},
+		{
+			Title:  "",
+			Width:  repoLayout.Width,
+			Hidden: repoLayout.Hidden,
+		},
+		{
+			Title:  "Name",
+			Grow:   utils.BoolPtr(true),
+			Hidden: nameLayout.Hidden,
+		},
+		{
+			Title:  "Tag",
+			Width:  tagLayout.Width,
+			Hidden: tagLayout.Hidden,
+		},
+		{
+			Title:  "Author",
+			Width:  authorLayout.Width,
+			Hidden: authorLayout.Hidden,
+		},
+		{
+			Title:  "Assets",
+			Width:  &releaseNumAssetsCellWidth,
+			Hidden: assetsLayout.Hidden,
+		},
+		{
+			Title:  "󱦻",
+			Width:  publishedAtLayout.Width,
+			Hidden: publishedAtLayout.Hidden,
+		},
+	}
+}
+
+func (m Model) BuildRows() []table.Row {
+	var rows []table.Row
+	for _, currRelease := range m.Releases {
+		releaseModel := releaserow.Release{
+			Ctx:  m.Ctx,
+			Data: currRelease,
+		}
+		rows = append(rows, releaseModel.ToTableRow())
+	}
+
+	if rows == nil {
+		rows = []table.Row{}
+	}
+
+	return rows
+}
+
+func (m *Model) NumRows() int {
+	return len(m.Releases)
+}
+
+func (m *Model) GetCurrRow() data.RowData {
+	idx := m.Table.GetCurrItem()
+	if idx < 0 || idx >= len(m.Releases) {
+		return nil
+	}
+	release := m.Releases[idx]
+	return &release
+}
+
+// ToggleSelection does nothing as releases don't support bulk actions.
+func (m *Model) ToggleSelection() {}
+
+func (m *Model) GetSelectedRows() []data.RowData {
+	return nil
+}
+
+func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
+	if m == nil {
+		return nil
+	}
+
+	if m.PageInfo != nil && !m.PageInfo.HasNextPage {
+		return nil
+	}
+
+	var cmds []tea.Cmd
+
+	startCursor := time.Now().String()
+	if m.PageInfo != nil {
+		startCursor = m.PageInfo.StartCursor
+	}
+	taskId := fmt.Sprintf("fetching_releases_%d_%s", m.Id, startCursor)
+	isFirstFetch := m.LastFetchTaskId == ""
+	m.LastFetchTaskId = taskId
+	task := context.Task{
+		Id:        taskId,
+		StartText: fmt.Sprintf(`Fetching releases for "%s"`, m.Config.Title),
+		FinishedText: fmt.Sprintf(
+			`Releases for "%s" have been fetched`,
+			m.Config.Title,
+		),
+		State: context.TaskStart,
+		Error: nil,
+	}
+	startCmd := m.Ctx.StartTask(task)
+	cmds = append(cmds, startCmd)
+
+	limit := m.Config.Limit
+	if limit == nil {
+		limit = &m.Ctx.Config.Defaults.ReleasesLimit
+	}
+	isFirstPage := m.PageInfo == nil
+	if isFirstPage {
+		m.CacheKey = data.SectionCacheKey(m.Type, m.GetFilters(), *limit)
+	}
+	cacheKey := m.CacheKey
+
+	if isFirstFetch {
+		m.warmStartFromCache()
+	}
+
+	fetchCmd := func() tea.Msg {
+		res, err := data.FetchReleases(m.GetFilters(), *limit, m.PageInfo)
+		if err != nil {
+			return constants.TaskFinishedMsg{
+				SectionId:   m.Id,
+				SectionType: m.Type,
+				TaskId:      taskId,
+				Err:         err,
+			}
+		}
+
+		if isFirstPage {
+			data.GetSectionCache().Put(cacheKey, res)
+		}
+
+		return constants.TaskFinishedMsg{
+			SectionId:   m.Id,
+			SectionType: m.Type,
+			TaskId:      taskId,
+			Msg: SectionReleasesFetchedMsg{
+				Releases:   res.Releases,
+				TotalCount: res.TotalCount,
+				PageInfo:   res.PageInfo,
+				TaskId:     taskId,
+			},
+		}
+	}
+	cmds = append(cmds, fetchCmd)
+
+	return cmds
+}
+
+// warmStartFromCache shows the last known results for the section, marked as
+// stale, until the fetch that is in flight replaces them.
+func (m *Model) warmStartFromCache() {
+	var cached data.ReleasesResponse
+	fetchedAt, ok := data.GetSectionCache().Get(m.CacheKey, m.CacheMaxAge(), &cached)
+	if !ok {
+		return
+	}
+
+	m.Releases = cached.Releases
+	m.TotalCount = cached.TotalCount
+	m.IsStale = true
+	m.Table.SetRows(m.BuildRows())
+	m.UpdateLastUpdated(fetchedAt)
+	m.UpdateTotalItemsCount(m.TotalCount)
+}
+
+func (m *Model) UpdateLastUpdated(t time.Time) {
+	m.Table.UpdateLastUpdated(t)
+}
+
+func (m *Model) ResetRows() {
+	m.Releases = nil
+	m.BaseModel.ResetRows()
+}
+
+func FetchAllSections(
+	ctx *context.ProgramContext,
+) (sections []section.Section, fetchAllCmd tea.Cmd) {
+	sectionConfigs := ctx.Config.ReleasesSections
+	fetchReleasesCmds := make([]tea.Cmd, 0, len(sectionConfigs))
+	sections = make([]section.Section, 0, len(sectionConfigs))
+	for i, sectionConfig := range sectionConfigs {
+		sectionModel := NewModel(
+			i+1,
+			ctx,
+			sectionConfig,
+			time.Now(),
+			time.Now(),
+		) // 0 is the search section
+		sections = append(sections, &sectionModel)
+		fetchReleasesCmds = append(
+			fetchReleasesCmds,
+			sectionModel.FetchNextPageSectionRows()...)
+	}
+	return sections, tea.Batch(fetchReleasesCmds...)
+}
+
+type SectionReleasesFetchedMsg struct {
+	Releases   []data.ReleaseData
+	TotalCount int
+	PageInfo   data.PageInfo
+	TaskId     string
+}
+
+func (m Model) GetItemSingularForm() string {
+	return "Release"
+}
+
+func (m Model) GetItemPluralForm() string {
+	return "Releases"
+}
+
+func (m Model) GetTotalCount() int {
+	return m.TotalCount
+}
+
+func (m *Model) GetIsLoading() bool {
+	return m.IsLoading
+}
+
+func (m *Model) SetIsLoading(val bool) {
+	m.IsLoading = val
+	m.Table.SetIsLoading(val)
+}
+
+func (m Model) GetPagerContent() string {
+	pagerContent := ""
+	lastUpdated := m.LastUpdated().Format("01/02 15:04:05")
+	if m.IsStale {
+		lastUpdated = "Cached " + lastUpdated
+	}
+	if m.TotalCount > 0 {
+		pagerContent = fmt.Sprintf(
+			"%v %v • %v %v/%v • Fetched %v",
+			constants.WaitingIcon,
+			lastUpdated,
+			m.SingularForm,
+			m.Table.GetCurrItem()+1,
+			m.TotalCount,
+			len(m.Table.Rows),
+		)
+	}
+	pager := m.Ctx.Styles.ListViewPort.PagerStyle.Render(pagerContent)
+	return pager
+}
@@ -0,0 +1,410 @@
+package releaseview
+
+import (
+	"fmt"
+	"image/color"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/common"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/cmpcontroller"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/inputbox"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/releasessection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/markdown"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+// maxShownCommits is the number of commits listed before the rest are summarized.
+const maxShownCommits = 50
+
+type Model struct {
+	ctx       *context.ProgramContext
+	release   *data.ReleaseData
+	sectionId int
+	width     int
+	editor    cmpcontroller.Controller
+	commits   commitsState
+}
+
+// commitsState holds the commits since the previous release of the shown release, which are
+// fetched when the release is shown.
+type commitsState struct {
+	key     string
+	loading bool
+	err     error
+	data    data.ReleaseCommits
+}
+
+func NewModel(ctx *context.ProgramContext) Model {
+	ta := inputbox.DefaultTextArea(ctx)
+	cmp := cmpcontroller.New(ctx, inputbox.ModelOpts{TextArea: &ta})
+
+	return Model{
+		ctx:     ctx,
+		release: nil,
+		editor:  cmp,
+	}
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	cmd, handled := m.editor.Update(msg)
+
+	if msg, ok := msg.(tea.KeyMsg); ok && msg.String() == "ctrl+d" {
+		value := strings.TrimSpace(m.editor.Value())
+		mode := m.editor.Mode()
+		m.editor.Exit()
+		if m.release == nil {
+			return m, nil
+		}
+
+		if mode == cmpcontroller.ModeReleaseTag && value != "" {
+			sid := tasks.SectionIdentifier{Id: m.sectionId, Type: releasessection.SectionType}
+			return m, tasks.CreateReleaseFromTag(m.ctx, sid, m.release.GetRepoNameWithOwner(),
+				value)
+		}
+		return m, nil
+	}
+	if handled {
+		return m, cmd
+	}
+
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.release != nil {
+		if key.Matches(keyMsg, keys.ReleaseKeys.Publish) {
+			return m, m.publish()
+		}
+	}
+
+	return m, cmd
+}
+
+func (m *Model) publish() tea.Cmd {
+	if !m.release.IsDraft {
+		return errCmd(fmt.Errorf("release %s is already published", m.release.TagName))
+	}
+
+	sid := tasks.SectionIdentifier{Id: m.sectionId, Type: releasessection.SectionType}
+	return tasks.PublishRelease(m.ctx, sid, m.release)
+}
+
+func errCmd(err error) tea.Cmd {
+	return func() tea.Msg {
+		return constants.ErrMsg{Err: err}
+	}
+}
+
+type CommitsFetchedMsg struct {
+	Key     string
+	Commits data.ReleaseCommits
+	Err     error
+}
+
+func commitsKey(release *data.ReleaseData) string {
+	return release.GetRepoNameWithOwner() + "@" + release.TagName
+}
+
+// LoadCommits fetches the commits since the previous release if they aren't loaded yet.
+func (m *Model) LoadCommits() tea.Cmd {
+	if !m.hasData() {
+		return nil
+	}
+
+	key := commitsKey(m.release)
+	if m.commits.key == key {
+		return nil
+	}
+
+	m.commits = commitsState{key: key, loading: true}
+	repo := m.release.GetRepoNameWithOwner()
+	tagName := m.release.TagName
+	return func() tea.Msg {
+		commits, err := data.FetchReleaseCommits(repo, tagName)
+		return CommitsFetchedMsg{Key: key, Commits: commits, Err: err}
+	}
+}
+
+// SetCommits stores fetched commits and reports whether they belong to the current release.
+func (m *Model) SetCommits(msg CommitsFetchedMsg) bool {
+	if m.commits.key != msg.Key {
+		return false
+	}
+
+	m.commits.loading = false
+	m.commits.err = msg.Err
+	m.commits.data = msg.Commits
+	return true
+}
+
+func (m Model) View() string {
+	s := strings.Builder{}
+
+	s.WriteString(m.renderTagAndRepo())
+	s.WriteString("\n")
+
+	s.WriteString(m.renderName())
+	s.WriteString("\n\n")
+	s.WriteString(m.renderStatusPill())
+	s.WriteString("\n\n")
+	s.WriteString(m.renderAuthor())
+	s.WriteString("\n\n")
+
+	s.WriteString(m.renderBody())
+	s.WriteString("\n\n")
+	s.WriteString(m.renderAssets())
+	s.WriteString("\n\n")
+	s.WriteString(m.renderCommits())
+	s.WriteString("\n\n")
+	s.WriteString(m.renderHint())
+
+	if m.editor.Mode() != cmpcontroller.ModeNone {
+		s.WriteString("\n")
+		s.WriteString(m.ctx.Styles.Sidebar.InputBox.Render(m.editor.View()))
+	}
+
+	return lipgloss.NewStyle().Padding(0, m.ctx.Styles.Sidebar.ContentPadding).Render(s.String())
+}
+
+func (m *Model) ViewCompletions() string {
+	if !m.hasData() {
+		return ""
+	}
+
+	return m.editor.ViewCompletions()
+}
+
+func (m *Model) InputBoxLineFromBottom() int {
+	return m.editor.LineFromBottom()
+}
+
+func (m *Model) renderTagAndRepo() string {
+	return common.RenderPreviewHeader(m.ctx.Theme, m.width,
+		fmt.Sprintf("%s · %s", m.release.TagName, m.release.GetRepoNameWithOwner()))
+}
+
+func (m *Model) renderName() string {
+	return common.RenderPreviewTitle(m.ctx.Theme, m.ctx.Styles.Common, m.width,
+		m.release.GetTitle())
+}
+
+func (m *Model) renderStatusPill() string {
+	var bgColor color.Color
+	content := ""
+	switch {
+	case m.release.IsDraft:
+		bgColor = m.ctx.Styles.Colors.ClosedIssue.Dark
+		content = constants.DraftIcon + " Draft"
+	case m.release.IsLatest:
+		bgColor = m.ctx.Styles.Colors.SuccessText.Dark
+		content = constants.ReleaseIcon + " Latest"
+	case m.release.IsPrerelease:
+		bgColor = m.ctx.Theme.WarningText.Dark
+		content = constants.ReleaseIcon + " Pre-release"
+	default:
+		bgColor = m.ctx.Styles.Colors.OpenIssue.Dark
+		content = constants.ReleaseIcon + " Published"
+	}
+
+	return m.ctx.Styles.PrView.PillStyle.
+		BorderForeground(bgColor).
+		Background(bgColor).
+		Render(content)
+}
+
+func (m *Model) renderAuthor() string {
+	verb := "published"
+	if m.release.IsDraft {
+		verb = "drafted"
+	}
+	faint := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText)
+	return lipgloss.JoinHorizontal(lipgloss.Top,
+		" by ",
+		lipgloss.NewStyle().Foreground(m.ctx.Theme.PrimaryText).Bold(true).
+			Render("@"+m.release.Author.Login),
+		faint.Render(lipgloss.JoinHorizontal(lipgloss.Top,
+			" ⋅ ", verb, " ", utils.TimeElapsed(m.release.GetPublishedAt()), " ago")),
+	)
+}
+
+func (m *Model) renderBody() string {
+	width := m.getIndentedContentWidth()
+	body := strings.TrimSpace(m.release.Description)
+	if body == "" {
+		return lipgloss.NewStyle().
+			Italic(true).
+			Foreground(m.ctx.Theme.FaintText).
+			Render("No release notes provided.")
+	}
+
+	markdownRenderer := markdown.GetMarkdownRenderer(width, m.ctx)
+	rendered, err := markdownRenderer.Render(body)
+	if err != nil {
+		return ""
+	}
+
+	return lipgloss.NewStyle().
+		Width(width).
+		MaxWidth(width).
+		Align(lipgloss.Left).
+		Render(rendered)
+}
+
+func (m *Model) renderTitle(title string) string {
+	return m.ctx.Styles.Common.MainTextStyle.MarginBottom(1).Underline(true).Render(title)
+}
+
+func (m *Model) renderAssets() string {
+	assets := m.release.ReleaseAssets
+	title := fmt.Sprintf("Assets (%d)", assets.TotalCount)
+	if assets.TotalCount > len(assets.Nodes) {
+		title = fmt.Sprintf("Assets (first %d of %d)", len(assets.Nodes), assets.TotalCount)
+	}
+	parts := []string{m.renderTitle(title)}
+
+	if len(assets.Nodes) == 0 {
+		parts = append(parts, lipgloss.NewStyle().Italic(true).Render("No assets..."))
+	}
+
+	faint := m.ctx.Styles.Common.FaintTextStyle
+	for _, asset := range assets.Nodes {
+		parts = append(parts, lipgloss.JoinHorizontal(lipgloss.Top,
+			asset.Name,
+			faint.Render(fmt.Sprintf(" %s · %s downloads", formatSize(asset.Size),
+				utils.ShortNumber(asset.DownloadCount))),
+		))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, parts...)
+}
+
+// formatSize formats a size in bytes using binary units, like GitHub does for assets.
+func formatSize(size int) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	value, exp := float64(size)/unit, 0
+	for value >= unit && exp < 3 {
+		value /= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", value, "KMGT"[exp])
+}
+
+func (m *Model) renderCommits() string {
+	commits := m.commits.data
+	if m.commits.key != commitsKey(m.release) {
+		commits = data.ReleaseCommits{}
+	}
+	title := fmt.Sprintf("%s Commits", constants.CommitIcon)
+	if commits.PreviousTagName != "" {
+		title = fmt.Sprintf("%s since %s (%d)", title, commits.PreviousTagName,
+			commits.TotalCommits)
+	}
+	parts := []string{m.renderTitle(title)}
+
+	faint := m.ctx.Styles.Common.FaintTextStyle
+	switch {
+	case m.commits.loading || m.commits.key != commitsKey(m.release):
+		parts = append(parts, faint.Render("Loading..."))
+	case m.commits.err != nil:
+		parts = append(parts, lipgloss.NewStyle().Foreground(m.ctx.Theme.ErrorText).
+			Width(m.getIndentedContentWidth()).
+			Render(fmt.Sprintf("Failed fetching commits: %v", m.commits.err)))
+	case commits.PreviousTagName == "":
+		parts = append(parts, lipgloss.NewStyle().Italic(true).
+			Render("No previous release to compare with..."))
+	case len(commits.Commits) == 0:
+		parts = append(parts, lipgloss.NewStyle().Italic(true).Render("No commits..."))
+	}
+
+	// The commits are listed newest first, like in the release notes
+	shown := 0
+	for i := len(commits.Commits) - 1; i >= 0 && shown < maxShownCommits; i-- {
+		commit := commits.Commits[i]
+		shown++
+		parts = append(parts, lipgloss.NewStyle().MaxWidth(m.getIndentedContentWidth()).Render(
+			lipgloss.JoinHorizontal(lipgloss.Top,
+				lipgloss.NewStyle().Foreground(m.ctx.Theme.SecondaryText).
+					Render(commit.Sha[:min(7, len(commit.Sha))]),
+				" ",
+				commit.GetHeadline(),
+				faint.Render(" @"+commit.GetAuthor()),
+			)))
+	}
+	if more := commits.TotalCommits - shown; shown > 0 && more > 0 {
+		parts = append(parts, faint.Render(fmt.Sprintf("and %d more...", more)))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, parts...)
+}
+
+func (m *Model) renderHint() string {
+	hint := fmt.Sprintf("Press %s to create a release from a tag",
+		keys.ReleaseKeys.CreateFromTag.Help().Key)
+	if m.release.IsDraft {
+		hint = fmt.Sprintf("Press %s to publish the draft, %s to create a release from a tag",
+			keys.ReleaseKeys.Publish.Help().Key, keys.ReleaseKeys.CreateFromTag.Help().Key)
+	}
+	return m.ctx.Styles.Common.FaintTextStyle.Italic(true).
+		Width(m.getIndentedContentWidth()).Render(hint)
+}
+
+func (m *Model) getIndentedContentWidth() int {
+	return m.width - 6
+}
+
+func (m *Model) SetWidth(width int) {
+	m.width = width
+	m.editor.SetWidth(
+		m.getIndentedContentWidth() - m.ctx.Styles.Sidebar.InputBox.GetHorizontalFrameSize(),
+	)
+}
+
+func (m *Model) SetSectionId(id int) {
+	m.sectionId = id
+}
+
+func (m *Model) SetRow(release *data.ReleaseData) {
+	m.release = release
+}
+
+func (m *Model) IsTextInputBoxFocused() bool {
+	return m.editor.Active()
+}
+
+func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
+	m.ctx = ctx
+	m.editor.UpdateProgramContext(ctx)
+	m.editor.SetSelectStyles(ctx.Styles.Select)
+}
+
+// SetIsCreatingRelease asks for the tag to create a release from, in the repo of the shown
+// release.
+func (m *Model) SetIsCreatingRelease(isCreating bool) tea.Cmd {
+	if m.release == nil {
+		return nil
+	}
+
+	if !isCreating {
+		if m.editor.Mode() == cmpcontroller.ModeReleaseTag {
+			m.editor.Exit()
+		}
+		return nil
+	}
+
+	return m.editor.Enter(cmpcontroller.EnterOptions{
+		Mode:   cmpcontroller.ModeReleaseTag,
+		Prompt: constants.ReleaseTagPrompt,
+	})
+}
+
+func (m *Model) hasData() bool {
+	return m.release != nil
+}
@@ -0,0 +1,109 @@
+package releaseview
+
+import (
+	"errors"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/theme"
+)
+
+func newTestModel(t *testing.T, isDraft bool) Model {
+	t.Helper()
+
+	cfg, err := config.ParseConfig(config.Location{
+		ConfigFlag:       "../../../config/testdata/test-config.yml",
+		SkipGlobalConfig: true,
+	})
+	require.NoError(t, err)
+
+	thm := theme.ParseTheme(&cfg)
+	ctx := &context.ProgramContext{
+		Config:            &cfg,
+		Theme:             thm,
+		Styles:            context.InitStyles(thm),
+		HasDarkBackground: true,
+		BackgroundSource:  "default",
+		StartTask: func(task context.Task) tea.Cmd {
+			return nil
+		},
+	}
+
+	release := &data.ReleaseData{
+		Id:          "RE_1",
+		Name:        "Dash 4.1",
+		TagName:     "v4.1.0",
+		Description: "Bug fixes",
+		IsDraft:     isDraft,
+		ReleaseAssets: data.ReleaseAssets{
+			Nodes:      []data.ReleaseAsset{{Name: "gh-dash_linux", Size: 3 << 20}},
+			TotalCount: 1,
+		},
+	}
+	release.Repository.NameWithOwner = "dlvhdr/gh-dash"
+
+	m := NewModel(ctx)
+	m.UpdateProgramContext(ctx)
+	m.SetWidth(80)
+	m.SetRow(release)
+	return m
+}
+
+func TestViewShowsAssetsAndCommits(t *testing.T) {
+	m := newTestModel(t, false)
+	require.NotNil(t, m.LoadCommits())
+	require.Nil(t, m.LoadCommits(), "commits are only fetched once per release")
+	require.Contains(t, ansi.Strip(m.View()), "Loading...")
+
+	commit := data.ReleaseCommit{Sha: "0123456789abcdef"}
+	commit.Commit.Message = "fix: render drafts\n\nlong description"
+	commit.Commit.Author.Name = "Dolev"
+	require.True(t, m.SetCommits(CommitsFetchedMsg{
+		Key: "dlvhdr/gh-dash@v4.1.0",
+		Commits: data.ReleaseCommits{
+			PreviousTagName: "v4.0.0",
+			Commits:         []data.ReleaseCommit{commit},
+			TotalCommits:    1,
+		},
+	}))
+
+	view := ansi.Strip(m.View())
+	require.Contains(t, view, "gh-dash_linux 3.0 MiB")
+	require.Contains(t, view, "Commits since v4.0.0 (1)")
+	require.Contains(t, view, "0123456 fix: render drafts @Dolev")
+	require.NotContains(t, view, "long description")
+}
+
+func TestSetCommitsIgnoresOtherReleases(t *testing.T) {
+	m := newTestModel(t, false)
+	m.LoadCommits()
+
+	require.False(t, m.SetCommits(CommitsFetchedMsg{
+		Key: "dlvhdr/gh-dash@v4.0.0",
+		Err: errors.New("boom"),
+	}))
+	require.Nil(t, m.commits.err)
+}
+
+func TestPublish(t *testing.T) {
+	m := newTestModel(t, false)
+	msg := m.publish()()
+	require.ErrorContains(t, msg.(constants.ErrMsg).Err, "already published")
+
+	m = newTestModel(t, true)
+	require.NotNil(t, m.publish())
+	require.Contains(t, ansi.Strip(m.View()), "Draft")
+}
+
+func TestFormatSize(t *testing.T) {
+	require.Equal(t, "512 B", formatSize(512))
+	require.Equal(t, "1.5 KiB", formatSize(1536))
+	require.Equal(t, "2.0 GiB", formatSize(2<<30))
+}
@@ -79,6 +79,8 @@ func (m *Model) fastForward() (tea.Cmd, error) {
 
 type pushOptions struct {
 	force bool
+	// forceWithLease only overwrites the remote branch if it's where it was last fetched from
+	forceWithLease bool
 }
 
 func (m *Model) push(opts pushOptions) (tea.Cmd, error) {
@@ -89,6 +91,9 @@ func (m *Model) push(opts pushOptions) (tea.Cmd, error) {
 		if opts.force {
 			return " with force"
 		}
+		if opts.forceWithLease {
+			return " with force-with-lease"
+		}
 		return ""
 	}
 	task := context.Task{
@@ -105,6 +110,9 @@ func (m *Model) push(opts pushOptions) (tea.Cmd, error) {
 		if opts.force {
 			args = append(args, "--force")
 		}
+		if opts.forceWithLease {
+			args = append(args, "--force-with-lease")
+		}
 		if len(b.Data.Remotes) == 0 {
 			args = append(args, "--set-upstream")
 			err = gitm.Push(
@@ -0,0 +1,171 @@
+package reposection
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/git"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
+)
+
+var errNoRebase = errors.New("no rebase is in progress")
+
+// rebaseStoppedErr explains how to get out of a stopped rebase.
+func rebaseStoppedErr(status git.Status) error {
+	return fmt.Errorf(
+		"the rebase of %s stopped on %d conflicts, resolve and stage them, then press %s to "+
+			"continue or %s to abort",
+		status.RebasingBranch,
+		len(status.Conflicts),
+		keys.BranchKeys.ContinueRebase.Help().Key,
+		keys.BranchKeys.AbortRebase.Help().Key,
+	)
+}
+
+// promptRebase asks to confirm rebasing the selected branch.
+func (m *Model) promptRebase() (tea.Cmd, error) {
+	if m.getCurrBranch() == nil {
+		return nil, errors.New("no branch selected")
+	}
+	if m.repo.Status.RebasingBranch != "" {
+		return nil, rebaseStoppedErr(m.repo.Status)
+	}
+	if m.repo.Status.IsDirty() {
+		return nil, errors.New("commit or stash your changes before rebasing")
+	}
+	m.SetPromptConfirmationAction("rebase")
+	return m.SetIsPromptConfirmationShown(true), nil
+}
+
+// rebase rebases the selected branch onto the base branch of its PR, or the default branch of
+// the repo when it has no PR.
+func (m *Model) rebase() tea.Cmd {
+	b := m.getCurrBranch()
+	if b == nil {
+		return nil
+	}
+	onto := ""
+	if b.PR != nil {
+		onto = b.PR.BaseRefName
+	}
+
+	taskId := fmt.Sprintf("rebase_%s_%d", b.Data.Name, time.Now().Unix())
+	task := context.Task{
+		Id:           taskId,
+		StartText:    fmt.Sprintf("Rebasing branch %s", b.Data.Name),
+		FinishedText: fmt.Sprintf("Branch %s has been rebased", b.Data.Name),
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+	startCmd := m.Ctx.StartTask(task)
+	return tea.Batch(startCmd, func() tea.Msg {
+		var err error
+		if onto == "" {
+			onto, err = git.GetDefaultBranch(m.Ctx.RepoPath)
+			if err != nil {
+				return constants.TaskFinishedMsg{TaskId: taskId, Err: err}
+			}
+		}
+		if onto == b.Data.Name {
+			return constants.TaskFinishedMsg{
+				TaskId: taskId,
+				Err:    fmt.Errorf("%s is the branch it would be rebased onto", b.Data.Name),
+			}
+		}
+		return m.rebaseFinishedMsg(taskId, git.Rebase(m.Ctx.RepoPath, b.Data.Name, onto))
+	})
+}
+
+func (m *Model) continueRebase() (tea.Cmd, error) {
+	if m.repo.Status.RebasingBranch == "" {
+		return nil, errNoRebase
+	}
+
+	branch := m.repo.Status.RebasingBranch
+	taskId := fmt.Sprintf("rebase_continue_%d", time.Now().Unix())
+	task := context.Task{
+		Id:           taskId,
+		StartText:    fmt.Sprintf("Continuing the rebase of %s", branch),
+		FinishedText: fmt.Sprintf("Branch %s has been rebased", branch),
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+	startCmd := m.Ctx.StartTask(task)
+	return tea.Batch(startCmd, func() tea.Msg {
+		return m.rebaseFinishedMsg(taskId, git.ContinueRebase(m.Ctx.RepoPath))
+	}), nil
+}
+
+// promptAbortRebase asks to confirm aborting the stopped rebase, as it drops the resolved
+// conflicts.
+func (m *Model) promptAbortRebase() (tea.Cmd, error) {
+	if m.repo.Status.RebasingBranch == "" {
+		return nil, errNoRebase
+	}
+	m.SetPromptConfirmationAction("abort_rebase")
+	return m.SetIsPromptConfirmationShown(true), nil
+}
+
+func (m *Model) abortRebase() tea.Cmd {
+	branch := m.repo.Status.RebasingBranch
+	taskId := fmt.Sprintf("rebase_abort_%d", time.Now().Unix())
+	task := context.Task{
+		Id:           taskId,
+		StartText:    fmt.Sprintf("Aborting the rebase of %s", branch),
+		FinishedText: fmt.Sprintf("The rebase of %s has been aborted", branch),
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+	startCmd := m.Ctx.StartTask(task)
+	return tea.Batch(startCmd, func() tea.Msg {
+		return m.rebaseFinishedMsg(taskId, git.AbortRebase(m.Ctx.RepoPath))
+	})
+}
+
+// rebaseFinishedMsg reads the repo again after a rebase command, so a stopped rebase shows
+// along with its conflicts.
+func (m *Model) rebaseFinishedMsg(taskId string, err error) tea.Msg {
+	repo, readErr := git.GetRepo(m.Ctx.RepoPath)
+	if readErr != nil {
+		return constants.TaskFinishedMsg{TaskId: taskId, Err: errors.Join(err, readErr)}
+	}
+	if errors.Is(err, git.ErrRebaseStopped) {
+		err = rebaseStoppedErr(repo.Status)
+	}
+	return constants.TaskFinishedMsg{
+		SectionId:   0,
+		SectionType: SectionType,
+		TaskId:      taskId,
+		Msg:         repoMsg{repo: repo, resetSelection: true},
+		Err:         err,
+	}
+}
+
+// promptForcePushWithLease asks to confirm force-pushing the selected branch.
+func (m *Model) promptForcePushWithLease() (tea.Cmd, error) {
+	if m.getCurrBranch() == nil {
+		return nil, errors.New("no branch selected")
+	}
+	m.SetPromptConfirmationAction("force_push_lease")
+	return m.SetIsPromptConfirmationShown(true), nil
+}
+
+// renderRebaseStatus renders the state of a stopped rebase and how to get out of it.
+func (m *Model) renderRebaseStatus(s lipgloss.Style) string {
+	status := m.repo.Status
+	text := fmt.Sprintf("%s Rebasing %s", constants.ConflictIcon, status.RebasingBranch)
+	if len(status.Conflicts) > 0 {
+		text += fmt.Sprintf(": %d conflicts", len(status.Conflicts))
+	}
+	text += fmt.Sprintf(" · %s continue · %s abort ",
+		keys.BranchKeys.ContinueRebase.Help().Key,
+		keys.BranchKeys.AbortRebase.Help().Key,
+	)
+	return s.Foreground(m.Ctx.Theme.ErrorText).Render(text)
+}
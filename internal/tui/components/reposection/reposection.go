@@ -121,9 +121,21 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 						case "ready":
 							cmd = tasks.PRReady(m.Ctx, sid, pr)
 						case "merge":
-							cmd = tasks.MergePR(m.Ctx, sid, pr)
+							cmd = tasks.MergePR(
+								m.Ctx, sid, pr, tasks.DefaultMergeOptions(m.Ctx, pr))
 						case "update":
 							cmd = tasks.UpdatePR(m.Ctx, sid, pr)
+						case "prune_worktrees":
+							cmd = tasks.PruneWorktrees(m.Ctx, sid, m.Ctx.RepoPath)
+						case "rebase":
+							cmd = m.rebase()
+						case "abort_rebase":
+							cmd = m.abortRebase()
+						case "force_push_lease":
+							cmd, err = m.push(pushOptions{forceWithLease: true})
+							if err != nil {
+								m.Ctx.Error = err
+							}
 						}
 					}
 				}
@@ -159,6 +171,36 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 			if err != nil {
 				m.Ctx.Error = err
 			}
+		case key.Matches(msg, keys.BranchKeys.OpenShell):
+			cmd, err = m.openShell()
+			if err != nil {
+				m.Ctx.Error = err
+			}
+		case key.Matches(msg, keys.BranchKeys.OpenEditor):
+			cmd, err = m.openEditor()
+			if err != nil {
+				m.Ctx.Error = err
+			}
+		case key.Matches(msg, keys.BranchKeys.ForcePushLease):
+			cmd, err = m.promptForcePushWithLease()
+			if err != nil {
+				m.Ctx.Error = err
+			}
+		case key.Matches(msg, keys.BranchKeys.Rebase):
+			cmd, err = m.promptRebase()
+			if err != nil {
+				m.Ctx.Error = err
+			}
+		case key.Matches(msg, keys.BranchKeys.ContinueRebase):
+			cmd, err = m.continueRebase()
+			if err != nil {
+				m.Ctx.Error = err
+			}
+		case key.Matches(msg, keys.BranchKeys.AbortRebase):
+			cmd, err = m.promptAbortRebase()
+			if err != nil {
+				m.Ctx.Error = err
+			}
 		}
 
 	case tasks.UpdateBranchMsg:
@@ -363,9 +405,14 @@ func GetSectionColumns(
 
 func (m *Model) updateBranchesWithPrs() {
 	branches := make([]branch.Branch, 0)
+	worktrees := m.worktrees()
 	for _, ref := range m.repo.Branches {
 		b := branch.Branch{Ctx: m.Ctx, Data: ref, Columns: m.Table.Columns}
 		b.PR = findPRForRef(m.Prs, ref.Name)
+		b.Worktree = findWorktreeForRef(worktrees, ref.Name)
+		if ref.IsCheckedOut {
+			b.Status = &m.repo.Status
+		}
 
 		branches = append(branches, b)
 	}
@@ -535,6 +582,13 @@ func (m *Model) ResetRows() {
 	m.Prs = nil
 }
 
+// ToggleSelection does nothing as branches don't support bulk actions.
+func (m *Model) ToggleSelection() {}
+
+func (m *Model) GetSelectedRows() []data.RowData {
+	return nil
+}
+
 func (m *Model) GetItemSingularForm() string {
 	return "Branch"
 }
@@ -561,6 +615,10 @@ func (m *Model) GetPagerContent() string {
 	minus := s.Foreground(m.Ctx.Theme.ErrorText).Render(
 		fmt.Sprintf(" %d", len(m.repo.Status.Removed)))
 	spacer := s.Render(" ")
+	if m.repo.Status.RebasingBranch != "" {
+		return m.Ctx.Styles.ListViewPort.PagerStyle.Render(lipgloss.JoinHorizontal(
+			lipgloss.Top, m.renderRebaseStatus(s), plus, spacer, minus, spacer, mod))
+	}
 	return m.Ctx.Styles.ListViewPort.PagerStyle.Render(
 		lipgloss.JoinHorizontal(lipgloss.Top, plus, spacer, minus, spacer, mod))
 }
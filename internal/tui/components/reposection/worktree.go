@@ -0,0 +1,70 @@
+package reposection
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/shell"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+)
+
+// worktrees returns the worktrees PRs of the repo were checked out in.
+func (m *Model) worktrees() []data.Worktree {
+	if m.Ctx.RepoPath == "" {
+		return nil
+	}
+	repoPath, err := filepath.Abs(tasks.ExpandHome(m.Ctx.RepoPath))
+	if err != nil {
+		return nil
+	}
+	return data.GetWorktreeStore().ForRepoPath(repoPath)
+}
+
+func findWorktreeForRef(worktrees []data.Worktree, branch string) *data.Worktree {
+	for _, worktree := range worktrees {
+		if worktree.Branch == branch {
+			return &worktree
+		}
+	}
+	return nil
+}
+
+func (m *Model) currWorktree() (*data.Worktree, error) {
+	b := m.getCurrBranch()
+	if b == nil || b.Worktree == nil {
+		return nil, errors.New("the selected branch isn't checked out in a worktree")
+	}
+	return b.Worktree, nil
+}
+
+func (m *Model) openShell() (tea.Cmd, error) {
+	worktree, err := m.currWorktree()
+	if err != nil {
+		return nil, err
+	}
+	return execInWorktree(shell.Interactive(), worktree, "shell"), nil
+}
+
+func (m *Model) openEditor() (tea.Cmd, error) {
+	worktree, err := m.currWorktree()
+	if err != nil {
+		return nil, err
+	}
+	return execInWorktree(shell.EditorCommand(worktree.Path), worktree, "editor"), nil
+}
+
+func execInWorktree(c *exec.Cmd, worktree *data.Worktree, name string) tea.Cmd {
+	c.Dir = worktree.Path
+	return tea.ExecProcess(c, func(err error) tea.Msg {
+		if err != nil {
+			return constants.ErrMsg{Err: fmt.Errorf("failed running the %s: %w", name, err)}
+		}
+		return nil
+	})
+}
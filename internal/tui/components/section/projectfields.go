@@ -0,0 +1,34 @@
+package section
+
+import (
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/table"
+)
+
+var projectFieldCellWidth = 14
+
+// ProjectFieldColumns returns a column for each project field of a layout, titled by its field.
+func ProjectFieldColumns(cols []config.ProjectFieldColumnConfig) []table.Column {
+	columns := make([]table.Column, 0, len(cols))
+	for _, col := range cols {
+		width := col.Width
+		if width == nil {
+			width = &projectFieldCellWidth
+		}
+		columns = append(columns, table.Column{
+			Title:  col.Field,
+			Width:  width,
+			Hidden: col.Hidden,
+		})
+	}
+	return columns
+}
+
+// ProjectFieldNames returns the fields of the project field columns of a layout, in order.
+func ProjectFieldNames(cols []config.ProjectFieldColumnConfig) []string {
+	fields := make([]string, 0, len(cols))
+	for _, col := range cols {
+		fields = append(fields, col.Field)
+	}
+	return fields
+}
@@ -1,19 +1,13 @@
 package section
 
 import (
-	"bytes"
 	"fmt"
-	"log/slog"
 	"strings"
-	"text/template"
 	"time"
 
 	"charm.land/bubbles/v2/spinner"
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
-	"charm.land/log/v2"
-	"github.com/go-sprout/sprout"
-	timeregistry "github.com/go-sprout/sprout/registry/time"
 
 	"github.com/dlvhdr/gh-dash/v4/internal/config"
 	"github.com/dlvhdr/gh-dash/v4/internal/data"
@@ -49,6 +43,20 @@ type BaseModel struct {
 	ShowAuthorIcon            bool
 	IsFilteredByCurrentRemote bool
 	IsLoading                 bool
+	// CacheKey identifies the section's results in the on-disk section cache.
+	CacheKey string
+	// IsStale is set while the rows shown come from the section cache and
+	// have not yet been replaced by a fresh fetch.
+	IsStale bool
+	// Selection holds the keys of the rows marked for a bulk action.
+	Selection map[string]bool
+	// Sorting is the order and grouping of the rows.
+	Sorting     Sorting
+	searchOrder map[string]int
+
+	// confirmPrompt and confirmAction are the prompt and action of a ConfirmMsg.
+	confirmPrompt string
+	confirmAction func() tea.Cmd
 }
 
 type NewSectionOptions struct {
@@ -66,19 +74,7 @@ type NewSectionOptions struct {
 func (options NewSectionOptions) GetConfigFiltersWithCurrentRemoteAdded(
 	ctx *context.ProgramContext,
 ) string {
-	searchValue := options.Config.Filters
-	if !ctx.Config.SmartFilteringAtLaunch {
-		return searchValue
-	}
-	if !ctx.HasGHRepo() {
-		return searchValue
-	}
-	for token := range strings.FieldsSeq(searchValue) {
-		if strings.HasPrefix(token, "repo:") {
-			return searchValue
-		}
-	}
-	return fmt.Sprintf("repo:%s/%s %s", ctx.GHRepo.Owner, ctx.GHRepo.Name, searchValue)
+	return ctx.Config.AddCurrentRepoFilter(options.Config.Filters, ctx.GHRepo)
 }
 
 func NewModel(
@@ -86,16 +82,6 @@ func NewModel(
 	options NewSectionOptions,
 ) BaseModel {
 	filters := options.GetConfigFiltersWithCurrentRemoteAdded(ctx)
-	isFilteredByCurrentRemote := false
-	if ctx.HasGHRepo() {
-		currentCloneFilter := fmt.Sprintf("repo:%s/%s", ctx.GHRepo.Owner, ctx.GHRepo.Name)
-		for token := range strings.FieldsSeq(filters) {
-			if token == currentCloneFilter {
-				isFilteredByCurrentRemote = true
-				break
-			}
-		}
-	}
 	m := BaseModel{
 		Ctx:          ctx,
 		Id:           options.Id,
@@ -111,7 +97,7 @@ func NewModel(
 		}),
 		SearchValue:               filters,
 		IsSearching:               false,
-		IsFilteredByCurrentRemote: isFilteredByCurrentRemote,
+		IsFilteredByCurrentRemote: config.IsFilteredByRepo(filters, ctx.GHRepo),
 		TotalCount:                0,
 		PageInfo:                  nil,
 		PromptConfirmationBox:     prompt.NewModel(ctx),
@@ -143,6 +129,7 @@ type Section interface {
 	Table
 	Search
 	PromptConfirmation
+	Selection
 	GetConfig() config.SectionConfig
 	UpdateProgramContext(ctx *context.ProgramContext)
 	MakeSectionCmd(cmd tea.Cmd) tea.Cmd
@@ -150,6 +137,7 @@ type Section interface {
 	GetItemSingularForm() string
 	GetItemPluralForm() string
 	GetTotalCount() int
+	InvalidateCache()
 }
 
 type Identifier interface {
@@ -186,12 +174,22 @@ type Search interface {
 	ResetPageInfo()
 }
 
+// Selection is implemented by sections whose rows can be marked so that actions apply to all
+// of them at once.
+type Selection interface {
+	ToggleSelection()
+	ClearSelection()
+	GetSelectedRows() []data.RowData
+	SelectionCount() int
+}
+
 type PromptConfirmation interface {
 	SetIsPromptConfirmationShown(val bool) tea.Cmd
 	IsPromptConfirmationFocused() bool
 	SetPromptConfirmationAction(action string)
 	GetPromptConfirmationAction() string
 	GetPromptConfirmation() string
+	Confirm(msg ConfirmMsg) tea.Cmd
 }
 
 func (m *BaseModel) GetDimensions() constants.Dimensions {
@@ -219,17 +217,7 @@ func (m *BaseModel) HasRepoNameInConfiguredFilter() bool {
 }
 
 func (m *BaseModel) HasCurrentRepoNameInConfiguredFilter() bool {
-	filters := m.SearchValue
-	if !m.Ctx.HasGHRepo() {
-		return false
-	}
-	currentCloneFilter := fmt.Sprintf("repo:%s/%s", m.Ctx.GHRepo.Owner, m.Ctx.GHRepo.Name)
-	for token := range strings.FieldsSeq(filters) {
-		if token == currentCloneFilter {
-			return true
-		}
-	}
-	return false
+	return config.IsFilteredByRepo(m.SearchValue, m.Ctx.GHRepo)
 }
 
 func (m *BaseModel) SyncSmartFilterWithSearchValue() {
@@ -237,52 +225,7 @@ func (m *BaseModel) SyncSmartFilterWithSearchValue() {
 }
 
 func (m *BaseModel) GetSearchValue() string {
-	searchValue := m.enrichSearchWithTemplateVars()
-	if !m.Ctx.HasGHRepo() {
-		return searchValue
-	}
-
-	currentCloneFilter := fmt.Sprintf("repo:%s/%s", m.Ctx.GHRepo.Owner, m.Ctx.GHRepo.Name)
-	var searchValueWithoutCurrentCloneFilter []string
-	for token := range strings.FieldsSeq(searchValue) {
-		if token != currentCloneFilter {
-			searchValueWithoutCurrentCloneFilter = append(
-				searchValueWithoutCurrentCloneFilter,
-				token,
-			)
-		}
-	}
-	if m.IsFilteredByCurrentRemote {
-		return fmt.Sprintf("%s %s", currentCloneFilter,
-			strings.Join(searchValueWithoutCurrentCloneFilter, " "))
-	}
-	return strings.Join(searchValueWithoutCurrentCloneFilter, " ")
-}
-
-func (m *BaseModel) enrichSearchWithTemplateVars() string {
-	searchValue := m.SearchValue
-	searchVars := struct{ Now time.Time }{
-		Now: time.Now(),
-	}
-	sl := slog.New(log.Default())
-	handler := sprout.New(
-		sprout.WithRegistries(timeregistry.NewRegistry(), utils.NewRegistry()),
-		sprout.WithLogger(sl),
-	)
-	funcs := handler.Build()
-
-	tmpl, err := template.New("search").Funcs(funcs).Parse(searchValue)
-	if err != nil {
-		log.Error("bad template", "err", err)
-		return searchValue
-	}
-	var buf bytes.Buffer
-	err = tmpl.Execute(&buf, searchVars)
-	if err != nil {
-		return searchValue
-	}
-
-	return buf.String()
+	return config.ResolveSearchValue(m.SearchValue, m.Ctx.GHRepo, m.IsFilteredByCurrentRemote)
 }
 
 func (m *BaseModel) UpdateProgramContext(ctx *context.ProgramContext) {
@@ -394,6 +337,33 @@ func (m *BaseModel) GetPromptConfirmationAction() string {
 	return m.PromptConfirmationAction
 }
 
+// ConfirmAction is the prompt confirmation action of a ConfirmMsg.
+const ConfirmAction = "confirm"
+
+// ConfirmMsg asks to confirm Prompt in the current section before running Action.
+type ConfirmMsg struct {
+	Prompt string
+	Action func() tea.Cmd
+}
+
+// Confirm shows the prompt of msg, whose action is run by RunConfirmedAction.
+func (m *BaseModel) Confirm(msg ConfirmMsg) tea.Cmd {
+	m.confirmPrompt = msg.Prompt
+	m.confirmAction = msg.Action
+	m.SetPromptConfirmationAction(ConfirmAction)
+	return m.SetIsPromptConfirmationShown(true)
+}
+
+// RunConfirmedAction runs the action of the confirmed ConfirmMsg.
+func (m *BaseModel) RunConfirmedAction() tea.Cmd {
+	action := m.confirmAction
+	m.confirmAction = nil
+	if action == nil {
+		return nil
+	}
+	return action()
+}
+
 type SectionMsg struct {
 	Id          int
 	Type        string
@@ -453,12 +423,77 @@ func (m *BaseModel) View() string {
 		)
 }
 
+// ToggleRowSelection marks or unmarks the row identified by key.
+func (m *BaseModel) ToggleRowSelection(key string) {
+	if m.Selection[key] {
+		delete(m.Selection, key)
+		return
+	}
+	if m.Selection == nil {
+		m.Selection = make(map[string]bool)
+	}
+	m.Selection[key] = true
+}
+
+func (m *BaseModel) ClearSelection() {
+	m.Selection = nil
+	m.Table.SetMarkedRows(nil)
+}
+
+func (m *BaseModel) SelectionCount() int {
+	return len(m.Selection)
+}
+
+// SelectionStatus describes the selection for the section's pager when rows are selected.
+func (m *BaseModel) SelectionStatus() string {
+	if len(m.Selection) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" • %d selected", len(m.Selection))
+}
+
+// SyncSelection drops selected rows that are no longer shown and marks the remaining ones in
+// the table. keys holds the key of every row in the order the rows are shown.
+func (m *BaseModel) SyncSelection(keys []string) {
+	if len(m.Selection) == 0 {
+		m.Table.SetMarkedRows(nil)
+		return
+	}
+
+	shown := make(map[string]bool, len(m.Selection))
+	marked := make([]int, 0, len(m.Selection))
+	for i, key := range keys {
+		if m.Selection[key] {
+			shown[key] = true
+			marked = append(marked, i)
+		}
+	}
+	m.Selection = shown
+	m.Table.SetMarkedRows(marked)
+}
+
 func (m *BaseModel) ResetRows() {
 	m.Table.Rows = nil
 	m.ResetPageInfo()
 	m.Table.ResetCurrItem()
 }
 
+// InvalidateCache drops the section's cached results so the next launch
+// doesn't warm start from them.
+func (m *BaseModel) InvalidateCache() {
+	if m.CacheKey == "" {
+		return
+	}
+	data.GetSectionCache().Invalidate(m.CacheKey)
+}
+
+// CacheMaxAge returns how old cached results may be before they are no
+// longer used for a warm start. It follows the refetch interval, so cached
+// results are never older than an interval refresh would allow.
+func (m *BaseModel) CacheMaxAge() time.Duration {
+	return time.Minute * time.Duration(m.Ctx.Config.Defaults.RefetchIntervalMinutes)
+}
+
 func (m *BaseModel) LastUpdated() time.Time {
 	return m.Table.LastUpdated()
 }
@@ -474,7 +509,20 @@ func (m *BaseModel) UpdateTotalItemsCount(count int) {
 func (m *BaseModel) GetPromptConfirmation() string {
 	if m.IsPromptConfirmationShown {
 		var prompt string
+		n := m.SelectionCount()
 		switch {
+		case n > 0 && m.PromptConfirmationAction == "close" && m.Ctx.View == config.PRsView:
+			prompt = fmt.Sprintf("Are you sure you want to close %d PRs? (y/N) ", n)
+
+		case n > 0 && m.PromptConfirmationAction == "reopen" && m.Ctx.View == config.PRsView:
+			prompt = fmt.Sprintf("Are you sure you want to reopen %d PRs? (y/N) ", n)
+
+		case n > 0 && m.PromptConfirmationAction == "close" && m.Ctx.View == config.IssuesView:
+			prompt = fmt.Sprintf("Are you sure you want to close %d issues? (y/N) ", n)
+
+		case n > 0 && m.PromptConfirmationAction == "reopen" && m.Ctx.View == config.IssuesView:
+			prompt = fmt.Sprintf("Are you sure you want to reopen %d issues? (y/N) ", n)
+
 		case m.PromptConfirmationAction == "close" && m.Ctx.View == config.PRsView:
 			prompt = "Are you sure you want to close this PR? (y/N) "
 
@@ -493,6 +541,15 @@ func (m *BaseModel) GetPromptConfirmation() string {
 		case m.PromptConfirmationAction == "approveWorkflows" && m.Ctx.View == config.PRsView:
 			prompt = "Are you sure you want to approve all workflows? (y/N) "
 
+		case m.PromptConfirmationAction == "rerunFailedWorkflows" && m.Ctx.View == config.PRsView:
+			prompt = "Are you sure you want to re-run the failed workflows? (y/N) "
+
+		case m.PromptConfirmationAction == "rerunAllWorkflows" && m.Ctx.View == config.PRsView:
+			prompt = "Are you sure you want to re-run all workflows? (y/N) "
+
+		case m.PromptConfirmationAction == "cancelWorkflows" && m.Ctx.View == config.PRsView:
+			prompt = "Are you sure you want to cancel the running workflows? (y/N) "
+
 		case m.PromptConfirmationAction == "close" && m.Ctx.View == config.IssuesView:
 			prompt = "Are you sure you want to close this issue? (y/N) "
 
@@ -504,8 +561,18 @@ func (m *BaseModel) GetPromptConfirmation() string {
 			prompt = "Enter branch name: "
 		case m.PromptConfirmationAction == "create_pr" && m.Ctx.View == config.RepoView:
 			prompt = "Enter PR title: "
+		case m.PromptConfirmationAction == "prune_worktrees" && m.Ctx.View == config.RepoView:
+			prompt = "Remove the worktrees of merged and closed PRs? (y/N) "
+		case m.PromptConfirmationAction == "rebase" && m.Ctx.View == config.RepoView:
+			prompt = "Are you sure you want to rebase this branch onto its base branch? (y/N) "
+		case m.PromptConfirmationAction == "abort_rebase" && m.Ctx.View == config.RepoView:
+			prompt = "Are you sure you want to abort the rebase? (y/N) "
+		case m.PromptConfirmationAction == "force_push_lease" && m.Ctx.View == config.RepoView:
+			prompt = "Are you sure you want to force-push this branch with lease? (y/N) "
 		case m.PromptConfirmationAction == "done_all" && m.Ctx.View == config.NotificationsView:
 			prompt = "Are you sure you want to mark all as done? (y/N) "
+		case m.PromptConfirmationAction == ConfirmAction:
+			prompt = m.confirmPrompt
 		}
 
 		m.PromptConfirmationBox.SetPrompt(prompt)
@@ -529,3 +529,82 @@ func TestViewRendersAtMainContentWidth(t *testing.T) {
 		})
 	}
 }
+
+func newSelectionTestModel(t *testing.T) BaseModel {
+	cfg, err := config.ParseConfig(config.Location{
+		ConfigFlag:       "../../../config/testdata/test-config.yml",
+		SkipGlobalConfig: true,
+	})
+	require.NoError(t, err)
+
+	thm := theme.ParseTheme(&cfg)
+	ctx := &context.ProgramContext{
+		Config: &cfg,
+		View:   config.PRsView,
+		Theme:  thm,
+		Styles: context.InitStyles(thm),
+	}
+	return BaseModel{
+		Ctx:                   ctx,
+		PromptConfirmationBox: prompt.NewModel(ctx),
+		Table: table.NewModel(
+			*ctx,
+			constants.Dimensions{Width: 80, Height: 10},
+			time.Now(),
+			time.Now(),
+			nil,
+			nil,
+			"pr",
+			nil,
+			"Loading...",
+			false,
+		),
+	}
+}
+
+func TestToggleRowSelection(t *testing.T) {
+	m := newSelectionTestModel(t)
+
+	m.ToggleRowSelection("a")
+	m.ToggleRowSelection("b")
+	require.Equal(t, 2, m.SelectionCount())
+	require.Equal(t, " • 2 selected", m.SelectionStatus())
+
+	m.ToggleRowSelection("a")
+	require.Equal(t, 1, m.SelectionCount())
+	require.True(t, m.Selection["b"])
+
+	m.ClearSelection()
+	require.Zero(t, m.SelectionCount())
+	require.Empty(t, m.SelectionStatus())
+}
+
+func TestSyncSelection_DropsRowsNoLongerShown(t *testing.T) {
+	m := newSelectionTestModel(t)
+	m.ToggleRowSelection("a")
+	m.ToggleRowSelection("c")
+	m.ToggleRowSelection("gone")
+
+	m.SyncSelection([]string{"a", "b", "c"})
+
+	require.Equal(t, map[string]bool{"a": true, "c": true}, m.Selection)
+	require.True(t, m.Table.IsRowMarked(0))
+	require.False(t, m.Table.IsRowMarked(1))
+	require.True(t, m.Table.IsRowMarked(2))
+
+	m.ClearSelection()
+	require.False(t, m.Table.IsRowMarked(0))
+}
+
+func TestGetPromptConfirmation_CountsSelectedRows(t *testing.T) {
+	m := newSelectionTestModel(t)
+	m.IsPromptConfirmationShown = true
+	m.PromptConfirmationAction = "close"
+	m.ToggleRowSelection("a")
+	m.ToggleRowSelection("b")
+
+	require.Contains(t, m.GetPromptConfirmation(), "close 2 PRs?")
+
+	m.ClearSelection()
+	require.Contains(t, m.GetPromptConfirmation(), "close this PR?")
+}
@@ -0,0 +1,205 @@
+package section
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Sorting is the order and grouping a section applies to its rows after fetching them.
+type Sorting struct {
+	// Column is the name of the column rows are sorted by. Empty keeps the order of the
+	// search results.
+	Column string
+	Desc   bool
+	// GroupBy is the name of the grouping rows are grouped by. Empty doesn't group rows.
+	GroupBy string
+}
+
+// SortColumn is a column the rows of a section can be sorted by.
+type SortColumn[T any] struct {
+	Name string
+	// Desc is whether the column sorts in descending order when no direction is configured,
+	// e.g. newest or largest first.
+	Desc    bool
+	Compare func(a, b T) int
+}
+
+// GroupColumn is a way the rows of a section can be grouped.
+type GroupColumn[T any] struct {
+	Name string
+	// Group returns the group of a row, or an empty string when the row has none.
+	Group func(row T) string
+	// None is the title of the group of rows that have none. It is shown last.
+	None string
+}
+
+// NewSorting returns the sorting configured for a section. direction is "asc", "desc" or empty
+// for the column's default direction.
+func NewSorting[T any](columns []SortColumn[T], column, direction, groupBy string) Sorting {
+	s := Sorting{Column: column, GroupBy: groupBy}
+	if i := findColumn(columns, column); i != -1 {
+		s.Desc = columns[i].Desc
+	}
+	switch direction {
+	case "asc":
+		s.Desc = false
+	case "desc":
+		s.Desc = true
+	}
+	return s
+}
+
+// NextSortColumn moves the sorting to the column after the current one, going back to the
+// order of the search results after the last column.
+func NextSortColumn[T any](columns []SortColumn[T], s Sorting) Sorting {
+	i := findColumn(columns, s.Column)
+	if i+1 >= len(columns) {
+		s.Column = ""
+		s.Desc = false
+		return s
+	}
+	s.Column = columns[i+1].Name
+	s.Desc = columns[i+1].Desc
+	return s
+}
+
+// NextGroupBy moves the grouping to the one after the current one, going back to ungrouped
+// rows after the last one.
+func NextGroupBy[T any](groups []GroupColumn[T], s Sorting) Sorting {
+	i := slices.IndexFunc(groups, func(g GroupColumn[T]) bool { return g.Name == s.GroupBy })
+	if i+1 >= len(groups) {
+		s.GroupBy = ""
+		return s
+	}
+	s.GroupBy = groups[i+1].Name
+	return s
+}
+
+// TrackSearchOrder records the position of rows in the search results, given their keys in
+// the order they were fetched. Keys already tracked keep their position unless reset is set,
+// which starts over for a new search.
+func (m *BaseModel) TrackSearchOrder(keys []string, reset bool) {
+	if reset || m.searchOrder == nil {
+		m.searchOrder = make(map[string]int, len(keys))
+	}
+	for _, key := range keys {
+		if _, ok := m.searchOrder[key]; !ok {
+			m.searchOrder[key] = len(m.searchOrder)
+		}
+	}
+}
+
+// PrependSearchOrder places the row with the given key before the search results, e.g. an
+// issue created from the dashboard that the search hasn't returned yet.
+func (m *BaseModel) PrependSearchOrder(key string) {
+	if m.searchOrder == nil {
+		m.searchOrder = make(map[string]int)
+	}
+	first := 0
+	for _, pos := range m.searchOrder {
+		first = min(first, pos)
+	}
+	m.searchOrder[key] = first - 1
+}
+
+func (m *BaseModel) searchPosition(key string) int {
+	if pos, ok := m.searchOrder[key]; ok {
+		return pos
+	}
+	return len(m.searchOrder)
+}
+
+// SortRows orders rows by their group and then by the sorted column of the section. Rows that
+// compare equal, or all rows when no column is sorted, keep the order of the search results.
+func SortRows[T any](
+	m *BaseModel,
+	rows []T,
+	columns []SortColumn[T],
+	groups []GroupColumn[T],
+	key func(row T) string,
+) {
+	group := findGroup(groups, m.Sorting.GroupBy)
+	var compare func(a, b T) int
+	if i := findColumn(columns, m.Sorting.Column); i != -1 {
+		compare = columns[i].Compare
+	}
+
+	slices.SortStableFunc(rows, func(a, b T) int {
+		if group != nil {
+			if c := compareGroups(group.Group(a), group.Group(b)); c != 0 {
+				return c
+			}
+		}
+		if compare != nil {
+			c := compare(a, b)
+			if m.Sorting.Desc {
+				c = -c
+			}
+			if c != 0 {
+				return c
+			}
+		}
+		return cmp.Compare(m.searchPosition(key(a)), m.searchPosition(key(b)))
+	})
+}
+
+// RowGroups returns the group of each row, or nil when the section doesn't group its rows.
+func RowGroups[T any](m *BaseModel, rows []T, groups []GroupColumn[T]) []string {
+	group := findGroup(groups, m.Sorting.GroupBy)
+	if group == nil {
+		return nil
+	}
+	titles := make([]string, 0, len(rows))
+	for _, row := range rows {
+		title := group.Group(row)
+		if title == "" {
+			title = group.None
+		}
+		titles = append(titles, title)
+	}
+	return titles
+}
+
+// compareGroups orders groups alphabetically regardless of case, with rows without a group
+// last.
+func compareGroups(a, b string) int {
+	switch {
+	case a == b:
+		return 0
+	case a == "":
+		return 1
+	case b == "":
+		return -1
+	}
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
+
+func findColumn[T any](columns []SortColumn[T], name string) int {
+	return slices.IndexFunc(columns, func(c SortColumn[T]) bool { return c.Name == name })
+}
+
+func findGroup[T any](groups []GroupColumn[T], name string) *GroupColumn[T] {
+	i := slices.IndexFunc(groups, func(g GroupColumn[T]) bool { return g.Name == name })
+	if i == -1 {
+		return nil
+	}
+	return &groups[i]
+}
+
+// SortingStatus describes the sorting for the section's pager when rows are sorted or grouped.
+func (m *BaseModel) SortingStatus() string {
+	var status string
+	if m.Sorting.Column != "" {
+		direction := "↑"
+		if m.Sorting.Desc {
+			direction = "↓"
+		}
+		status += fmt.Sprintf(" • sorted by %s %s", m.Sorting.Column, direction)
+	}
+	if m.Sorting.GroupBy != "" {
+		status += fmt.Sprintf(" • grouped by %s", m.Sorting.GroupBy)
+	}
+	return status
+}
@@ -0,0 +1,155 @@
+package section
+
+import (
+	"cmp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/table"
+)
+
+type sortTestRow struct {
+	url   string
+	repo  string
+	lines int
+}
+
+var testSortColumns = []SortColumn[sortTestRow]{
+	{Name: "repo", Compare: func(a, b sortTestRow) int { return strings.Compare(a.repo, b.repo) }},
+	{Name: "lines", Desc: true, Compare: func(a, b sortTestRow) int {
+		return cmp.Compare(a.lines, b.lines)
+	}},
+}
+
+var testGroupColumns = []GroupColumn[sortTestRow]{
+	{Name: "repo", Group: func(row sortTestRow) string { return row.repo }},
+}
+
+func sortTestKey(row sortTestRow) string {
+	return row.url
+}
+
+func sortTestUrls(rows []sortTestRow) []string {
+	urls := make([]string, 0, len(rows))
+	for _, row := range rows {
+		urls = append(urls, row.url)
+	}
+	return urls
+}
+
+func newSortTestRows() []sortTestRow {
+	return []sortTestRow{
+		{url: "1", repo: "b/b", lines: 10},
+		{url: "2", repo: "a/a", lines: 300},
+		{url: "3", repo: "b/b", lines: 50},
+		{url: "4", repo: "a/a", lines: 5},
+	}
+}
+
+func TestNewSorting(t *testing.T) {
+	require.Equal(t, Sorting{Column: "lines", Desc: true},
+		NewSorting(testSortColumns, "lines", "", ""))
+	require.Equal(t, Sorting{Column: "lines", Desc: false},
+		NewSorting(testSortColumns, "lines", "asc", ""))
+	require.Equal(t, Sorting{Column: "repo", Desc: true, GroupBy: "repo"},
+		NewSorting(testSortColumns, "repo", "desc", "repo"))
+}
+
+func TestNextSortColumn_CyclesBackToSearchOrder(t *testing.T) {
+	s := Sorting{}
+
+	s = NextSortColumn(testSortColumns, s)
+	require.Equal(t, Sorting{Column: "repo"}, s)
+
+	s = NextSortColumn(testSortColumns, s)
+	require.Equal(t, Sorting{Column: "lines", Desc: true}, s)
+
+	s = NextSortColumn(testSortColumns, s)
+	require.Equal(t, Sorting{}, s)
+}
+
+func TestSortRows(t *testing.T) {
+	m := BaseModel{}
+	rows := newSortTestRows()
+	m.TrackSearchOrder(sortTestUrls(rows), true)
+
+	m.Sorting = Sorting{Column: "lines", Desc: true}
+	SortRows(&m, rows, testSortColumns, testGroupColumns, sortTestKey)
+	require.Equal(t, []string{"2", "3", "1", "4"}, sortTestUrls(rows))
+
+	m.Sorting = Sorting{Column: "lines", GroupBy: "repo"}
+	SortRows(&m, rows, testSortColumns, testGroupColumns, sortTestKey)
+	require.Equal(t, []string{"4", "2", "1", "3"}, sortTestUrls(rows))
+	require.Equal(t, []string{"a/a", "a/a", "b/b", "b/b"},
+		RowGroups(&m, rows, testGroupColumns))
+
+	m.Sorting = Sorting{}
+	SortRows(&m, rows, testSortColumns, testGroupColumns, sortTestKey)
+	require.Equal(t, []string{"1", "2", "3", "4"}, sortTestUrls(rows),
+		"no sorting should go back to the search results order")
+	require.Nil(t, RowGroups(&m, rows, testGroupColumns))
+}
+
+func TestSortRows_KeepsSearchOrderForTies(t *testing.T) {
+	m := BaseModel{Sorting: Sorting{Column: "repo"}}
+	rows := newSortTestRows()
+	m.TrackSearchOrder(sortTestUrls(rows[:2]), true)
+	m.TrackSearchOrder(sortTestUrls(rows[2:]), false)
+
+	SortRows(&m, rows, testSortColumns, testGroupColumns, sortTestKey)
+
+	require.Equal(t, []string{"2", "4", "1", "3"}, sortTestUrls(rows))
+}
+
+func TestPrependSearchOrder(t *testing.T) {
+	m := BaseModel{}
+	rows := newSortTestRows()
+	m.TrackSearchOrder(sortTestUrls(rows), true)
+	m.PrependSearchOrder("5")
+	m.PrependSearchOrder("6")
+	rows = append(rows, sortTestRow{url: "5", repo: "a/a"}, sortTestRow{url: "6", repo: "b/b"})
+
+	SortRows(&m, rows, testSortColumns, testGroupColumns, sortTestKey)
+
+	require.Equal(t, []string{"6", "5", "1", "2", "3", "4"}, sortTestUrls(rows))
+}
+
+func TestSortingStatus(t *testing.T) {
+	m := BaseModel{}
+	require.Empty(t, m.SortingStatus())
+
+	m.Sorting = Sorting{Column: "ci", GroupBy: "repo"}
+	require.Equal(t, " • sorted by ci ↑ • grouped by repo", m.SortingStatus())
+}
+
+func TestGroupedTable(t *testing.T) {
+	m := newSelectionTestModel(t)
+	m.Table.SetRows([]table.Row{{}, {}, {}})
+	m.Table.SetGroups([]string{"a/a", "a/a", "b/b"})
+
+	// The list starts with the header of the first group
+	require.True(t, m.Table.IsGroupHeaderSelected())
+	require.Equal(t, -1, m.Table.GetCurrItem())
+	require.Equal(t, 0, m.Table.NextItem())
+	require.Equal(t, 1, m.Table.NextItem())
+	require.Equal(t, -1, m.Table.NextItem())
+	require.Equal(t, 2, m.Table.NextItem())
+
+	// Collapsing a group from one of its rows moves to the group's header
+	m.Table.FirstItem()
+	m.Table.NextItem()
+	m.Table.ToggleCurrGroup()
+	require.True(t, m.Table.IsGroupHeaderSelected())
+	require.Equal(t, -1, m.Table.NextItem(), "collapsed rows should be skipped")
+	require.Equal(t, 2, m.Table.NextItem())
+
+	m.Table.FirstItem()
+	m.Table.ToggleCurrGroup()
+	require.Equal(t, 0, m.Table.NextItem())
+
+	m.Table.SetGroups(nil)
+	m.Table.FirstItem()
+	require.Equal(t, 0, m.Table.GetCurrItem())
+}
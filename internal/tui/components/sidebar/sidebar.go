@@ -119,6 +119,10 @@ func (m *Model) ScrollToBottom() {
 	m.viewport.GotoBottom()
 }
 
+func (m *Model) ScrollToLine(line int) {
+	m.viewport.SetYOffset(line)
+}
+
 func (m *Model) YOffset() int {
 	return m.viewport.YOffset()
 }
@@ -2,6 +2,8 @@ package table
 
 import (
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 	"time"
 
@@ -26,9 +28,20 @@ type Model struct {
 	loadingSpinner spinner.Model
 	dimensions     constants.Dimensions
 	rowsViewport   listviewport.Model
+	markedRows     map[int]bool
+	rowGroups      []string
+	collapsed      map[string]bool
+	items          []item
 	ContentHeight  int // Optional: override content height (0 = use default from config)
 }
 
+// item is an entry in the list of a grouped table: either a row or the header of a group.
+type item struct {
+	row     int // -1 for a group header
+	group   string
+	numRows int // the number of rows in the group, only set for headers
+}
+
 type Column struct {
 	Title         string
 	Hidden        *bool
@@ -120,36 +133,53 @@ func (m *Model) ResetCurrItem() {
 	m.rowsViewport.ResetCurrItem()
 }
 
+// GetCurrItem returns the index of the current row, or -1 when the current item is the
+// header of a group.
 func (m *Model) GetCurrItem() int {
-	return m.rowsViewport.GetCurrItem()
+	currItem := m.rowsViewport.GetCurrItem()
+	if !m.isGrouped() {
+		return currItem
+	}
+	if currItem < 0 || currItem >= len(m.items) {
+		return -1
+	}
+	return m.items[currItem].row
 }
 
 func (m *Model) PrevItem() int {
-	currItem := m.rowsViewport.PrevItem()
+	m.rowsViewport.PrevItem()
 	m.SyncViewPortContent()
 
-	return currItem
+	return m.GetCurrItem()
 }
 
 func (m *Model) NextItem() int {
-	currItem := m.rowsViewport.NextItem()
+	m.rowsViewport.NextItem()
 	m.SyncViewPortContent()
 
-	return currItem
+	return m.GetCurrItem()
 }
 
 func (m *Model) FirstItem() int {
-	currItem := m.rowsViewport.FirstItem()
+	m.rowsViewport.FirstItem()
 	m.SyncViewPortContent()
 
-	return currItem
+	return m.GetCurrItem()
 }
 
 func (m *Model) LastItem() int {
-	currItem := m.rowsViewport.LastItem()
+	m.rowsViewport.LastItem()
+	m.SyncViewPortContent()
+
+	return m.GetCurrItem()
+}
+
+// SetCurrItem selects the item with the given index, clamped to the items of the table.
+func (m *Model) SetCurrItem(item int) int {
+	m.rowsViewport.SetCurrItem(item)
 	m.SyncViewPortContent()
 
-	return currItem
+	return m.GetCurrItem()
 }
 
 func (m *Model) cacheColumnWidths() {
@@ -166,8 +196,18 @@ func (m *Model) SyncViewPortContent() {
 	headerColumns := m.renderHeaderColumns()
 	m.cacheColumnWidths()
 	renderedRows := make([]string, 0, len(m.Rows))
-	for i := range m.Rows {
-		renderedRows = append(renderedRows, m.renderRow(i, headerColumns))
+	if m.isGrouped() {
+		for i, it := range m.items {
+			if it.row < 0 {
+				renderedRows = append(renderedRows, m.renderGroupHeader(i, it))
+				continue
+			}
+			renderedRows = append(renderedRows, m.renderRow(it.row, headerColumns))
+		}
+	} else {
+		for i := range m.Rows {
+			renderedRows = append(renderedRows, m.renderRow(i, headerColumns))
+		}
 	}
 
 	m.rowsViewport.SyncViewPort(
@@ -177,10 +217,96 @@ func (m *Model) SyncViewPortContent() {
 
 func (m *Model) SetRows(rows []Row) {
 	m.Rows = rows
-	m.rowsViewport.SetNumItems(len(m.Rows))
+	m.syncItems()
+	m.SyncViewPortContent()
+}
+
+// SetGroups groups the rows under a header per group, given the group of each row. The rows
+// of a group must be consecutive. A nil groups shows the rows without headers.
+func (m *Model) SetGroups(groups []string) {
+	if slices.Equal(groups, m.rowGroups) {
+		return
+	}
+	m.rowGroups = groups
+	m.syncItems()
+	m.SyncViewPortContent()
+}
+
+func (m *Model) isGrouped() bool {
+	return len(m.rowGroups) > 0 && len(m.rowGroups) == len(m.Rows)
+}
+
+func (m *Model) syncItems() {
+	if !m.isGrouped() {
+		m.items = nil
+		m.rowsViewport.SetNumItems(len(m.Rows))
+		return
+	}
+
+	m.items = make([]item, 0, len(m.Rows))
+	header := -1
+	for i, group := range m.rowGroups {
+		if header == -1 || m.items[header].group != group {
+			header = len(m.items)
+			m.items = append(m.items, item{row: -1, group: group})
+		}
+		m.items[header].numRows++
+		if !m.collapsed[group] {
+			m.items = append(m.items, item{row: i, group: group})
+		}
+	}
+	m.rowsViewport.SetNumItems(len(m.items))
+}
+
+// ToggleCurrGroup collapses or expands the group of the current item and moves to the group's
+// header.
+func (m *Model) ToggleCurrGroup() {
+	currItem := m.rowsViewport.GetCurrItem()
+	if !m.isGrouped() || currItem < 0 || currItem >= len(m.items) {
+		return
+	}
+
+	header := currItem
+	for m.items[header].row != -1 {
+		header--
+	}
+	group := m.items[header].group
+	if m.collapsed[group] {
+		delete(m.collapsed, group)
+	} else {
+		if m.collapsed == nil {
+			m.collapsed = make(map[string]bool)
+		}
+		m.collapsed[group] = true
+	}
+
+	m.syncItems()
+	m.rowsViewport.SetCurrItem(header)
+	m.SyncViewPortContent()
+}
+
+// IsGroupHeaderSelected returns whether the current item is the header of a group.
+func (m *Model) IsGroupHeaderSelected() bool {
+	return m.isGrouped() && m.GetCurrItem() == -1
+}
+
+// SetMarkedRows sets the rows that are marked for a bulk action.
+func (m *Model) SetMarkedRows(rows []int) {
+	marked := make(map[int]bool, len(rows))
+	for _, row := range rows {
+		marked[row] = true
+	}
+	if maps.Equal(marked, m.markedRows) {
+		return
+	}
+	m.markedRows = marked
 	m.SyncViewPortContent()
 }
 
+func (m *Model) IsRowMarked(rowId int) bool {
+	return m.markedRows[rowId]
+}
+
 // SetContentHeight sets a custom content height for rows (use 0 to use default config-based height)
 func (m *Model) SetContentHeight(height int) {
 	m.ContentHeight = height
@@ -308,12 +434,13 @@ func (m *Model) renderBody() string {
 func (m *Model) renderRow(rowId int, headerColumns []string) string {
 	var style lipgloss.Style
 
-	if m.rowsViewport.GetCurrItem() == rowId {
+	if m.GetCurrItem() == rowId {
 		style = m.ctx.Styles.Table.SelectedCellStyle
 	} else {
 		style = m.ctx.Styles.Table.CellStyle
 	}
 
+	isMarked := m.IsRowMarked(rowId)
 	renderedColumns := make([]string, 0, len(m.Columns))
 	headerColId := 0
 
@@ -323,18 +450,24 @@ func (m *Model) renderRow(rowId int, headerColumns []string) string {
 		}
 
 		colWidth := lipgloss.Width(headerColumns[headerColId])
-		colHeight := 1
-		if m.ContentHeight > 0 {
-			// Use custom content height if set
-			colHeight = m.ContentHeight
-		} else if !m.ctx.Config.Theme.Ui.Table.Compact {
-			colHeight = 2
-		}
+		colHeight := m.rowContentHeight()
 		col := m.Rows[rowId][i]
+		colStyle := style
+		if isMarked && headerColId == 0 {
+			// The marker takes the place of the cell's left padding
+			marker := m.ctx.Styles.Table.MarkerStyle.
+				Background(style.GetBackground()).
+				Render(constants.MarkedIcon)
+			col = marker + strings.ReplaceAll(col, "\n", "\n"+marker)
+			colStyle = style.PaddingLeft(0)
+		}
 		// For multi-line content, truncate long lines and pad short lines
 		// so lines don't wrap and background color extends properly
 		// Account for cell padding (1 left + 1 right = 2)
 		contentWidth := max(colWidth-2, 1)
+		if colStyle.GetPaddingLeft() == 0 {
+			contentWidth++
+		}
 		var renderedCol string
 		if strings.Contains(col, "\n") {
 			// For multi-line content, apply cell style to each line individually
@@ -346,7 +479,7 @@ func (m *Model) renderRow(rowId int, headerColumns []string) string {
 				if lineWidth > contentWidth {
 					line = ansi.Truncate(line, contentWidth, constants.Ellipsis)
 				}
-				lineStyle := style.Width(colWidth).MaxWidth(colWidth).Height(1)
+				lineStyle := colStyle.Width(colWidth).MaxWidth(colWidth).Height(1)
 				if column.Align != nil {
 					lineStyle = lineStyle.Align(*column.Align)
 				}
@@ -354,7 +487,7 @@ func (m *Model) renderRow(rowId int, headerColumns []string) string {
 			}
 			renderedCol = strings.Join(renderedLines, "\n")
 		} else {
-			cellStyle := style.
+			cellStyle := colStyle.
 				Width(colWidth).
 				MaxWidth(colWidth).
 				Height(colHeight).
@@ -375,6 +508,45 @@ func (m *Model) renderRow(rowId int, headerColumns []string) string {
 		Render(lipgloss.JoinHorizontal(lipgloss.Top, renderedColumns...))
 }
 
+func (m *Model) rowContentHeight() int {
+	if m.ContentHeight > 0 {
+		// Use custom content height if set
+		return m.ContentHeight
+	}
+	if !m.ctx.Config.Theme.Ui.Table.Compact {
+		return 2
+	}
+	return 1
+}
+
+func (m *Model) renderGroupHeader(itemId int, header item) string {
+	style := m.ctx.Styles.Table.GroupHeaderStyle
+	if m.rowsViewport.GetCurrItem() == itemId {
+		style = style.Background(m.ctx.Theme.SelectedBackground)
+	}
+
+	icon := constants.ExpandedGroupIcon
+	if m.collapsed[header.group] {
+		icon = constants.CollapsedGroupIcon
+	}
+	title := ansi.Truncate(
+		fmt.Sprintf("%s %s (%d)", icon, header.group, header.numRows),
+		max(m.dimensions.Width-2, 1),
+		constants.Ellipsis,
+	)
+	height := m.rowContentHeight()
+
+	return m.ctx.Styles.Table.RowStyle.
+		BorderBottom(m.ctx.Config.Theme.Ui.Table.ShowSeparator).
+		MaxWidth(m.dimensions.Width).
+		Render(style.
+			Width(m.dimensions.Width).
+			MaxWidth(m.dimensions.Width).
+			Height(height).
+			MaxHeight(height).
+			Render(title))
+}
+
 func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
 	m.ctx = *ctx
 	m.rowsViewport.UpdateProgramContext(ctx)
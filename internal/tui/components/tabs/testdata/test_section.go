@@ -20,6 +20,16 @@ func (t *TestSection) BuildRows() []table.Row {
 	panic("unimplemented")
 }
 
+// ClearSelection implements section.Section.
+func (t *TestSection) ClearSelection() {
+	panic("unimplemented")
+}
+
+// Confirm implements section.Section.
+func (t *TestSection) Confirm(msg section.ConfirmMsg) tea.Cmd {
+	panic("unimplemented")
+}
+
 // CurrRow implements section.Section.
 func (t *TestSection) CurrRow() int {
 	panic("unimplemented")
@@ -85,6 +95,11 @@ func (t *TestSection) GetPromptConfirmationAction() string {
 	panic("unimplemented")
 }
 
+// GetSelectedRows implements section.Section.
+func (t *TestSection) GetSelectedRows() []data.RowData {
+	panic("unimplemented")
+}
+
 // GetTotalCount implements section.Section.
 func (t *TestSection) GetTotalCount() int {
 	return 10
@@ -95,6 +110,11 @@ func (t *TestSection) GetType() string {
 	panic("unimplemented")
 }
 
+// InvalidateCache implements section.Section.
+func (t *TestSection) InvalidateCache() {
+	panic("unimplemented")
+}
+
 // IsPromptConfirmationFocused implements section.Section.
 func (t *TestSection) IsPromptConfirmationFocused() bool {
 	panic("unimplemented")
@@ -145,6 +165,11 @@ func (t *TestSection) ResetRows() {
 	panic("unimplemented")
 }
 
+// SelectionCount implements section.Section.
+func (t *TestSection) SelectionCount() int {
+	panic("unimplemented")
+}
+
 // SetIsLoading implements section.Section.
 func (t *TestSection) SetIsLoading(val bool) {
 	t.loading = val
@@ -165,6 +190,11 @@ func (t *TestSection) SetPromptConfirmationAction(action string) {
 	panic("unimplemented")
 }
 
+// ToggleSelection implements section.Section.
+func (t *TestSection) ToggleSelection() {
+	panic("unimplemented")
+}
+
 // Update implements section.Section.
 func (t *TestSection) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 	panic("unimplemented")
@@ -0,0 +1,316 @@
+package tasks
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/log/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+// bulkConcurrency caps how many gh commands a bulk task runs at the same time.
+const bulkConcurrency = 4
+
+// BulkUpdateMsg carries the update message of every row a bulk task ran on.
+type BulkUpdateMsg struct {
+	Msgs []tea.Msg
+}
+
+// BulkTask runs a GitHubTask for each of several rows while showing a single task in the
+// footer.
+type BulkTask struct {
+	Id           string
+	Section      SectionIdentifier
+	StartText    string
+	FinishedText string
+	Tasks        []GitHubTask
+}
+
+func newBulkTask(
+	prefix string,
+	section SectionIdentifier,
+	startText string,
+	finishedText string,
+	rows []data.RowData,
+	build func(row data.RowData) GitHubTask,
+) BulkTask {
+	tasks := make([]GitHubTask, 0, len(rows))
+	for _, row := range rows {
+		tasks = append(tasks, build(row))
+	}
+	return BulkTask{
+		Id:           fmt.Sprintf("%s_%d_%d", prefix, len(rows), time.Now().UnixNano()),
+		Section:      section,
+		StartText:    fmt.Sprintf(startText, len(rows)),
+		FinishedText: fmt.Sprintf(finishedText, len(rows)),
+		Tasks:        tasks,
+	}
+}
+
+func fireBulkTask(ctx *context.ProgramContext, bulk BulkTask) tea.Cmd {
+	start := context.Task{
+		Id:           bulk.Id,
+		StartText:    bulk.StartText,
+		FinishedText: bulk.FinishedText,
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+
+	startCmd := ctx.StartTask(start)
+	return tea.Batch(startCmd, func() tea.Msg {
+		return runBulkTask(bulk, func(task GitHubTask) (*exec.Cmd, error) {
+			log.Info("Running task", "cmd", "gh "+strings.Join(task.Args, " "))
+			c := exec.Command("gh", task.Args...)
+			return c, c.Run()
+		})
+	})
+}
+
+// runBulkTask runs every task of bulk with run and aggregates the results into a single
+// TaskFinishedMsg.
+func runBulkTask(
+	bulk BulkTask,
+	run func(task GitHubTask) (*exec.Cmd, error),
+) constants.TaskFinishedMsg {
+	msgs := make([]tea.Msg, len(bulk.Tasks))
+	errs := make([]error, len(bulk.Tasks))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, bulkConcurrency)
+	for i, task := range bulk.Tasks {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			c, err := run(task)
+			msgs[i] = task.Msg(c, err)
+			errs[i] = err
+		}()
+	}
+	wg.Wait()
+
+	var failed int
+	for _, err := range errs {
+		if err != nil {
+			failed++
+		}
+	}
+
+	var err error
+	if failed > 0 {
+		err = fmt.Errorf("%d of %d failed: %w", failed, len(bulk.Tasks), errors.Join(errs...))
+	}
+
+	return constants.TaskFinishedMsg{
+		TaskId:      bulk.Id,
+		SectionId:   bulk.Section.Id,
+		SectionType: bulk.Section.Type,
+		Err:         err,
+		Msg:         BulkUpdateMsg{Msgs: msgs},
+	}
+}
+
+func ClosePRs(ctx *context.ProgramContext, section SectionIdentifier, prs []data.RowData) tea.Cmd {
+	return fireBulkTask(ctx, newBulkTask("pr_bulk_close", section,
+		"Closing %d PRs", "%d PRs have been closed", prs,
+		func(pr data.RowData) GitHubTask { return closePRTask(section, pr) }))
+}
+
+func ReopenPRs(ctx *context.ProgramContext, section SectionIdentifier, prs []data.RowData) tea.Cmd {
+	return fireBulkTask(ctx, newBulkTask("pr_bulk_reopen", section,
+		"Reopening %d PRs", "%d PRs have been reopened", prs,
+		func(pr data.RowData) GitHubTask { return reopenPRTask(section, pr) }))
+}
+
+func CommentOnPRs(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	prs []data.RowData,
+	body string,
+) tea.Cmd {
+	return fireBulkTask(ctx, newBulkTask("pr_bulk_comment", section,
+		"Commenting on %d PRs", "Commented on %d PRs", prs,
+		func(pr data.RowData) GitHubTask { return commentOnPRTask(ctx, section, pr, body) }))
+}
+
+func ApprovePRs(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	prs []data.RowData,
+	comment string,
+) tea.Cmd {
+	return fireBulkTask(ctx, newBulkTask("pr_bulk_approve", section,
+		"Approving %d PRs", "%d PRs have been approved", prs,
+		func(pr data.RowData) GitHubTask {
+			return submitReviewTask(ctx, section, pr, ReviewEventApprove, comment)
+		}))
+}
+
+func AssignPRs(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	prs []data.RowData,
+	usernames []string,
+) tea.Cmd {
+	return fireBulkTask(ctx, newBulkTask("pr_bulk_assign", section,
+		"Assigning %d PRs to "+fmt.Sprint(usernames),
+		"%d PRs have been assigned to "+fmt.Sprint(usernames), prs,
+		func(pr data.RowData) GitHubTask { return assignPRTask(section, pr, usernames) }))
+}
+
+func UnassignPRs(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	prs []data.RowData,
+	usernames []string,
+) tea.Cmd {
+	return fireBulkTask(ctx, newBulkTask("pr_bulk_unassign", section,
+		"Unassigning "+fmt.Sprint(usernames)+" from %d PRs",
+		fmt.Sprint(usernames)+" unassigned from %d PRs", prs,
+		func(pr data.RowData) GitHubTask { return unassignPRTask(section, pr, usernames) }))
+}
+
+// AddLabelsToPRs adds labels to every PR, keeping the labels they already have.
+func AddLabelsToPRs(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	prs []data.RowData,
+	labels []data.Label,
+) tea.Cmd {
+	return fireBulkTask(ctx, newBulkTask("pr_bulk_label", section,
+		"Labeling %d PRs with "+labelNames(labels),
+		"%d PRs have been labeled with "+labelNames(labels), prs,
+		func(pr data.RowData) GitHubTask { return addPRLabelsTask(section, pr, labels) }))
+}
+
+// SetPRsMilestone sets the milestone of every PR, or removes it when milestone is nil.
+func SetPRsMilestone(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	prs []data.RowData,
+	milestone *data.Milestone,
+) tea.Cmd {
+	startText, finishedText := bulkMilestoneTexts("PRs", milestone)
+	return fireBulkTask(ctx, newBulkTask("pr_bulk_milestone", section,
+		startText, finishedText, prs,
+		func(pr data.RowData) GitHubTask { return setPRMilestoneTask(section, pr, milestone) }))
+}
+
+func CloseIssues(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	issues []data.RowData,
+) tea.Cmd {
+	return fireBulkTask(ctx, newBulkTask("issue_bulk_close", section,
+		"Closing %d issues", "%d issues have been closed", issues,
+		func(issue data.RowData) GitHubTask { return closeIssueTask(section, issue) }))
+}
+
+func ReopenIssues(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	issues []data.RowData,
+) tea.Cmd {
+	return fireBulkTask(ctx, newBulkTask("issue_bulk_reopen", section,
+		"Reopening %d issues", "%d issues have been reopened", issues,
+		func(issue data.RowData) GitHubTask { return reopenIssueTask(section, issue) }))
+}
+
+func CommentOnIssues(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	issues []data.RowData,
+	body string,
+) tea.Cmd {
+	return fireBulkTask(ctx, newBulkTask("issue_bulk_comment", section,
+		"Commenting on %d issues", "Commented on %d issues", issues,
+		func(issue data.RowData) GitHubTask {
+			return commentOnIssueTask(ctx, section, issue, body)
+		}))
+}
+
+func AssignIssues(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	issues []data.RowData,
+	usernames []string,
+) tea.Cmd {
+	return fireBulkTask(ctx, newBulkTask("issue_bulk_assign", section,
+		"Assigning %d issues to "+fmt.Sprint(usernames),
+		"%d issues have been assigned to "+fmt.Sprint(usernames), issues,
+		func(issue data.RowData) GitHubTask {
+			return assignIssueTask(section, issue, usernames)
+		}))
+}
+
+func UnassignIssues(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	issues []data.RowData,
+	usernames []string,
+) tea.Cmd {
+	return fireBulkTask(ctx, newBulkTask("issue_bulk_unassign", section,
+		"Unassigning "+fmt.Sprint(usernames)+" from %d issues",
+		fmt.Sprint(usernames)+" unassigned from %d issues", issues,
+		func(issue data.RowData) GitHubTask {
+			return unassignIssueTask(section, issue, usernames)
+		}))
+}
+
+// AddLabelsToIssues adds labels to every issue, keeping the labels they already have.
+func AddLabelsToIssues(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	issues []data.RowData,
+	labels []data.Label,
+) tea.Cmd {
+	return fireBulkTask(ctx, newBulkTask("issue_bulk_label", section,
+		"Labeling %d issues with "+labelNames(labels),
+		"%d issues have been labeled with "+labelNames(labels), issues,
+		func(issue data.RowData) GitHubTask { return addIssueLabelsTask(section, issue, labels) }))
+}
+
+// SetIssuesMilestone sets the milestone of every issue, or removes it when milestone is nil.
+func SetIssuesMilestone(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	issues []data.RowData,
+	milestone *data.Milestone,
+) tea.Cmd {
+	startText, finishedText := bulkMilestoneTexts("issues", milestone)
+	return fireBulkTask(ctx, newBulkTask("issue_bulk_milestone", section,
+		startText, finishedText, issues,
+		func(issue data.RowData) GitHubTask {
+			return setIssueMilestoneTask(section, issue, milestone)
+		}))
+}
+
+// bulkMilestoneTexts returns the start and finished texts of a bulk milestone task, with a %d
+// verb for the number of rows.
+func bulkMilestoneTexts(plural string, milestone *data.Milestone) (string, string) {
+	if milestone == nil {
+		return "Removing the milestone of %d " + plural,
+			"The milestone of %d " + plural + " has been removed"
+	}
+	// newBulkTask formats the texts with the count, so a % in the title is escaped
+	title := strings.ReplaceAll(milestone.Title, "%", "%%")
+	return "Setting the milestone of %d " + plural + " to " + title,
+		"The milestone of %d " + plural + " has been set to " + title
+}
+
+func labelNames(labels []data.Label) string {
+	names := make([]string, 0, len(labels))
+	for _, label := range labels {
+		names = append(names, label.Name)
+	}
+	return fmt.Sprint(names)
+}
@@ -0,0 +1,136 @@
+package tasks
+
+import (
+	"errors"
+	"os/exec"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+func TestNewBulkTask_Configuration(t *testing.T) {
+	section := SectionIdentifier{Id: 1, Type: "pr"}
+	rows := []data.RowData{
+		mockIssue{number: 1, repoName: "owner/repo"},
+		mockIssue{number: 2, repoName: "owner/other"},
+	}
+
+	bulk := newBulkTask("pr_bulk_close", section,
+		"Closing %d PRs", "%d PRs have been closed", rows,
+		func(pr data.RowData) GitHubTask { return closePRTask(section, pr) })
+
+	require.Regexp(t, `^pr_bulk_close_2_\d+$`, bulk.Id)
+	require.Equal(t, section, bulk.Section)
+	require.Equal(t, "Closing 2 PRs", bulk.StartText)
+	require.Equal(t, "2 PRs have been closed", bulk.FinishedText)
+	require.Len(t, bulk.Tasks, 2)
+	require.Equal(t, []string{"pr", "close", "1", "-R", "owner/repo"}, bulk.Tasks[0].Args)
+	require.Equal(t, []string{"pr", "close", "2", "-R", "owner/other"}, bulk.Tasks[1].Args)
+}
+
+func TestRunBulkTask_AllSucceed(t *testing.T) {
+	section := SectionIdentifier{Id: 3, Type: "issue"}
+	rows := []data.RowData{
+		mockIssue{number: 1, repoName: "owner/repo"},
+		mockIssue{number: 2, repoName: "owner/repo"},
+		mockIssue{number: 3, repoName: "owner/repo"},
+	}
+	bulk := newBulkTask("issue_bulk_close", section,
+		"Closing %d issues", "%d issues have been closed", rows,
+		func(issue data.RowData) GitHubTask { return closeIssueTask(section, issue) })
+
+	var runs atomic.Int32
+	msg := runBulkTask(bulk, func(task GitHubTask) (*exec.Cmd, error) {
+		runs.Add(1)
+		return nil, nil
+	})
+
+	require.Equal(t, int32(3), runs.Load())
+	require.Equal(t, bulk.Id, msg.TaskId)
+	require.Equal(t, 3, msg.SectionId)
+	require.Equal(t, "issue", msg.SectionType)
+	require.NoError(t, msg.Err)
+
+	update, ok := msg.Msg.(BulkUpdateMsg)
+	require.True(t, ok, "Msg should be a BulkUpdateMsg")
+	require.Len(t, update.Msgs, 3)
+	for i, inner := range update.Msgs {
+		issueMsg, ok := inner.(UpdateIssueMsg)
+		require.True(t, ok)
+		require.Equal(t, i+1, issueMsg.IssueNumber, "messages should keep the order of the rows")
+		require.NotNil(t, issueMsg.IsClosed)
+		require.True(t, *issueMsg.IsClosed)
+	}
+}
+
+func TestRunBulkTask_PartialFailure(t *testing.T) {
+	section := SectionIdentifier{Id: 1, Type: "pr"}
+	rows := []data.RowData{
+		mockIssue{number: 1, repoName: "owner/repo"},
+		mockIssue{number: 2, repoName: "owner/repo"},
+	}
+	bulk := newBulkTask("pr_bulk_reopen", section,
+		"Reopening %d PRs", "%d PRs have been reopened", rows,
+		func(pr data.RowData) GitHubTask { return reopenPRTask(section, pr) })
+
+	failure := errors.New("not allowed")
+	msg := runBulkTask(bulk, func(task GitHubTask) (*exec.Cmd, error) {
+		if task.Id == "pr_reopen_2" {
+			return nil, failure
+		}
+		return nil, nil
+	})
+
+	require.Error(t, msg.Err)
+	require.ErrorIs(t, msg.Err, failure)
+	require.Contains(t, msg.Err.Error(), "1 of 2 failed")
+
+	update := msg.Msg.(BulkUpdateMsg)
+	reopened := update.Msgs[0].(UpdatePRMsg)
+	require.NotNil(t, reopened.IsClosed)
+	require.False(t, *reopened.IsClosed)
+
+	failed := update.Msgs[1].(UpdatePRMsg)
+	require.Equal(t, 2, failed.PrNumber)
+	require.Nil(t, failed.IsClosed, "a failed reopen must not change the PR state")
+}
+
+func TestAddPRLabels_TaskConfiguration(t *testing.T) {
+	section := SectionIdentifier{Id: 1, Type: "pr"}
+	labels := []data.Label{{Name: "bug", Color: "ff0000"}, {Name: "help wanted"}}
+
+	task := addPRLabelsTask(section, mockIssue{number: 7, repoName: "owner/repo"}, labels)
+
+	require.Equal(t, "pr_label_7", task.Id)
+	require.Equal(t, []string{
+		"pr", "edit", "7", "-R", "owner/repo",
+		"--add-label", "bug", "--add-label", "help wanted",
+	}, task.Args)
+	require.Equal(t, "Labeling PR #7 with [bug help wanted]", task.StartText)
+
+	msg := task.Msg(nil, nil).(UpdatePRMsg)
+	require.NotNil(t, msg.AddedLabels)
+	require.Equal(t, labels, msg.AddedLabels.Nodes)
+
+	msg = task.Msg(nil, errors.New("boom")).(UpdatePRMsg)
+	require.Nil(t, msg.AddedLabels)
+}
+
+func TestAddIssueLabels_TaskConfiguration(t *testing.T) {
+	section := SectionIdentifier{Id: 1, Type: "issue"}
+	labels := []data.Label{{Name: "bug"}}
+
+	task := addIssueLabelsTask(section, mockIssue{number: 9, repoName: "owner/repo"}, labels)
+
+	require.Equal(t, "issue_label_9", task.Id)
+	require.Equal(t, []string{
+		"issue", "edit", "9", "-R", "owner/repo", "--add-label", "bug",
+	}, task.Args)
+
+	msg := task.Msg(nil, nil).(UpdateIssueMsg)
+	require.NotNil(t, msg.AddedLabels)
+	require.Equal(t, labels, msg.AddedLabels.Nodes)
+}
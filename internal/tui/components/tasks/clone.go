@@ -0,0 +1,121 @@
+package tasks
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/log/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/common"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+var errNoRepoPath = errors.New(
+	"local path to repo not specified, set one in your config.yml under repoPaths",
+)
+
+// RepoClonedMsg carries on with what needed the local path of a repo once the repo was cloned.
+type RepoClonedMsg struct {
+	RepoName string
+	Path     string
+	Then     func(repoPath string) tea.Cmd
+}
+
+// ClonePath renders where the repo is cloned to from the path template of the clone config.
+func ClonePath(pathTemplate string, repoName string) (string, error) {
+	owner, name, ok := strings.Cut(repoName, "/")
+	if !ok {
+		return "", fmt.Errorf("invalid repo name %q", repoName)
+	}
+	tmpl, err := template.New("clone_path").Option("missingkey=error").Parse(pathTemplate)
+	if err != nil {
+		return "", fmt.Errorf("invalid clone path %q: %w", pathTemplate, err)
+	}
+	var buff bytes.Buffer
+	if err := tmpl.Execute(&buff, struct{ Owner, Name string }{owner, name}); err != nil {
+		return "", fmt.Errorf("invalid clone path %q: %w", pathTemplate, err)
+	}
+	return filepath.Clean(ExpandHome(buff.String())), nil
+}
+
+func ghRepoClone(repoName string, path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	c := exec.Command("gh", "repo", "clone", repoName, path)
+	log.Info("Running task", "cmd", "gh repo clone "+repoName, "path", path)
+	if output, err := c.CombinedOutput(); err != nil {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// cloneRepo clones the repo into path and records it in the store. A repo that was already
+// cloned there, just not mapped in repoPaths, is only recorded.
+func cloneRepo(
+	store *data.CloneStore,
+	clone func(repoName string, path string) error,
+	repoName string,
+	path string,
+) error {
+	if _, err := os.Stat(filepath.Join(path, ".git")); err != nil {
+		if err := clone(repoName, path); err != nil {
+			return err
+		}
+	}
+	return store.Set(repoName, path)
+}
+
+// CloneRepo clones the repo into the path of the clone config and then runs then with the path.
+func CloneRepo(
+	ctx *context.ProgramContext,
+	repoName string,
+	then func(repoPath string) tea.Cmd,
+) (tea.Cmd, error) {
+	path, err := ClonePath(ctx.Config.Clone.Path, repoName)
+	if err != nil {
+		return nil, err
+	}
+
+	taskId := fmt.Sprintf("clone_%s", repoName)
+	startCmd := ctx.StartTask(context.Task{
+		Id:           taskId,
+		StartText:    fmt.Sprintf("Cloning %s into %s", repoName, path),
+		FinishedText: fmt.Sprintf("%s has been cloned into %s", repoName, path),
+		State:        context.TaskStart,
+		Error:        nil,
+	})
+	return tea.Batch(startCmd, func() tea.Msg {
+		err := cloneRepo(data.GetCloneStore(), ghRepoClone, repoName, path)
+		return constants.TaskFinishedMsg{
+			TaskId: taskId,
+			Err:    err,
+			Msg:    RepoClonedMsg{RepoName: repoName, Path: path, Then: then},
+		}
+	}), nil
+}
+
+// WithRepoPath runs then with the local path of the repo. A repo without one is cloned first
+// when cloning on demand is enabled.
+func WithRepoPath(
+	ctx *context.ProgramContext,
+	repoName string,
+	then func(repoPath string) tea.Cmd,
+) (tea.Cmd, error) {
+	if repoPath, ok := common.FindRepoLocalPath(repoName, ctx.Config.RepoPaths); ok {
+		return then(repoPath), nil
+	}
+	if !ctx.Config.Clone.Enabled {
+		return nil, errNoRepoPath
+	}
+	return CloneRepo(ctx, repoName, then)
+}
@@ -0,0 +1,96 @@
+package tasks
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+func TestClonePath(t *testing.T) {
+	path, err := ClonePath("/src/{{.Owner}}/{{.Name}}", "dlvhdr/gh-dash")
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join("/src", "dlvhdr", "gh-dash"), path)
+
+	homeDir, err := os.UserHomeDir()
+	require.NoError(t, err)
+	path, err = ClonePath("~/code/{{.Name}}", "cli/cli")
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(homeDir, "code", "cli"), path)
+
+	_, err = ClonePath("/src/{{.Repo}}", "cli/cli")
+	require.Error(t, err, "unknown fields fail instead of rendering <no value>")
+	_, err = ClonePath("/src/{{.Name}}", "cli")
+	require.Error(t, err)
+}
+
+func TestCloneRepo(t *testing.T) {
+	dir := t.TempDir()
+	store := data.NewCloneStoreForTesting(filepath.Join(dir, "cloned-repos.json"))
+
+	var cloned []string
+	clone := func(repoName string, path string) error {
+		cloned = append(cloned, repoName)
+		return os.MkdirAll(filepath.Join(path, ".git"), 0o755)
+	}
+
+	path := filepath.Join(dir, "dlvhdr", "gh-dash")
+	require.NoError(t, cloneRepo(store, clone, "dlvhdr/gh-dash", path))
+	got, ok := store.Get("dlvhdr/gh-dash")
+	require.True(t, ok)
+	require.Equal(t, path, got)
+
+	require.NoError(t, cloneRepo(store, clone, "dlvhdr/gh-dash", path))
+	require.Equal(t, []string{"dlvhdr/gh-dash"}, cloned, "existing clones are only recorded")
+
+	failing := func(repoName string, path string) error { return errors.New("not found") }
+	require.Error(t, cloneRepo(store, failing, "dlvhdr/nope", filepath.Join(dir, "nope")))
+	_, ok = store.Get("dlvhdr/nope")
+	require.False(t, ok, "failed clones aren't recorded")
+}
+
+func TestWithRepoPath(t *testing.T) {
+	dir := t.TempDir()
+	restore := data.OverrideCloneStoreForTesting(
+		data.NewCloneStoreForTesting(filepath.Join(dir, "cloned-repos.json")))
+	defer restore()
+
+	var started []context.Task
+	ctx := &context.ProgramContext{
+		Config: &config.Config{
+			RepoPaths: map[string]string{"dlvhdr/gh-dash": "/code/gh-dash"},
+			Clone:     config.CloneConfig{Path: filepath.Join(dir, "{{.Owner}}", "{{.Name}}")},
+		},
+		StartTask: func(task context.Task) tea.Cmd {
+			started = append(started, task)
+			return nil
+		},
+	}
+	var gotPath string
+	then := func(repoPath string) tea.Cmd {
+		gotPath = repoPath
+		return nil
+	}
+
+	_, err := WithRepoPath(ctx, "dlvhdr/gh-dash", then)
+	require.NoError(t, err)
+	require.Equal(t, "/code/gh-dash", gotPath)
+
+	_, err = WithRepoPath(ctx, "dlvhdr/jb", then)
+	require.ErrorIs(t, err, errNoRepoPath, "cloning is opt-in")
+
+	ctx.Config.Clone.Enabled = true
+	cmd, err := WithRepoPath(ctx, "dlvhdr/jb", then)
+	require.NoError(t, err)
+	require.NotNil(t, cmd)
+	require.Len(t, started, 1)
+	require.Equal(t, "clone_dlvhdr/jb", started[0].Id)
+	require.Contains(t, started[0].StartText, filepath.Join(dir, "dlvhdr", "jb"))
+}
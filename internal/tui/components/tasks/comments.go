@@ -0,0 +1,318 @@
+package tasks
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/atotto/clipboard"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/shell"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+// CommentUpdate describes a comment, review or review comment of a PR or issue being edited,
+// deleted or hidden.
+type CommentUpdate struct {
+	Id          string
+	Body        *string
+	IsDeleted   bool
+	IsMinimized bool
+}
+
+// TimelineComment is the comment, review or review comment an action of the activity timeline
+// acts on.
+type TimelineComment struct {
+	Id       string
+	Typename string
+	Body     string
+	// Name describes the comment in the task texts, e.g. "@dlvhdr's comment on PR #12"
+	Name string
+}
+
+const (
+	updateIssueCommentMutation = `mutation($id: ID!, $body: String!) {
+  updateIssueComment(input: {id: $id, body: $body}) { issueComment { id } }
+}`
+	updateReviewMutation = `mutation($id: ID!, $body: String!) {
+  updatePullRequestReview(input: {pullRequestReviewId: $id, body: $body}) {
+    pullRequestReview { id }
+  }
+}`
+	updateReviewCommentMutation = `mutation($id: ID!, $body: String!) {
+  updatePullRequestReviewComment(input: {pullRequestReviewCommentId: $id, body: $body}) {
+    pullRequestReviewComment { id }
+  }
+}`
+	deleteIssueCommentMutation = `mutation($id: ID!) {
+  deleteIssueComment(input: {id: $id}) { clientMutationId }
+}`
+	deleteReviewMutation = `mutation($id: ID!) {
+  deletePullRequestReview(input: {pullRequestReviewId: $id}) { pullRequestReview { id } }
+}`
+	deleteReviewCommentMutation = `mutation($id: ID!) {
+  deletePullRequestReviewComment(input: {id: $id}) { pullRequestReview { id } }
+}`
+	hideOutdatedCommentMutation = `mutation($id: ID!) {
+  minimizeComment(input: {subjectId: $id, classifier: OUTDATED}) {
+    minimizedComment { isMinimized }
+  }
+}`
+)
+
+func updateCommentMutation(typename string) string {
+	switch typename {
+	case data.TimelineReview:
+		return updateReviewMutation
+	case data.TimelineReviewComment:
+		return updateReviewCommentMutation
+	}
+	return updateIssueCommentMutation
+}
+
+func deleteCommentMutation(typename string) string {
+	switch typename {
+	case data.TimelineReview:
+		return deleteReviewMutation
+	case data.TimelineReviewComment:
+		return deleteReviewCommentMutation
+	}
+	return deleteIssueCommentMutation
+}
+
+func commentArgs(mutation string, id string, fields ...string) []string {
+	args := []string{"api", "graphql", "-f", "query=" + mutation, "-f", "id=" + id}
+	for _, field := range fields {
+		args = append(args, "-f", field)
+	}
+	return args
+}
+
+func commentUpdateMsg(kind string, number int, update *CommentUpdate) tea.Msg {
+	if kind == "pr" {
+		return UpdatePRMsg{PrNumber: number, Comment: update}
+	}
+	return UpdateIssueMsg{IssueNumber: number, Comment: update}
+}
+
+func deleteCommentTask(
+	section SectionIdentifier,
+	kind string,
+	number int,
+	comment TimelineComment,
+) GitHubTask {
+	return GitHubTask{
+		Id:           buildTaskId(kind+"_delete_comment", number) + "_" + comment.Id,
+		Args:         commentArgs(deleteCommentMutation(comment.Typename), comment.Id),
+		Section:      section,
+		StartText:    fmt.Sprintf("Deleting %s", comment.Name),
+		FinishedText: fmt.Sprintf("Deleted %s", comment.Name),
+		Msg: func(c *exec.Cmd, err error) tea.Msg {
+			if err != nil {
+				return commentUpdateMsg(kind, number, nil)
+			}
+			return commentUpdateMsg(kind, number, &CommentUpdate{Id: comment.Id, IsDeleted: true})
+		},
+	}
+}
+
+func hideCommentTask(
+	section SectionIdentifier,
+	kind string,
+	number int,
+	comment TimelineComment,
+) GitHubTask {
+	return GitHubTask{
+		Id:           buildTaskId(kind+"_hide_comment", number) + "_" + comment.Id,
+		Args:         commentArgs(hideOutdatedCommentMutation, comment.Id),
+		Section:      section,
+		StartText:    fmt.Sprintf("Hiding %s as outdated", comment.Name),
+		FinishedText: fmt.Sprintf("Hid %s as outdated", comment.Name),
+		Msg: func(c *exec.Cmd, err error) tea.Msg {
+			if err != nil {
+				return commentUpdateMsg(kind, number, nil)
+			}
+			return commentUpdateMsg(kind, number,
+				&CommentUpdate{Id: comment.Id, IsMinimized: true})
+		},
+	}
+}
+
+// runCommentEdit submits the edited body of a comment. The file is removed once submitted and
+// kept on failure so the edits aren't lost.
+func runCommentEdit(
+	taskId string,
+	section SectionIdentifier,
+	target editTarget,
+	comment TimelineComment,
+	path string,
+	body string,
+	edit func(args []string) error,
+) constants.TaskFinishedMsg {
+	finished := constants.TaskFinishedMsg{
+		TaskId:      taskId,
+		SectionId:   section.Id,
+		SectionType: section.Type,
+	}
+
+	args := commentArgs(updateCommentMutation(comment.Typename), comment.Id, "body="+body)
+	if err := edit(args); err != nil {
+		finished.Err = fmt.Errorf("failed to update %s: %w, your edits are kept in %s",
+			comment.Name, err, path)
+		finished.Msg = commentUpdateMsg(target.kind, target.number, nil)
+		return finished
+	}
+	os.Remove(path)
+
+	finished.Msg = commentUpdateMsg(target.kind, target.number,
+		&CommentUpdate{Id: comment.Id, Body: utils.StringPtr(body)})
+	return finished
+}
+
+// editComment writes the body of the comment to a temp file and opens it in the editor. Once
+// the editor exits, the changes are submitted as a task.
+func editComment(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	target editTarget,
+	comment TimelineComment,
+) (tea.Cmd, error) {
+	file, err := os.CreateTemp("", fmt.Sprintf("gh-dash-%s-%d-comment-*.md", target.kind,
+		target.number))
+	if err != nil {
+		return nil, err
+	}
+	_, err = file.WriteString(comment.Body + "\n")
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(file.Name())
+		return nil, err
+	}
+	path := file.Name()
+
+	return tea.ExecProcess(shell.EditorCommand(path), func(err error) tea.Msg {
+		if err != nil {
+			os.Remove(path)
+			return constants.ErrMsg{Err: fmt.Errorf("failed running the editor: %w", err)}
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return constants.ErrMsg{Err: err}
+		}
+		body := strings.TrimSpace(strings.ReplaceAll(string(content), "\r\n", "\n"))
+		if body == "" {
+			os.Remove(path)
+			return constants.ErrMsg{Err: fmt.Errorf("%s can't be empty, delete it instead",
+				comment.Name)}
+		}
+		if body == strings.TrimSpace(strings.ReplaceAll(comment.Body, "\r\n", "\n")) {
+			os.Remove(path)
+			return nil
+		}
+
+		taskId := buildTaskId(target.kind+"_edit_comment", target.number) + "_" + comment.Id
+		startCmd := ctx.StartTask(context.Task{
+			Id:           taskId,
+			StartText:    fmt.Sprintf("Updating %s", comment.Name),
+			FinishedText: fmt.Sprintf("Updated %s", comment.Name),
+			State:        context.TaskStart,
+			Error:        nil,
+		})
+		return tea.BatchMsg{startCmd, func() tea.Msg {
+			edit := func(args []string) error {
+				_, err := runGh(args)
+				return err
+			}
+			return runCommentEdit(taskId, section, target, comment, path, body, edit)
+		}}
+	}), nil
+}
+
+// EditPRComment opens the body of a comment, review or review comment of the PR in the editor
+// and updates it with the result.
+func EditPRComment(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	pr data.RowData,
+	comment TimelineComment,
+) (tea.Cmd, error) {
+	return editComment(ctx, section, editTarget{kind: "pr", number: pr.GetNumber()}, comment)
+}
+
+// EditIssueComment opens the body of a comment of the issue in the editor and updates it with
+// the result.
+func EditIssueComment(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	issue data.RowData,
+	comment TimelineComment,
+) (tea.Cmd, error) {
+	return editComment(ctx, section, editTarget{kind: "issue", number: issue.GetNumber()},
+		comment)
+}
+
+// DeletePRComment deletes a comment, pending review or review comment of the PR.
+func DeletePRComment(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	pr data.RowData,
+	comment TimelineComment,
+) tea.Cmd {
+	return fireTask(ctx, deleteCommentTask(section, "pr", pr.GetNumber(), comment))
+}
+
+// DeleteIssueComment deletes a comment of the issue.
+func DeleteIssueComment(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	issue data.RowData,
+	comment TimelineComment,
+) tea.Cmd {
+	return fireTask(ctx, deleteCommentTask(section, "issue", issue.GetNumber(), comment))
+}
+
+// HidePRComment hides a comment, review or review comment of the PR as outdated.
+func HidePRComment(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	pr data.RowData,
+	comment TimelineComment,
+) tea.Cmd {
+	return fireTask(ctx, hideCommentTask(section, "pr", pr.GetNumber(), comment))
+}
+
+// HideIssueComment hides a comment of the issue as outdated.
+func HideIssueComment(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	issue data.RowData,
+	comment TimelineComment,
+) tea.Cmd {
+	return fireTask(ctx, hideCommentTask(section, "issue", issue.GetNumber(), comment))
+}
+
+// CopyLink copies the link to a comment or timeline event to the clipboard.
+func CopyLink(ctx *context.ProgramContext, url string) tea.Cmd {
+	id := "copy_link_" + url
+	startCmd := ctx.StartTask(context.Task{
+		Id:           id,
+		StartText:    "Copying " + url,
+		FinishedText: fmt.Sprintf("Copied %s to clipboard", url),
+		State:        context.TaskStart,
+		Error:        nil,
+	})
+	return tea.Batch(startCmd, func() tea.Msg {
+		err := clipboard.WriteAll(url)
+		if err != nil {
+			err = fmt.Errorf("failed copying to clipboard: %w", err)
+		}
+		return constants.TaskFinishedMsg{TaskId: id, Err: err}
+	})
+}
@@ -0,0 +1,86 @@
+package tasks
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+func TestRunCommentEdit(t *testing.T) {
+	sid := SectionIdentifier{Id: 1, Type: "issue"}
+	target := editTarget{kind: "issue", number: 3}
+	comment := TimelineComment{
+		Id:       "IC_1",
+		Typename: data.TimelineIssueComment,
+		Body:     "Old body",
+		Name:     "@octo's comment on issue #3",
+	}
+	editFile := func(t *testing.T) string {
+		path := filepath.Join(t.TempDir(), "gh-dash-issue-3-comment.md")
+		require.NoError(t, os.WriteFile(path, []byte("New body\n"), 0o600))
+		return path
+	}
+
+	t.Run("submits the edits", func(t *testing.T) {
+		path := editFile(t)
+		var editedArgs []string
+		edit := func(args []string) error {
+			editedArgs = args
+			return nil
+		}
+
+		msg := runCommentEdit("issue_edit_comment_3_IC_1", sid, target, comment, path, "New body", edit)
+		require.NoError(t, msg.Err)
+		require.Contains(t, editedArgs, "query="+updateIssueCommentMutation)
+		require.Contains(t, editedArgs, "id=IC_1")
+		require.Contains(t, editedArgs, "body=New body")
+		require.Equal(t, UpdateIssueMsg{
+			IssueNumber: 3,
+			Comment:     &CommentUpdate{Id: "IC_1", Body: utils.StringPtr("New body")},
+		}, msg.Msg)
+		require.NoFileExists(t, path)
+	})
+
+	t.Run("keeps the edits on failure", func(t *testing.T) {
+		path := editFile(t)
+		edit := func([]string) error { return errors.New("forbidden") }
+
+		msg := runCommentEdit("issue_edit_comment_3_IC_1", sid, target, comment, path, "New body", edit)
+		require.ErrorContains(t, msg.Err, path)
+		require.Equal(t, UpdateIssueMsg{IssueNumber: 3}, msg.Msg)
+		require.FileExists(t, path)
+	})
+}
+
+func TestCommentTasks(t *testing.T) {
+	sid := SectionIdentifier{Id: 1, Type: "pr"}
+	review := TimelineComment{
+		Id:       "PRR_1",
+		Typename: data.TimelineReview,
+		Name:     "@octo's review on pr #7",
+	}
+
+	task := deleteCommentTask(sid, "pr", 7, review)
+	require.Equal(t, "pr_delete_comment_7_PRR_1", task.Id)
+	require.Contains(t, task.Args, "query="+deleteReviewMutation)
+	require.Contains(t, task.Args, "id=PRR_1")
+	require.Equal(t, UpdatePRMsg{
+		PrNumber: 7,
+		Comment:  &CommentUpdate{Id: "PRR_1", IsDeleted: true},
+	}, task.Msg(nil, nil))
+	require.Equal(t, UpdatePRMsg{PrNumber: 7}, task.Msg(nil, errors.New("failed")))
+
+	task = hideCommentTask(sid, "pr", 7, review)
+	require.Contains(t, task.Args, "query="+hideOutdatedCommentMutation)
+	require.Equal(t, "Hid @octo's review on pr #7 as outdated", task.FinishedText)
+	require.Equal(t, UpdatePRMsg{
+		PrNumber: 7,
+		Comment:  &CommentUpdate{Id: "PRR_1", IsMinimized: true},
+	}, task.Msg(nil, nil))
+}
@@ -0,0 +1,134 @@
+package tasks
+
+import (
+	"fmt"
+	"os/exec"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+// UpdateDiscussionMsg updates the discussion with the given node id after an action on it
+// finished.
+type UpdateDiscussionMsg struct {
+	DiscussionId string
+	NewComment   *data.DiscussionComment
+	// AnswerId is the id of the comment marked as the answer, or empty when the answer was
+	// unmarked
+	AnswerId *string
+}
+
+const (
+	addDiscussionCommentMutation = `mutation($discussionId: ID!, $body: String!) {
+  addDiscussionComment(input: {discussionId: $discussionId, body: $body}) { comment { id } }
+}`
+	markDiscussionAnswerMutation = `mutation($commentId: ID!) {
+  markDiscussionCommentAsAnswer(input: {id: $commentId}) { discussion { id } }
+}`
+	unmarkDiscussionAnswerMutation = `mutation($commentId: ID!) {
+  unmarkDiscussionCommentAsAnswer(input: {id: $commentId}) { discussion { id } }
+}`
+)
+
+func commentOnDiscussionTask(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	discussion *data.DiscussionData,
+	body string,
+) GitHubTask {
+	number := discussion.GetNumber()
+	discussionId := discussion.Id
+	return GitHubTask{
+		Id: buildTaskId("discussion_comment", number),
+		Args: []string{
+			"api",
+			"graphql",
+			"-f",
+			"query=" + addDiscussionCommentMutation,
+			"-f",
+			"discussionId=" + discussionId,
+			"-f",
+			"body=" + body,
+		},
+		Section:      section,
+		StartText:    fmt.Sprintf("Commenting on discussion #%d", number),
+		FinishedText: fmt.Sprintf("Commented on discussion #%d", number),
+		Msg: func(c *exec.Cmd, err error) tea.Msg {
+			if err != nil {
+				return UpdateDiscussionMsg{DiscussionId: discussionId}
+			}
+			return UpdateDiscussionMsg{
+				DiscussionId: discussionId,
+				NewComment: &data.DiscussionComment{
+					Author:    struct{ Login string }{Login: ctx.User},
+					Body:      body,
+					UpdatedAt: time.Now(),
+				},
+			}
+		},
+	}
+}
+
+func CommentOnDiscussion(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	discussion *data.DiscussionData,
+	body string,
+) tea.Cmd {
+	return fireTask(ctx, commentOnDiscussionTask(ctx, section, discussion, body))
+}
+
+func markDiscussionAnswerTask(
+	section SectionIdentifier,
+	discussion *data.DiscussionData,
+	commentId string,
+	mark bool,
+) GitHubTask {
+	number := discussion.GetNumber()
+	discussionId := discussion.Id
+	mutation, prefix := markDiscussionAnswerMutation, "discussion_mark_answer"
+	startText := fmt.Sprintf("Marking answer of discussion #%d", number)
+	finishedText := fmt.Sprintf("Marked answer of discussion #%d", number)
+	answerId := commentId
+	if !mark {
+		mutation, prefix = unmarkDiscussionAnswerMutation, "discussion_unmark_answer"
+		startText = fmt.Sprintf("Unmarking answer of discussion #%d", number)
+		finishedText = fmt.Sprintf("Unmarked answer of discussion #%d", number)
+		answerId = ""
+	}
+	return GitHubTask{
+		Id: buildTaskId(prefix, number),
+		Args: []string{
+			"api",
+			"graphql",
+			"-f",
+			"query=" + mutation,
+			"-f",
+			"commentId=" + commentId,
+		},
+		Section:      section,
+		StartText:    startText,
+		FinishedText: finishedText,
+		Msg: func(c *exec.Cmd, err error) tea.Msg {
+			if err != nil {
+				return UpdateDiscussionMsg{DiscussionId: discussionId}
+			}
+			return UpdateDiscussionMsg{DiscussionId: discussionId, AnswerId: &answerId}
+		},
+	}
+}
+
+// MarkDiscussionAnswer marks the comment as the answer of the discussion, or unmarks it when
+// mark is false.
+func MarkDiscussionAnswer(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	discussion *data.DiscussionData,
+	commentId string,
+	mark bool,
+) tea.Cmd {
+	return fireTask(ctx, markDiscussionAnswerTask(section, discussion, commentId, mark))
+}
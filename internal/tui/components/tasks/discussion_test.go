@@ -0,0 +1,61 @@
+package tasks
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+func TestCommentOnDiscussion_TaskConfiguration(t *testing.T) {
+	ctx := &context.ProgramContext{User: "me"}
+	section := SectionIdentifier{Id: 1, Type: "discussion"}
+	discussion := &data.DiscussionData{Id: "D_1", Number: 7}
+
+	task := commentOnDiscussionTask(ctx, section, discussion, "+1")
+
+	require.Equal(t, "discussion_comment_7", task.Id)
+	require.Equal(t, []string{
+		"api", "graphql",
+		"-f", "query=" + addDiscussionCommentMutation,
+		"-f", "discussionId=D_1",
+		"-f", "body=+1",
+	}, task.Args)
+
+	updateMsg, ok := task.Msg(nil, nil).(UpdateDiscussionMsg)
+	require.True(t, ok, "Msg should return UpdateDiscussionMsg")
+	require.Equal(t, "D_1", updateMsg.DiscussionId)
+	require.Equal(t, "me", updateMsg.NewComment.Author.Login)
+	require.Equal(t, "+1", updateMsg.NewComment.Body)
+
+	failedMsg := task.Msg(nil, fmt.Errorf("boom")).(UpdateDiscussionMsg)
+	require.Nil(t, failedMsg.NewComment, "a failed comment must not be added")
+}
+
+func TestMarkDiscussionAnswer_TaskConfiguration(t *testing.T) {
+	section := SectionIdentifier{Id: 1, Type: "discussion"}
+	discussion := &data.DiscussionData{Id: "D_1", Number: 7}
+
+	for _, mark := range []bool{true, false} {
+		task := markDiscussionAnswerTask(section, discussion, "DC_2", mark)
+
+		mutation, id, answerId := markDiscussionAnswerMutation, "discussion_mark_answer_7", "DC_2"
+		if !mark {
+			mutation, id, answerId = unmarkDiscussionAnswerMutation, "discussion_unmark_answer_7", ""
+		}
+		require.Equal(t, id, task.Id)
+		require.Equal(t, []string{
+			"api", "graphql", "-f", "query=" + mutation, "-f", "commentId=DC_2",
+		}, task.Args)
+
+		updateMsg := task.Msg(nil, nil).(UpdateDiscussionMsg)
+		require.Equal(t, "D_1", updateMsg.DiscussionId)
+		require.Equal(t, answerId, *updateMsg.AnswerId)
+
+		failedMsg := task.Msg(nil, fmt.Errorf("boom")).(UpdateDiscussionMsg)
+		require.Nil(t, failedMsg.AnswerId)
+	}
+}
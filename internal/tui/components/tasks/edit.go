@@ -0,0 +1,249 @@
+package tasks
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/shell"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+// editTarget is the issue or PR whose title and body are being edited.
+type editTarget struct {
+	kind   string
+	number int
+	url    string
+	// title and body are the title and body as fetched before opening the editor
+	title string
+	body  string
+}
+
+func (t editTarget) noun() string {
+	if t.kind == "pr" {
+		return "PR"
+	}
+	return "Issue"
+}
+
+// editFileContent is the content of the file the title and body are edited in: the title on
+// the first line and the body after a blank line, like a commit message.
+func editFileContent(title, body string) string {
+	return title + "\n\n" + body + "\n"
+}
+
+// parseEditFile reverses editFileContent.
+func parseEditFile(content string) (title string, body string) {
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	title, body, _ = strings.Cut(content, "\n")
+	return strings.TrimSpace(title), strings.TrimSpace(body)
+}
+
+// sameTitleAndBody compares title and body content ignoring the line endings and surrounding
+// whitespace GitHub keeps in bodies written in the browser.
+func sameTitleAndBody(a, b data.TitleAndBody) bool {
+	aTitle, aBody := parseEditFile(editFileContent(a.Title, a.Body))
+	bTitle, bBody := parseEditFile(editFileContent(b.Title, b.Body))
+	return aTitle == bTitle && aBody == bBody
+}
+
+const (
+	updateIssueMutation = `mutation($id: ID!, $title: String!, $body: String!) {
+  updateIssue(input: {id: $id, title: $title, body: $body}) { issue { id } }
+}`
+	updatePullRequestMutation = `mutation($id: ID!, $title: String!, $body: String!) {
+  updatePullRequest(input: {pullRequestId: $id, title: $title, body: $body}) {
+    pullRequest { id }
+  }
+}`
+)
+
+func editArgs(target editTarget, id string, edited data.TitleAndBody) []string {
+	mutation := updateIssueMutation
+	if target.kind == "pr" {
+		mutation = updatePullRequestMutation
+	}
+	return []string{
+		"api",
+		"graphql",
+		"-f", "query=" + mutation,
+		"-f", "id=" + id,
+		"-f", "title=" + edited.Title,
+		"-f", "body=" + edited.Body,
+	}
+}
+
+// errEditConflict is returned when the title or body changed on GitHub while being edited.
+var errEditConflict = errors.New("changed on GitHub while being edited")
+
+// runEdit submits the edits unless the title or body changed remotely since it was
+// opened. The file is removed once submitted and kept on a conflict so the edits aren't lost.
+func runEdit(
+	taskId string,
+	section SectionIdentifier,
+	target editTarget,
+	path string,
+	edited data.TitleAndBody,
+	fetch func(url string) (data.TitleAndBody, error),
+	edit func(args []string) error,
+) constants.TaskFinishedMsg {
+	finished := constants.TaskFinishedMsg{
+		TaskId:      taskId,
+		SectionId:   section.Id,
+		SectionType: section.Type,
+	}
+
+	remote, err := fetch(target.url)
+	if err != nil {
+		finished.Err = err
+		return finished
+	}
+	if !sameTitleAndBody(remote, data.TitleAndBody{Title: target.title, Body: target.body}) {
+		finished.Err = fmt.Errorf("%s #%d %w, your edits are kept in %s",
+			target.noun(), target.number, errEditConflict, path)
+		return finished
+	}
+
+	if err := edit(editArgs(target, remote.Id, edited)); err != nil {
+		finished.Err = fmt.Errorf("failed to update %s #%d: %w, your edits are kept in %s",
+			target.noun(), target.number, err, path)
+		return finished
+	}
+	os.Remove(path)
+
+	if target.kind == "pr" {
+		finished.Msg = UpdatePRMsg{
+			PrNumber: target.number,
+			Title:    utils.StringPtr(edited.Title),
+			Body:     utils.StringPtr(edited.Body),
+		}
+	} else {
+		finished.Msg = UpdateIssueMsg{
+			IssueNumber: target.number,
+			Title:       utils.StringPtr(edited.Title),
+			Body:        utils.StringPtr(edited.Body),
+		}
+	}
+	return finished
+}
+
+// editTitleAndBody fetches the current title and body, writes them to a temp file and opens
+// it in the editor. Once the editor exits, the changes are submitted as a task, unless the
+// title or body changed on GitHub since they were fetched.
+func editTitleAndBody(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	target editTarget,
+) tea.Cmd {
+	return func() tea.Msg {
+		remote, err := data.FetchTitleAndBody(target.url)
+		if err != nil {
+			return constants.ErrMsg{Err: fmt.Errorf("failed to fetch %s #%d: %w",
+				target.noun(), target.number, err)}
+		}
+		target.title, target.body = remote.Title, remote.Body
+
+		path, err := writeEditFile(target)
+		if err != nil {
+			return constants.ErrMsg{Err: err}
+		}
+		return tea.ExecProcess(shell.EditorCommand(path), func(err error) tea.Msg {
+			return editorExited(ctx, section, target, path, err)
+		})()
+	}
+}
+
+// writeEditFile writes the title and body of the target to a temp file and returns its path.
+func writeEditFile(target editTarget) (string, error) {
+	file, err := os.CreateTemp("", fmt.Sprintf("gh-dash-%s-%d-*.md", target.kind, target.number))
+	if err != nil {
+		return "", err
+	}
+	_, err = file.WriteString(editFileContent(target.title, target.body))
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(file.Name())
+		return "", err
+	}
+	return file.Name(), nil
+}
+
+// editorExited submits the title and body edited in the file at path as a task.
+func editorExited(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	target editTarget,
+	path string,
+	err error,
+) tea.Msg {
+	if err != nil {
+		os.Remove(path)
+		return constants.ErrMsg{Err: fmt.Errorf("failed running the editor: %w", err)}
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return constants.ErrMsg{Err: err}
+	}
+	title, body := parseEditFile(string(content))
+	if title == "" {
+		os.Remove(path)
+		return constants.ErrMsg{Err: fmt.Errorf("%s #%d needs a title", target.noun(),
+			target.number)}
+	}
+	edited := data.TitleAndBody{Title: title, Body: body}
+	if sameTitleAndBody(edited, data.TitleAndBody{Title: target.title, Body: target.body}) {
+		os.Remove(path)
+		return nil
+	}
+
+	taskId := buildTaskId(target.kind+"_edit", target.number)
+	startCmd := ctx.StartTask(context.Task{
+		Id:           taskId,
+		StartText:    fmt.Sprintf("Updating %s #%d", target.noun(), target.number),
+		FinishedText: fmt.Sprintf("%s #%d has been updated", target.noun(), target.number),
+		State:        context.TaskStart,
+		Error:        nil,
+	})
+	return tea.BatchMsg{startCmd, func() tea.Msg {
+		edit := func(args []string) error {
+			_, err := runGh(args)
+			return err
+		}
+		return runEdit(taskId, section, target, path, edited, data.FetchTitleAndBody, edit)
+	}}
+}
+
+// EditIssue opens the title and body of the issue in the editor and updates the issue with
+// the result.
+func EditIssue(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	issue data.IssueData,
+) tea.Cmd {
+	return editTitleAndBody(ctx, section, editTarget{
+		kind:   "issue",
+		number: issue.Number,
+		url:    issue.Url,
+	})
+}
+
+// EditPR opens the title and body of the PR in the editor and updates the PR with the result.
+func EditPR(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	pr data.RowData,
+) tea.Cmd {
+	return editTitleAndBody(ctx, section, editTarget{
+		kind:   "pr",
+		number: pr.GetNumber(),
+		url:    pr.GetUrl(),
+	})
+}
@@ -0,0 +1,120 @@
+package tasks
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+func TestParseEditFile(t *testing.T) {
+	title, body := parseEditFile(editFileContent("Fix typo", "Some *body*\n\nwith lines"))
+	require.Equal(t, "Fix typo", title)
+	require.Equal(t, "Some *body*\n\nwith lines", body)
+
+	title, body = parseEditFile("  Only a title  \r\n")
+	require.Equal(t, "Only a title", title)
+	require.Empty(t, body)
+
+	require.True(t, sameTitleAndBody(
+		data.TitleAndBody{Id: "I_1", Title: "Title", Body: "Line\r\nother line\r\n"},
+		data.TitleAndBody{Title: "Title", Body: "Line\nother line"},
+	), "line endings and node ids should be ignored")
+}
+
+func TestRunEdit(t *testing.T) {
+	sid := SectionIdentifier{Id: 1, Type: "pr"}
+	target := editTarget{
+		kind:   "pr",
+		number: 7,
+		url:    "https://github.com/dlvhdr/gh-dash/pull/7",
+		title:  "Fix typo",
+		body:   "Old body",
+	}
+	edited := data.TitleAndBody{Title: "Fix typos", Body: "New body"}
+	editFile := func(t *testing.T) string {
+		path := filepath.Join(t.TempDir(), "gh-dash-pr-7.md")
+		require.NoError(t, os.WriteFile(path, []byte("Fix typos\n\nNew body\n"), 0o600))
+		return path
+	}
+
+	t.Run("submits the edits", func(t *testing.T) {
+		path := editFile(t)
+		fetch := func(string) (data.TitleAndBody, error) {
+			return data.TitleAndBody{Id: "PR_7", Title: "Fix typo", Body: "Old body\r\n"}, nil
+		}
+		var editedArgs []string
+		edit := func(args []string) error {
+			editedArgs = args
+			return nil
+		}
+
+		msg := runEdit("pr_edit_7", sid, target, path, edited, fetch, edit)
+		require.NoError(t, msg.Err)
+		require.Contains(t, editedArgs, "query="+updatePullRequestMutation)
+		require.Contains(t, editedArgs, "id=PR_7")
+		require.Contains(t, editedArgs, "body=New body")
+		require.Equal(t, UpdatePRMsg{
+			PrNumber: 7,
+			Title:    utils.StringPtr("Fix typos"),
+			Body:     utils.StringPtr("New body"),
+		}, msg.Msg)
+		require.NoFileExists(t, path)
+	})
+
+	t.Run("keeps the edits on a conflict", func(t *testing.T) {
+		path := editFile(t)
+		fetch := func(string) (data.TitleAndBody, error) {
+			return data.TitleAndBody{Id: "PR_7", Title: "Fix typo", Body: "Edited elsewhere"}, nil
+		}
+		edit := func([]string) error {
+			t.Fatal("conflicting edits shouldn't be submitted")
+			return nil
+		}
+
+		msg := runEdit("pr_edit_7", sid, target, path, edited, fetch, edit)
+		require.ErrorIs(t, msg.Err, errEditConflict)
+		require.ErrorContains(t, msg.Err, path)
+		require.Nil(t, msg.Msg)
+		require.FileExists(t, path)
+	})
+
+	t.Run("keeps the edits when the update fails", func(t *testing.T) {
+		path := editFile(t)
+		fetch := func(string) (data.TitleAndBody, error) {
+			return data.TitleAndBody{Id: "PR_7", Title: "Fix typo", Body: "Old body"}, nil
+		}
+		edit := func([]string) error { return fmt.Errorf("exit status 1") }
+
+		msg := runEdit("pr_edit_7", sid, target, path, edited, fetch, edit)
+		require.ErrorContains(t, msg.Err, path)
+		require.FileExists(t, path)
+	})
+
+	t.Run("updates issues", func(t *testing.T) {
+		issue := target
+		issue.kind = "issue"
+		fetch := func(string) (data.TitleAndBody, error) {
+			return data.TitleAndBody{Id: "I_7", Title: "Fix typo", Body: "Old body"}, nil
+		}
+		var editedArgs []string
+		edit := func(args []string) error {
+			editedArgs = args
+			return nil
+		}
+
+		msg := runEdit("issue_edit_7", sid, issue, editFile(t), edited, fetch, edit)
+		require.NoError(t, msg.Err)
+		require.Contains(t, editedArgs, "query="+updateIssueMutation)
+		require.Equal(t, UpdateIssueMsg{
+			IssueNumber: 7,
+			Title:       utils.StringPtr("Fix typos"),
+			Body:        utils.StringPtr("New body"),
+		}, msg.Msg)
+	})
+}
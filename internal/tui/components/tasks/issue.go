@@ -3,31 +3,35 @@ package tasks
 import (
 	"fmt"
 	"os/exec"
+	"strings"
 	"time"
 
 	tea "charm.land/bubbletea/v2"
 
 	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
 	"github.com/dlvhdr/gh-dash/v4/internal/utils"
 )
 
 type UpdateIssueMsg struct {
 	IssueNumber      int
+	Title            *string
+	Body             *string
 	Labels           *data.IssueLabels
+	AddedLabels      *data.IssueLabels
 	NewComment       *data.IssueComment
 	IsClosed         *bool
 	AddedAssignees   *data.Assignees
 	RemovedAssignees *data.Assignees
+	Milestone        *MilestoneUpdate
+	Reaction         *ReactionUpdate
+	Comment          *CommentUpdate
 }
 
-func CloseIssue(
-	ctx *context.ProgramContext,
-	section SectionIdentifier,
-	issue data.RowData,
-) tea.Cmd {
+func closeIssueTask(section SectionIdentifier, issue data.RowData) GitHubTask {
 	issueNumber := issue.GetNumber()
-	return fireTask(ctx, GitHubTask{
+	return GitHubTask{
 		Id: fmt.Sprintf("issue_close_%d", issueNumber),
 		Args: []string{
 			"issue",
@@ -40,21 +44,28 @@ func CloseIssue(
 		StartText:    fmt.Sprintf("Closing issue #%d", issueNumber),
 		FinishedText: fmt.Sprintf("Issue #%d has been closed", issueNumber),
 		Msg: func(c *exec.Cmd, err error) tea.Msg {
+			if err != nil {
+				return UpdateIssueMsg{IssueNumber: issueNumber}
+			}
 			return UpdateIssueMsg{
 				IssueNumber: issueNumber,
 				IsClosed:    utils.BoolPtr(true),
 			}
 		},
-	})
+	}
 }
 
-func ReopenIssue(
+func CloseIssue(
 	ctx *context.ProgramContext,
 	section SectionIdentifier,
 	issue data.RowData,
 ) tea.Cmd {
+	return fireTask(ctx, closeIssueTask(section, issue))
+}
+
+func reopenIssueTask(section SectionIdentifier, issue data.RowData) GitHubTask {
 	issueNumber := issue.GetNumber()
-	return fireTask(ctx, GitHubTask{
+	return GitHubTask{
 		Id: fmt.Sprintf("issue_reopen_%d", issueNumber),
 		Args: []string{
 			"issue",
@@ -67,20 +78,30 @@ func ReopenIssue(
 		StartText:    fmt.Sprintf("Reopening issue #%d", issueNumber),
 		FinishedText: fmt.Sprintf("Issue #%d has been reopened", issueNumber),
 		Msg: func(c *exec.Cmd, err error) tea.Msg {
+			if err != nil {
+				return UpdateIssueMsg{IssueNumber: issueNumber}
+			}
 			return UpdateIssueMsg{
 				IssueNumber: issueNumber,
 				IsClosed:    utils.BoolPtr(false),
 			}
 		},
-	})
+	}
 }
 
-func AssignIssue(
+func ReopenIssue(
 	ctx *context.ProgramContext,
 	section SectionIdentifier,
 	issue data.RowData,
-	usernames []string,
 ) tea.Cmd {
+	return fireTask(ctx, reopenIssueTask(section, issue))
+}
+
+func assignIssueTask(
+	section SectionIdentifier,
+	issue data.RowData,
+	usernames []string,
+) GitHubTask {
 	issueNumber := issue.GetNumber()
 	args := []string{
 		"issue",
@@ -92,13 +113,16 @@ func AssignIssue(
 	for _, assignee := range usernames {
 		args = append(args, "--add-assignee", assignee)
 	}
-	return fireTask(ctx, GitHubTask{
+	return GitHubTask{
 		Id:           fmt.Sprintf("issue_assign_%d", issueNumber),
 		Args:         args,
 		Section:      section,
 		StartText:    fmt.Sprintf("Assigning issue #%d to %s", issueNumber, usernames),
 		FinishedText: fmt.Sprintf("Issue #%d has been assigned to %s", issueNumber, usernames),
 		Msg: func(c *exec.Cmd, err error) tea.Msg {
+			if err != nil {
+				return UpdateIssueMsg{IssueNumber: issueNumber}
+			}
 			returnedAssignees := data.Assignees{Nodes: []data.Assignee{}}
 			for _, assignee := range usernames {
 				returnedAssignees.Nodes = append(
@@ -111,15 +135,23 @@ func AssignIssue(
 				AddedAssignees: &returnedAssignees,
 			}
 		},
-	})
+	}
 }
 
-func UnassignIssue(
+func AssignIssue(
 	ctx *context.ProgramContext,
 	section SectionIdentifier,
 	issue data.RowData,
 	usernames []string,
 ) tea.Cmd {
+	return fireTask(ctx, assignIssueTask(section, issue, usernames))
+}
+
+func unassignIssueTask(
+	section SectionIdentifier,
+	issue data.RowData,
+	usernames []string,
+) GitHubTask {
 	issueNumber := issue.GetNumber()
 	args := []string{
 		"issue",
@@ -131,13 +163,16 @@ func UnassignIssue(
 	for _, assignee := range usernames {
 		args = append(args, "--remove-assignee", assignee)
 	}
-	return fireTask(ctx, GitHubTask{
+	return GitHubTask{
 		Id:           fmt.Sprintf("issue_unassign_%d", issueNumber),
 		Args:         args,
 		Section:      section,
 		StartText:    fmt.Sprintf("Unassigning %s from issue #%d", usernames, issueNumber),
 		FinishedText: fmt.Sprintf("%s unassigned from issue #%d", usernames, issueNumber),
 		Msg: func(c *exec.Cmd, err error) tea.Msg {
+			if err != nil {
+				return UpdateIssueMsg{IssueNumber: issueNumber}
+			}
 			returnedAssignees := data.Assignees{Nodes: []data.Assignee{}}
 			for _, assignee := range usernames {
 				returnedAssignees.Nodes = append(
@@ -150,17 +185,26 @@ func UnassignIssue(
 				RemovedAssignees: &returnedAssignees,
 			}
 		},
-	})
+	}
 }
 
-func CommentOnIssue(
+func UnassignIssue(
 	ctx *context.ProgramContext,
 	section SectionIdentifier,
 	issue data.RowData,
-	body string,
+	usernames []string,
 ) tea.Cmd {
+	return fireTask(ctx, unassignIssueTask(section, issue, usernames))
+}
+
+func commentOnIssueTask(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	issue data.RowData,
+	body string,
+) GitHubTask {
 	issueNumber := issue.GetNumber()
-	return fireTask(ctx, GitHubTask{
+	return GitHubTask{
 		Id: fmt.Sprintf("issue_comment_%d", issueNumber),
 		Args: []string{
 			"issue",
@@ -175,16 +219,30 @@ func CommentOnIssue(
 		StartText:    fmt.Sprintf("Commenting on issue #%d", issueNumber),
 		FinishedText: fmt.Sprintf("Commented on issue #%d", issueNumber),
 		Msg: func(c *exec.Cmd, err error) tea.Msg {
+			if err != nil {
+				return UpdateIssueMsg{IssueNumber: issueNumber}
+			}
+			now := time.Now()
 			return UpdateIssueMsg{
 				IssueNumber: issueNumber,
 				NewComment: &data.IssueComment{
-					Author:    struct{ Login string }{Login: ctx.User},
-					Body:      body,
-					UpdatedAt: time.Now(),
+					Author:      struct{ Login string }{Login: ctx.User},
+					Body:        body,
+					UpdatedAt:   now,
+					CommentMeta: data.CommentMeta{CreatedAt: now},
 				},
 			}
 		},
-	})
+	}
+}
+
+func CommentOnIssue(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	issue data.RowData,
+	body string,
+) tea.Cmd {
+	return fireTask(ctx, commentOnIssueTask(ctx, section, issue, body))
 }
 
 func LabelIssue(
@@ -244,3 +302,137 @@ func LabelIssue(
 		},
 	})
 }
+
+// addIssueLabelsTask adds labels to an issue without removing the ones it already has.
+func addIssueLabelsTask(
+	section SectionIdentifier,
+	issue data.RowData,
+	labels []data.Label,
+) GitHubTask {
+	issueNumber := issue.GetNumber()
+	args := []string{
+		"issue",
+		"edit",
+		fmt.Sprint(issueNumber),
+		"-R",
+		issue.GetRepoNameWithOwner(),
+	}
+	for _, label := range labels {
+		args = append(args, "--add-label", label.Name)
+	}
+	names := labelNames(labels)
+	return GitHubTask{
+		Id:           fmt.Sprintf("issue_label_%d", issueNumber),
+		Args:         args,
+		Section:      section,
+		StartText:    fmt.Sprintf("Labeling issue #%d with %s", issueNumber, names),
+		FinishedText: fmt.Sprintf("Issue #%d has been labeled with %s", issueNumber, names),
+		Msg: func(c *exec.Cmd, err error) tea.Msg {
+			if err != nil {
+				return UpdateIssueMsg{IssueNumber: issueNumber}
+			}
+			return UpdateIssueMsg{
+				IssueNumber: issueNumber,
+				AddedLabels: &data.IssueLabels{Nodes: labels},
+			}
+		},
+	}
+}
+
+// IssueDraft is an issue to create in Repo.
+type IssueDraft struct {
+	Repo      string
+	Title     string
+	Body      string
+	Labels    []string
+	Assignees []string
+}
+
+// IssueCreatedMsg is sent after an issue was created, with the issue as fetched from GitHub so
+// the section can show it.
+type IssueCreatedMsg struct {
+	Issue data.IssueData
+}
+
+func createIssueArgs(draft IssueDraft) []string {
+	args := []string{
+		"issue",
+		"create",
+		"-R",
+		draft.Repo,
+		"--title",
+		draft.Title,
+		"--body",
+		draft.Body,
+	}
+	for _, label := range draft.Labels {
+		args = append(args, "--label", label)
+	}
+	for _, assignee := range draft.Assignees {
+		args = append(args, "--assignee", assignee)
+	}
+	return args
+}
+
+// createdIssueUrl returns the url gh prints after creating an issue, after any warnings.
+func createdIssueUrl(output string) (string, bool) {
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	url := strings.TrimSpace(lines[len(lines)-1])
+	return url, strings.HasPrefix(url, "https://")
+}
+
+// runCreateIssue creates the issue and fetches it, so it can be added to the section with all
+// the fields the section shows.
+func runCreateIssue(
+	taskId string,
+	section SectionIdentifier,
+	draft IssueDraft,
+	create func(args []string) ([]byte, error),
+	fetchIssue func(url string) (data.IssueData, error),
+) constants.TaskFinishedMsg {
+	finished := constants.TaskFinishedMsg{
+		TaskId:      taskId,
+		SectionId:   section.Id,
+		SectionType: section.Type,
+	}
+
+	output, err := create(createIssueArgs(draft))
+	if err != nil {
+		finished.Err = err
+		return finished
+	}
+	url, ok := createdIssueUrl(string(output))
+	if !ok {
+		finished.Err = fmt.Errorf("unexpected output of gh issue create: %q", output)
+		return finished
+	}
+
+	issue, err := fetchIssue(url)
+	if err != nil {
+		finished.Err = fmt.Errorf("failed to fetch the created issue %s: %w", url, err)
+		return finished
+	}
+	finished.Msg = IssueCreatedMsg{Issue: issue}
+	return finished
+}
+
+// CreateIssue creates an issue and adds it to the section once created.
+func CreateIssue(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	draft IssueDraft,
+) tea.Cmd {
+	taskId := fmt.Sprintf("issue_create_%s_%d", draft.Repo, time.Now().UnixNano())
+	task := context.Task{
+		Id:           taskId,
+		StartText:    fmt.Sprintf(`Creating issue "%s" in %s`, draft.Title, draft.Repo),
+		FinishedText: fmt.Sprintf(`Issue "%s" has been created`, draft.Title),
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+	startCmd := ctx.StartTask(task)
+
+	return tea.Batch(startCmd, func() tea.Msg {
+		return runCreateIssue(taskId, section, draft, runGh, data.FetchIssue)
+	})
+}
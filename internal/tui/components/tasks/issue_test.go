@@ -477,3 +477,70 @@ func TestReopenIssue_CommandArgs(t *testing.T) {
 func boolPtr(b bool) *bool {
 	return &b
 }
+
+func TestCreateIssueArgs(t *testing.T) {
+	args := createIssueArgs(IssueDraft{
+		Repo:      "dlvhdr/gh-dash",
+		Title:     "Crash on start",
+		Body:      "It crashes",
+		Labels:    []string{"bug", "needs triage"},
+		Assignees: []string{"dlvhdr"},
+	})
+
+	require.Equal(t, []string{
+		"issue", "create", "-R", "dlvhdr/gh-dash",
+		"--title", "Crash on start",
+		"--body", "It crashes",
+		"--label", "bug",
+		"--label", "needs triage",
+		"--assignee", "dlvhdr",
+	}, args)
+}
+
+func TestRunCreateIssue(t *testing.T) {
+	sid := SectionIdentifier{Id: 2, Type: "issue"}
+	draft := IssueDraft{Repo: "dlvhdr/gh-dash", Title: "Crash on start"}
+	url := "https://github.com/dlvhdr/gh-dash/issues/42"
+
+	t.Run("fetches the created issue", func(t *testing.T) {
+		create := func(args []string) ([]byte, error) {
+			return []byte("Warning: 1 uncommitted change\n" + url + "\n"), nil
+		}
+		var fetchedUrl string
+		fetch := func(issueUrl string) (data.IssueData, error) {
+			fetchedUrl = issueUrl
+			return data.IssueData{Number: 42, Url: issueUrl}, nil
+		}
+
+		msg := runCreateIssue("issue_create", sid, draft, create, fetch)
+		require.NoError(t, msg.Err)
+		require.Equal(t, url, fetchedUrl)
+		require.Equal(t, 2, msg.SectionId)
+		require.Equal(t, IssueCreatedMsg{Issue: data.IssueData{Number: 42, Url: url}}, msg.Msg)
+	})
+
+	t.Run("reports gh failing", func(t *testing.T) {
+		create := func(args []string) ([]byte, error) {
+			return nil, fmt.Errorf("exit status 1")
+		}
+		fetch := func(string) (data.IssueData, error) {
+			t.Fatal("nothing should be fetched")
+			return data.IssueData{}, nil
+		}
+
+		msg := runCreateIssue("issue_create", sid, draft, create, fetch)
+		require.Error(t, msg.Err)
+		require.Nil(t, msg.Msg)
+	})
+
+	t.Run("reports the issue failing to be fetched", func(t *testing.T) {
+		create := func(args []string) ([]byte, error) { return []byte(url), nil }
+		fetch := func(string) (data.IssueData, error) {
+			return data.IssueData{}, fmt.Errorf("not found")
+		}
+
+		msg := runCreateIssue("issue_create", sid, draft, create, fetch)
+		require.ErrorContains(t, msg.Err, url)
+		require.Nil(t, msg.Msg)
+	})
+}
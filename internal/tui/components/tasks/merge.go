@@ -0,0 +1,206 @@
+package tasks
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/common"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+// MergeOptions configure how a PR gets merged.
+type MergeOptions struct {
+	// Method is one of "merge", "squash" or "rebase"
+	Method string
+	// Subject and Body override the default commit message, rebases have none
+	Subject      string
+	Body         string
+	DeleteBranch bool
+	// Auto enables auto-merge instead of merging right away
+	Auto bool
+}
+
+// DefaultMergeOptions merges the PR with the strategy configured for its repo and the
+// default commit message.
+func DefaultMergeOptions(ctx *context.ProgramContext, pr data.RowData) MergeOptions {
+	return MergeOptions{
+		Method: common.GetRepoMergeStrategy(
+			pr.GetRepoNameWithOwner(), ctx.Config.MergeStrategies),
+	}
+}
+
+const (
+	enqueuePullRequestMutation = `mutation($pullRequestId: ID!) {
+  enqueuePullRequest(input: {pullRequestId: $pullRequestId}) {
+    mergeQueueEntry { id }
+  }
+}`
+	dequeuePullRequestMutation = `mutation($id: ID!) {
+  dequeuePullRequest(input: {id: $id}) {
+    mergeQueueEntry { id }
+  }
+}`
+)
+
+func mergePRTask(
+	section SectionIdentifier,
+	pr data.RowData,
+	opts MergeOptions,
+) GitHubTask {
+	prNumber := pr.GetNumber()
+	args := []string{
+		"pr",
+		"merge",
+		fmt.Sprint(prNumber),
+		"-R",
+		pr.GetRepoNameWithOwner(),
+		"--" + opts.Method,
+	}
+	if opts.Method != "rebase" {
+		if opts.Subject != "" {
+			args = append(args, "--subject", opts.Subject)
+		}
+		if opts.Body != "" {
+			args = append(args, "--body", opts.Body)
+		}
+	}
+	if opts.DeleteBranch {
+		args = append(args, "--delete-branch")
+	}
+
+	if opts.Auto {
+		args = append(args, "--auto")
+		return GitHubTask{
+			Id:           buildTaskId("pr_auto_merge", prNumber),
+			Args:         args,
+			Section:      section,
+			StartText:    fmt.Sprintf("Enabling auto-merge for PR #%d", prNumber),
+			FinishedText: fmt.Sprintf("Auto-merge has been enabled for PR #%d", prNumber),
+			Msg: func(c *exec.Cmd, err error) tea.Msg {
+				if err != nil {
+					return UpdatePRMsg{PrNumber: prNumber}
+				}
+				return UpdatePRMsg{
+					PrNumber: prNumber,
+					AutoMerge: &AutoMergeUpdate{Request: &data.AutoMergeRequest{
+						EnabledAt:   time.Now(),
+						MergeMethod: strings.ToUpper(opts.Method),
+					}},
+				}
+			},
+		}
+	}
+
+	return GitHubTask{
+		Id:           buildTaskId("merge", prNumber),
+		Args:         args,
+		Section:      section,
+		StartText:    fmt.Sprintf("Merging PR #%d", prNumber),
+		FinishedText: fmt.Sprintf("PR #%d has been merged", prNumber),
+		Msg: func(c *exec.Cmd, err error) tea.Msg {
+			isMerged := err == nil
+			return UpdatePRMsg{
+				PrNumber: prNumber,
+				IsMerged: &isMerged,
+			}
+		},
+	}
+}
+
+// MergePR merges the PR, or enables auto-merge for it when opts.Auto is set.
+func MergePR(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	pr data.RowData,
+	opts MergeOptions,
+) tea.Cmd {
+	return fireTask(ctx, mergePRTask(section, pr, opts))
+}
+
+func disableAutoMergeTask(section SectionIdentifier, pr data.RowData) GitHubTask {
+	prNumber := pr.GetNumber()
+	return GitHubTask{
+		Id: buildTaskId("pr_disable_auto_merge", prNumber),
+		Args: []string{
+			"pr",
+			"merge",
+			fmt.Sprint(prNumber),
+			"-R",
+			pr.GetRepoNameWithOwner(),
+			"--disable-auto",
+		},
+		Section:      section,
+		StartText:    fmt.Sprintf("Disabling auto-merge for PR #%d", prNumber),
+		FinishedText: fmt.Sprintf("Auto-merge has been disabled for PR #%d", prNumber),
+		Msg: func(c *exec.Cmd, err error) tea.Msg {
+			if err != nil {
+				return UpdatePRMsg{PrNumber: prNumber}
+			}
+			return UpdatePRMsg{PrNumber: prNumber, AutoMerge: &AutoMergeUpdate{}}
+		},
+	}
+}
+
+func DisableAutoMerge(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	pr data.RowData,
+) tea.Cmd {
+	return fireTask(ctx, disableAutoMergeTask(section, pr))
+}
+
+func mergeQueueTask(
+	section SectionIdentifier,
+	pr data.RowData,
+	prId string,
+	enqueue bool,
+) GitHubTask {
+	prNumber := pr.GetNumber()
+	args := []string{"api", "graphql"}
+	prefix := "pr_enqueue"
+	startText := fmt.Sprintf("Adding PR #%d to the merge queue", prNumber)
+	finishedText := fmt.Sprintf("PR #%d has been added to the merge queue", prNumber)
+	if enqueue {
+		args = append(args,
+			"-f", "query="+enqueuePullRequestMutation,
+			"-f", "pullRequestId="+prId)
+	} else {
+		args = append(args,
+			"-f", "query="+dequeuePullRequestMutation,
+			"-f", "id="+prId)
+		prefix = "pr_dequeue"
+		startText = fmt.Sprintf("Removing PR #%d from the merge queue", prNumber)
+		finishedText = fmt.Sprintf("PR #%d has been removed from the merge queue", prNumber)
+	}
+
+	return GitHubTask{
+		Id:           buildTaskId(prefix, prNumber),
+		Args:         args,
+		Section:      section,
+		StartText:    startText,
+		FinishedText: finishedText,
+		Msg: func(c *exec.Cmd, err error) tea.Msg {
+			if err != nil {
+				return UpdatePRMsg{PrNumber: prNumber}
+			}
+			return UpdatePRMsg{PrNumber: prNumber, IsInMergeQueue: &enqueue}
+		},
+	}
+}
+
+// UpdateMergeQueue adds the PR with the given node id to its merge queue, or removes it
+// when enqueue is false.
+func UpdateMergeQueue(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	pr data.RowData,
+	prId string,
+	enqueue bool,
+) tea.Cmd {
+	return fireTask(ctx, mergeQueueTask(section, pr, prId, enqueue))
+}
@@ -0,0 +1,94 @@
+package tasks
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergePR_TaskConfiguration(t *testing.T) {
+	section := SectionIdentifier{Id: 2, Type: "pr"}
+	pr := mockIssue{number: 42, repoName: "owner/repo"}
+
+	t.Run("merge now", func(t *testing.T) {
+		task := mergePRTask(section, pr, MergeOptions{
+			Method:       "squash",
+			Subject:      "Add feature (#42)",
+			Body:         "details",
+			DeleteBranch: true,
+		})
+
+		require.Equal(t, "merge_42", task.Id)
+		require.Equal(t, []string{
+			"pr", "merge", "42", "-R", "owner/repo", "--squash",
+			"--subject", "Add feature (#42)", "--body", "details", "--delete-branch",
+		}, task.Args)
+
+		updateMsg := task.Msg(nil, nil).(UpdatePRMsg)
+		require.True(t, *updateMsg.IsMerged)
+		failedMsg := task.Msg(nil, fmt.Errorf("boom")).(UpdatePRMsg)
+		require.False(t, *failedMsg.IsMerged)
+	})
+
+	t.Run("rebases have no commit message", func(t *testing.T) {
+		task := mergePRTask(section, pr, MergeOptions{
+			Method:  "rebase",
+			Subject: "ignored",
+			Body:    "ignored",
+		})
+
+		require.Equal(t, []string{"pr", "merge", "42", "-R", "owner/repo", "--rebase"}, task.Args)
+	})
+
+	t.Run("auto-merge", func(t *testing.T) {
+		task := mergePRTask(section, pr, MergeOptions{Method: "merge", Auto: true})
+
+		require.Equal(t, "pr_auto_merge_42", task.Id)
+		require.Equal(t, []string{
+			"pr", "merge", "42", "-R", "owner/repo", "--merge", "--auto",
+		}, task.Args)
+
+		updateMsg := task.Msg(nil, nil).(UpdatePRMsg)
+		require.Nil(t, updateMsg.IsMerged, "enabling auto-merge does not merge the PR")
+		require.Equal(t, "MERGE", updateMsg.AutoMerge.Request.MergeMethod)
+		require.Nil(t, task.Msg(nil, fmt.Errorf("boom")).(UpdatePRMsg).AutoMerge)
+	})
+}
+
+func TestDisableAutoMerge_TaskConfiguration(t *testing.T) {
+	pr := mockIssue{number: 42, repoName: "owner/repo"}
+
+	task := disableAutoMergeTask(SectionIdentifier{}, pr)
+
+	require.Equal(t, "pr_disable_auto_merge_42", task.Id)
+	require.Equal(t, []string{
+		"pr", "merge", "42", "-R", "owner/repo", "--disable-auto",
+	}, task.Args)
+	updateMsg := task.Msg(nil, nil).(UpdatePRMsg)
+	require.NotNil(t, updateMsg.AutoMerge)
+	require.Nil(t, updateMsg.AutoMerge.Request)
+}
+
+func TestUpdateMergeQueue_TaskConfiguration(t *testing.T) {
+	pr := mockIssue{number: 42, repoName: "owner/repo"}
+
+	enqueue := mergeQueueTask(SectionIdentifier{}, pr, "PR_1", true)
+	require.Equal(t, "pr_enqueue_42", enqueue.Id)
+	require.Equal(t, []string{
+		"api", "graphql",
+		"-f", "query=" + enqueuePullRequestMutation,
+		"-f", "pullRequestId=PR_1",
+	}, enqueue.Args)
+	require.True(t, *enqueue.Msg(nil, nil).(UpdatePRMsg).IsInMergeQueue)
+
+	dequeue := mergeQueueTask(SectionIdentifier{}, pr, "PR_1", false)
+	require.Equal(t, "pr_dequeue_42", dequeue.Id)
+	require.Equal(t, []string{
+		"api", "graphql",
+		"-f", "query=" + dequeuePullRequestMutation,
+		"-f", "id=PR_1",
+	}, dequeue.Args)
+	require.False(t, *dequeue.Msg(nil, nil).(UpdatePRMsg).IsInMergeQueue)
+	require.Nil(t, dequeue.Msg(nil, fmt.Errorf("boom")).(UpdatePRMsg).IsInMergeQueue)
+}
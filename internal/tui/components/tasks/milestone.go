@@ -0,0 +1,112 @@
+package tasks
+
+import (
+	"fmt"
+	"os/exec"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+// MilestoneUpdate describes the milestone of an issue or PR being set or, with a nil Milestone,
+// cleared.
+type MilestoneUpdate struct {
+	Milestone *data.Milestone
+}
+
+// milestoneArgs returns the arguments of "gh pr edit" or "gh issue edit" setting the milestone
+// of a row, or removing it when milestone is nil.
+func milestoneArgs(kind string, row data.RowData, milestone *data.Milestone) []string {
+	args := []string{
+		kind,
+		"edit",
+		fmt.Sprint(row.GetNumber()),
+		"-R",
+		row.GetRepoNameWithOwner(),
+	}
+	if milestone == nil {
+		return append(args, "--remove-milestone")
+	}
+	return append(args, "--milestone", milestone.Title)
+}
+
+func milestoneTexts(noun string, number int, milestone *data.Milestone) (string, string) {
+	if milestone == nil {
+		return fmt.Sprintf("Removing the milestone of %s #%d", noun, number),
+			fmt.Sprintf("The milestone of %s #%d has been removed", noun, number)
+	}
+	return fmt.Sprintf("Setting the milestone of %s #%d to %s", noun, number, milestone.Title),
+		fmt.Sprintf("The milestone of %s #%d has been set to %s", noun, number, milestone.Title)
+}
+
+func setPRMilestoneTask(
+	section SectionIdentifier,
+	pr data.RowData,
+	milestone *data.Milestone,
+) GitHubTask {
+	prNumber := pr.GetNumber()
+	startText, finishedText := milestoneTexts("PR", prNumber, milestone)
+	return GitHubTask{
+		Id:           buildTaskId("pr_milestone", prNumber),
+		Args:         milestoneArgs("pr", pr, milestone),
+		Section:      section,
+		StartText:    startText,
+		FinishedText: finishedText,
+		Msg: func(c *exec.Cmd, err error) tea.Msg {
+			if err != nil {
+				return UpdatePRMsg{PrNumber: prNumber}
+			}
+			return UpdatePRMsg{
+				PrNumber:  prNumber,
+				Milestone: &MilestoneUpdate{Milestone: milestone},
+			}
+		},
+	}
+}
+
+// SetPRMilestone sets the milestone of a PR, or removes it when milestone is nil.
+func SetPRMilestone(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	pr data.RowData,
+	milestone *data.Milestone,
+) tea.Cmd {
+	return fireTask(ctx, setPRMilestoneTask(section, pr, milestone))
+}
+
+func setIssueMilestoneTask(
+	section SectionIdentifier,
+	issue data.RowData,
+	milestone *data.Milestone,
+) GitHubTask {
+	issueNumber := issue.GetNumber()
+	startText, finishedText := milestoneTexts("issue", issueNumber, milestone)
+	return GitHubTask{
+		Id:           buildTaskId("issue_milestone", issueNumber),
+		Args:         milestoneArgs("issue", issue, milestone),
+		Section:      section,
+		StartText:    startText,
+		FinishedText: finishedText,
+		Msg: func(c *exec.Cmd, err error) tea.Msg {
+			if err != nil {
+				return UpdateIssueMsg{IssueNumber: issueNumber}
+			}
+			return UpdateIssueMsg{
+				IssueNumber: issueNumber,
+				Milestone:   &MilestoneUpdate{Milestone: milestone},
+			}
+		},
+	}
+}
+
+// SetIssueMilestone sets the milestone of an issue, or removes it when milestone is nil.
+func SetIssueMilestone(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	issue data.RowData,
+	milestone *data.Milestone,
+) tea.Cmd {
+	return fireTask(ctx, setIssueMilestoneTask(section, issue, milestone))
+}
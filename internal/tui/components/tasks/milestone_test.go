@@ -0,0 +1,56 @@
+package tasks
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+func TestSetPRMilestone_TaskConfiguration(t *testing.T) {
+	section := SectionIdentifier{Id: 1, Type: "pr"}
+	milestone := &data.Milestone{Title: "v4.1"}
+
+	task := setPRMilestoneTask(section, mockIssue{number: 7, repoName: "owner/repo"}, milestone)
+
+	require.Equal(t, "pr_milestone_7", task.Id)
+	require.Equal(t, []string{
+		"pr", "edit", "7", "-R", "owner/repo", "--milestone", "v4.1",
+	}, task.Args)
+	require.Equal(t, "Setting the milestone of PR #7 to v4.1", task.StartText)
+
+	msg := task.Msg(nil, nil).(UpdatePRMsg)
+	require.NotNil(t, msg.Milestone)
+	require.Equal(t, milestone, msg.Milestone.Milestone)
+
+	msg = task.Msg(nil, errors.New("boom")).(UpdatePRMsg)
+	require.Nil(t, msg.Milestone, "a failed edit must keep the milestone of the PR")
+}
+
+func TestRemoveIssueMilestone_TaskConfiguration(t *testing.T) {
+	section := SectionIdentifier{Id: 1, Type: "issue"}
+
+	task := setIssueMilestoneTask(section, mockIssue{number: 9, repoName: "owner/repo"}, nil)
+
+	require.Equal(t, "issue_milestone_9", task.Id)
+	require.Equal(t, []string{
+		"issue", "edit", "9", "-R", "owner/repo", "--remove-milestone",
+	}, task.Args)
+	require.Equal(t, "Removing the milestone of issue #9", task.StartText)
+
+	msg := task.Msg(nil, nil).(UpdateIssueMsg)
+	require.NotNil(t, msg.Milestone, "removing the milestone is an update too")
+	require.Nil(t, msg.Milestone.Milestone)
+}
+
+func TestBulkMilestoneTexts(t *testing.T) {
+	startText, finishedText := bulkMilestoneTexts("PRs", &data.Milestone{Title: "100% done"})
+	bulk := newBulkTask("pr_bulk_milestone", SectionIdentifier{}, startText, finishedText,
+		[]data.RowData{mockIssue{number: 1}, mockIssue{number: 2}},
+		func(row data.RowData) GitHubTask { return GitHubTask{} })
+
+	require.Equal(t, "Setting the milestone of 2 PRs to 100% done", bulk.StartText)
+	require.Equal(t, "The milestone of 2 PRs has been set to 100% done", bulk.FinishedText)
+}
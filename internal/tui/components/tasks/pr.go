@@ -1,6 +1,7 @@
 package tasks
 
 import (
+	"errors"
 	"fmt"
 	"os/exec"
 	"strings"
@@ -22,13 +23,42 @@ type SectionIdentifier struct {
 
 type UpdatePRMsg struct {
 	PrNumber         int
+	Title            *string
+	Body             *string
 	IsClosed         *bool
 	NewComment       *data.Comment
 	ReadyForReview   *bool
 	IsMerged         *bool
 	AddedAssignees   *data.Assignees
 	RemovedAssignees *data.Assignees
-	Labels           *data.PRLabels
+	// AddedReviewRequests and RemovedReviewRequests are the reviewers whose review was
+	// requested or whose review request was removed
+	AddedReviewRequests   *data.ReviewRequests
+	RemovedReviewRequests *data.ReviewRequests
+	Labels                *data.PRLabels
+	AddedLabels           *data.PRLabels
+	ReviewThread          *ReviewThreadUpdate
+	NewReview             *data.Review
+	ReviewDecision        *string
+	IsInMergeQueue        *bool
+	AutoMerge             *AutoMergeUpdate
+	Milestone             *MilestoneUpdate
+	Reaction              *ReactionUpdate
+	Comment               *CommentUpdate
+	// Checks are the refetched checks of the last commit of the PR
+	Checks *data.LastCommitWithStatusChecks
+}
+
+// ReviewThreadUpdate describes a change to a single review thread of a PR.
+type ReviewThreadUpdate struct {
+	Id         string
+	NewComment *data.ReviewComment
+	IsResolved *bool
+}
+
+// AutoMergeUpdate describes auto-merge being enabled or, with a nil Request, disabled.
+type AutoMergeUpdate struct {
+	Request *data.AutoMergeRequest
 }
 
 type UpdateBranchMsg struct {
@@ -75,6 +105,18 @@ func fireTask(ctx *context.ProgramContext, task GitHubTask) tea.Cmd {
 	})
 }
 
+// runGh runs gh with the args and returns its output. gh's stderr is added to the error when
+// it fails, since the exit status alone doesn't tell why.
+func runGh(args []string) ([]byte, error) {
+	log.Info("Running task", "cmd", "gh "+strings.Join(args, " "))
+	output, err := exec.Command("gh", args...).Output()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		err = fmt.Errorf("%w: %s", err, strings.TrimSpace(string(exitErr.Stderr)))
+	}
+	return output, err
+}
+
 func OpenBranchPR(ctx *context.ProgramContext, section SectionIdentifier, branch string) tea.Cmd {
 	return fireTask(ctx, GitHubTask{
 		Id: fmt.Sprintf("branch_open_%s", branch),
@@ -95,9 +137,9 @@ func OpenBranchPR(ctx *context.ProgramContext, section SectionIdentifier, branch
 	})
 }
 
-func ReopenPR(ctx *context.ProgramContext, section SectionIdentifier, pr data.RowData) tea.Cmd {
+func reopenPRTask(section SectionIdentifier, pr data.RowData) GitHubTask {
 	prNumber := pr.GetNumber()
-	return fireTask(ctx, GitHubTask{
+	return GitHubTask{
 		Id: buildTaskId("pr_reopen", prNumber),
 		Args: []string{
 			"pr",
@@ -110,17 +152,24 @@ func ReopenPR(ctx *context.ProgramContext, section SectionIdentifier, pr data.Ro
 		StartText:    fmt.Sprintf("Reopening PR #%d", prNumber),
 		FinishedText: fmt.Sprintf("PR #%d has been reopened", prNumber),
 		Msg: func(c *exec.Cmd, err error) tea.Msg {
+			if err != nil {
+				return UpdatePRMsg{PrNumber: prNumber}
+			}
 			return UpdatePRMsg{
 				PrNumber: prNumber,
 				IsClosed: utils.BoolPtr(false),
 			}
 		},
-	})
+	}
 }
 
-func ClosePR(ctx *context.ProgramContext, section SectionIdentifier, pr data.RowData) tea.Cmd {
+func ReopenPR(ctx *context.ProgramContext, section SectionIdentifier, pr data.RowData) tea.Cmd {
+	return fireTask(ctx, reopenPRTask(section, pr))
+}
+
+func closePRTask(section SectionIdentifier, pr data.RowData) GitHubTask {
 	prNumber := pr.GetNumber()
-	return fireTask(ctx, GitHubTask{
+	return GitHubTask{
 		Id: buildTaskId("pr_close", prNumber),
 		Args: []string{
 			"pr",
@@ -133,12 +182,19 @@ func ClosePR(ctx *context.ProgramContext, section SectionIdentifier, pr data.Row
 		StartText:    fmt.Sprintf("Closing PR #%d", prNumber),
 		FinishedText: fmt.Sprintf("PR #%d has been closed", prNumber),
 		Msg: func(c *exec.Cmd, err error) tea.Msg {
+			if err != nil {
+				return UpdatePRMsg{PrNumber: prNumber}
+			}
 			return UpdatePRMsg{
 				PrNumber: prNumber,
 				IsClosed: utils.BoolPtr(true),
 			}
 		},
-	})
+	}
+}
+
+func ClosePR(ctx *context.ProgramContext, section SectionIdentifier, pr data.RowData) tea.Cmd {
+	return fireTask(ctx, closePRTask(section, pr))
 }
 
 func PRReady(ctx *context.ProgramContext, section SectionIdentifier, pr data.RowData) tea.Cmd {
@@ -164,43 +220,6 @@ func PRReady(ctx *context.ProgramContext, section SectionIdentifier, pr data.Row
 	})
 }
 
-func MergePR(ctx *context.ProgramContext, section SectionIdentifier, pr data.RowData) tea.Cmd {
-	prNumber := pr.GetNumber()
-	c := exec.Command(
-		"gh",
-		"pr",
-		"merge",
-		fmt.Sprint(prNumber),
-		"-R",
-		pr.GetRepoNameWithOwner(),
-	)
-
-	taskId := fmt.Sprintf("merge_%d", prNumber)
-	task := context.Task{
-		Id:           taskId,
-		StartText:    fmt.Sprintf("Merging PR #%d", prNumber),
-		FinishedText: fmt.Sprintf("PR #%d has been merged", prNumber),
-		State:        context.TaskStart,
-		Error:        nil,
-	}
-	startCmd := ctx.StartTask(task)
-
-	return tea.Batch(startCmd, tea.ExecProcess(c, func(err error) tea.Msg {
-		isMerged := err == nil && c.ProcessState.ExitCode() == 0
-
-		return constants.TaskFinishedMsg{
-			SectionId:   section.Id,
-			SectionType: section.Type,
-			TaskId:      taskId,
-			Err:         err,
-			Msg: UpdatePRMsg{
-				PrNumber: prNumber,
-				IsMerged: &isMerged,
-			},
-		}
-	}))
-}
-
 func CreatePR(
 	ctx *context.ProgramContext,
 	section SectionIdentifier,
@@ -266,12 +285,7 @@ func UpdatePR(ctx *context.ProgramContext, section SectionIdentifier, pr data.Ro
 	return fireTask(ctx, updatePRTask(section, pr))
 }
 
-func AssignPR(
-	ctx *context.ProgramContext,
-	section SectionIdentifier,
-	pr data.RowData,
-	usernames []string,
-) tea.Cmd {
+func assignPRTask(section SectionIdentifier, pr data.RowData, usernames []string) GitHubTask {
 	prNumber := pr.GetNumber()
 	args := []string{
 		"pr",
@@ -283,13 +297,16 @@ func AssignPR(
 	for _, assignee := range usernames {
 		args = append(args, "--add-assignee", assignee)
 	}
-	return fireTask(ctx, GitHubTask{
+	return GitHubTask{
 		Id:           buildTaskId("pr_assign", prNumber),
 		Args:         args,
 		Section:      section,
 		StartText:    fmt.Sprintf("Assigning pr #%d to %s", prNumber, usernames),
 		FinishedText: fmt.Sprintf("pr #%d has been assigned to %s", prNumber, usernames),
 		Msg: func(c *exec.Cmd, err error) tea.Msg {
+			if err != nil {
+				return UpdatePRMsg{PrNumber: prNumber}
+			}
 			returnedAssignees := data.Assignees{Nodes: []data.Assignee{}}
 			for _, assignee := range usernames {
 				returnedAssignees.Nodes = append(
@@ -302,15 +319,19 @@ func AssignPR(
 				AddedAssignees: &returnedAssignees,
 			}
 		},
-	})
+	}
 }
 
-func UnassignPR(
+func AssignPR(
 	ctx *context.ProgramContext,
 	section SectionIdentifier,
 	pr data.RowData,
 	usernames []string,
 ) tea.Cmd {
+	return fireTask(ctx, assignPRTask(section, pr, usernames))
+}
+
+func unassignPRTask(section SectionIdentifier, pr data.RowData, usernames []string) GitHubTask {
 	prNumber := pr.GetNumber()
 	args := []string{
 		"pr",
@@ -322,13 +343,16 @@ func UnassignPR(
 	for _, assignee := range usernames {
 		args = append(args, "--remove-assignee", assignee)
 	}
-	return fireTask(ctx, GitHubTask{
+	return GitHubTask{
 		Id:           buildTaskId("pr_unassign", prNumber),
 		Args:         args,
 		Section:      section,
 		StartText:    fmt.Sprintf("Unassigning %s from pr #%d", usernames, prNumber),
 		FinishedText: fmt.Sprintf("%s unassigned from pr #%d", usernames, prNumber),
 		Msg: func(c *exec.Cmd, err error) tea.Msg {
+			if err != nil {
+				return UpdatePRMsg{PrNumber: prNumber}
+			}
 			returnedAssignees := data.Assignees{Nodes: []data.Assignee{}}
 			for _, assignee := range usernames {
 				returnedAssignees.Nodes = append(
@@ -341,17 +365,26 @@ func UnassignPR(
 				RemovedAssignees: &returnedAssignees,
 			}
 		},
-	})
+	}
 }
 
-func CommentOnPR(
+func UnassignPR(
 	ctx *context.ProgramContext,
 	section SectionIdentifier,
 	pr data.RowData,
-	body string,
+	usernames []string,
 ) tea.Cmd {
+	return fireTask(ctx, unassignPRTask(section, pr, usernames))
+}
+
+func commentOnPRTask(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	pr data.RowData,
+	body string,
+) GitHubTask {
 	prNumber := pr.GetNumber()
-	return fireTask(ctx, GitHubTask{
+	return GitHubTask{
 		Id: buildTaskId("pr_comment", prNumber),
 		Args: []string{
 			"pr",
@@ -366,24 +399,110 @@ func CommentOnPR(
 		StartText:    fmt.Sprintf("Commenting on PR #%d", prNumber),
 		FinishedText: fmt.Sprintf("Commented on PR #%d", prNumber),
 		Msg: func(c *exec.Cmd, err error) tea.Msg {
+			if err != nil {
+				return UpdatePRMsg{PrNumber: prNumber}
+			}
+			now := time.Now()
 			return UpdatePRMsg{
 				PrNumber: prNumber,
 				NewComment: &data.Comment{
-					Author:    struct{ Login string }{Login: ctx.User},
-					Body:      body,
-					UpdatedAt: time.Now(),
+					Author:      struct{ Login string }{Login: ctx.User},
+					Body:        body,
+					UpdatedAt:   now,
+					CommentMeta: data.CommentMeta{CreatedAt: now},
 				},
 			}
 		},
-	})
+	}
 }
 
-func ApprovePR(
+func CommentOnPR(
 	ctx *context.ProgramContext,
 	section SectionIdentifier,
 	pr data.RowData,
-	comment string,
+	body string,
 ) tea.Cmd {
+	return fireTask(ctx, commentOnPRTask(ctx, section, pr, body))
+}
+
+// addPRLabelsTask adds labels to a PR without removing the ones it already has.
+func addPRLabelsTask(section SectionIdentifier, pr data.RowData, labels []data.Label) GitHubTask {
+	prNumber := pr.GetNumber()
+	args := []string{
+		"pr",
+		"edit",
+		fmt.Sprint(prNumber),
+		"-R",
+		pr.GetRepoNameWithOwner(),
+	}
+	for _, label := range labels {
+		args = append(args, "--add-label", label.Name)
+	}
+	names := labelNames(labels)
+	return GitHubTask{
+		Id:           buildTaskId("pr_label", prNumber),
+		Args:         args,
+		Section:      section,
+		StartText:    fmt.Sprintf("Labeling PR #%d with %s", prNumber, names),
+		FinishedText: fmt.Sprintf("PR #%d has been labeled with %s", prNumber, names),
+		Msg: func(c *exec.Cmd, err error) tea.Msg {
+			if err != nil {
+				return UpdatePRMsg{PrNumber: prNumber}
+			}
+			return UpdatePRMsg{
+				PrNumber:    prNumber,
+				AddedLabels: &data.PRLabels{Nodes: labels},
+			}
+		},
+	}
+}
+
+// ReviewEvent is the kind of review submitted on a PR.
+type ReviewEvent string
+
+const (
+	ReviewEventComment        ReviewEvent = "COMMENT"
+	ReviewEventRequestChanges ReviewEvent = "REQUEST_CHANGES"
+	ReviewEventApprove        ReviewEvent = "APPROVE"
+)
+
+// ReviewEvents are the review events in the order they are offered when submitting a review.
+var ReviewEvents = []ReviewEvent{
+	ReviewEventComment,
+	ReviewEventRequestChanges,
+	ReviewEventApprove,
+}
+
+func (e ReviewEvent) String() string {
+	switch e {
+	case ReviewEventRequestChanges:
+		return "Request changes"
+	case ReviewEventApprove:
+		return "Approve"
+	default:
+		return "Comment"
+	}
+}
+
+// ReviewState returns the state the submitted review ends up in.
+func (e ReviewEvent) ReviewState() string {
+	switch e {
+	case ReviewEventRequestChanges:
+		return "CHANGES_REQUESTED"
+	case ReviewEventApprove:
+		return "APPROVED"
+	default:
+		return "COMMENTED"
+	}
+}
+
+func submitReviewTask(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	pr data.RowData,
+	event ReviewEvent,
+	body string,
+) GitHubTask {
 	prNumber := pr.GetNumber()
 	args := []string{
 		"pr",
@@ -391,23 +510,76 @@ func ApprovePR(
 		"-R",
 		pr.GetRepoNameWithOwner(),
 		fmt.Sprint(prNumber),
-		"--approve",
 	}
-	if comment != "" {
-		args = append(args, "--body", comment)
+
+	var id, startText, finishedText string
+	switch event {
+	case ReviewEventApprove:
+		args = append(args, "--approve")
+		id = buildTaskId("pr_approve", prNumber)
+		startText = fmt.Sprintf("Approving pr #%d", prNumber)
+		finishedText = fmt.Sprintf("pr #%d has been approved", prNumber)
+	case ReviewEventRequestChanges:
+		args = append(args, "--request-changes")
+		id = buildTaskId("pr_request_changes", prNumber)
+		startText = fmt.Sprintf("Requesting changes on pr #%d", prNumber)
+		finishedText = fmt.Sprintf("Requested changes on pr #%d", prNumber)
+	default:
+		args = append(args, "--comment")
+		id = buildTaskId("pr_review", prNumber)
+		startText = fmt.Sprintf("Reviewing pr #%d", prNumber)
+		finishedText = fmt.Sprintf("pr #%d has been reviewed", prNumber)
 	}
-	return fireTask(ctx, GitHubTask{
-		Id:           buildTaskId("pr_approve", prNumber),
+	if body != "" {
+		args = append(args, "--body", body)
+	}
+
+	return GitHubTask{
+		Id:           id,
 		Args:         args,
 		Section:      section,
-		StartText:    fmt.Sprintf("Approving pr #%d", prNumber),
-		FinishedText: fmt.Sprintf("pr #%d has been approved", prNumber),
+		StartText:    startText,
+		FinishedText: finishedText,
 		Msg: func(c *exec.Cmd, err error) tea.Msg {
-			return UpdatePRMsg{
-				PrNumber: prNumber,
+			if err != nil {
+				return UpdatePRMsg{PrNumber: prNumber}
+			}
+			now := time.Now()
+			review := data.Review{
+				Author:      struct{ Login string }{Login: ctx.User},
+				Body:        body,
+				State:       event.ReviewState(),
+				UpdatedAt:   now,
+				CommentMeta: data.CommentMeta{CreatedAt: now},
 			}
+			msg := UpdatePRMsg{PrNumber: prNumber, NewReview: &review}
+			if event != ReviewEventComment {
+				decision := review.State
+				msg.ReviewDecision = &decision
+			}
+			return msg
 		},
-	})
+	}
+}
+
+// SubmitReview submits a review of the given event with an optional body.
+func SubmitReview(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	pr data.RowData,
+	event ReviewEvent,
+	body string,
+) tea.Cmd {
+	return fireTask(ctx, submitReviewTask(ctx, section, pr, event, body))
+}
+
+func ApprovePR(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	pr data.RowData,
+	comment string,
+) tea.Cmd {
+	return SubmitReview(ctx, section, pr, ReviewEventApprove, comment)
 }
 
 func ApproveWorkflows(
@@ -501,3 +673,125 @@ func ApproveWorkflows(
 		}
 	})
 }
+
+const (
+	replyToReviewThreadMutation = `mutation($threadId: ID!, $body: String!) {
+  addPullRequestReviewThreadReply(input: {pullRequestReviewThreadId: $threadId, body: $body}) {
+    comment { id }
+  }
+}`
+	resolveReviewThreadMutation = `mutation($threadId: ID!) {
+  resolveReviewThread(input: {threadId: $threadId}) { thread { isResolved } }
+}`
+	unresolveReviewThreadMutation = `mutation($threadId: ID!) {
+  unresolveReviewThread(input: {threadId: $threadId}) { thread { isResolved } }
+}`
+)
+
+func replyToReviewThreadTask(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	pr data.RowData,
+	threadId string,
+	body string,
+) GitHubTask {
+	prNumber := pr.GetNumber()
+	return GitHubTask{
+		Id: buildTaskId("pr_thread_reply", prNumber) + "_" + threadId,
+		Args: []string{
+			"api",
+			"graphql",
+			"-f",
+			"query=" + replyToReviewThreadMutation,
+			"-f",
+			"threadId=" + threadId,
+			"-f",
+			"body=" + body,
+		},
+		Section:      section,
+		StartText:    fmt.Sprintf("Replying to review thread on PR #%d", prNumber),
+		FinishedText: fmt.Sprintf("Replied to review thread on PR #%d", prNumber),
+		Msg: func(c *exec.Cmd, err error) tea.Msg {
+			if err != nil {
+				return UpdatePRMsg{PrNumber: prNumber}
+			}
+			now := time.Now()
+			return UpdatePRMsg{
+				PrNumber: prNumber,
+				ReviewThread: &ReviewThreadUpdate{
+					Id: threadId,
+					NewComment: &data.ReviewComment{
+						Author:      struct{ Login string }{Login: ctx.User},
+						Body:        body,
+						UpdatedAt:   now,
+						CommentMeta: data.CommentMeta{CreatedAt: now},
+					},
+				},
+			}
+		},
+	}
+}
+
+func ReplyToReviewThread(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	pr data.RowData,
+	threadId string,
+	body string,
+) tea.Cmd {
+	return fireTask(ctx, replyToReviewThreadTask(ctx, section, pr, threadId, body))
+}
+
+func resolveReviewThreadTask(
+	section SectionIdentifier,
+	pr data.RowData,
+	threadId string,
+	resolve bool,
+) GitHubTask {
+	prNumber := pr.GetNumber()
+	mutation, prefix := resolveReviewThreadMutation, "pr_thread_resolve"
+	startText := fmt.Sprintf("Resolving review thread on PR #%d", prNumber)
+	finishedText := fmt.Sprintf("Resolved review thread on PR #%d", prNumber)
+	if !resolve {
+		mutation, prefix = unresolveReviewThreadMutation, "pr_thread_unresolve"
+		startText = fmt.Sprintf("Unresolving review thread on PR #%d", prNumber)
+		finishedText = fmt.Sprintf("Unresolved review thread on PR #%d", prNumber)
+	}
+	return GitHubTask{
+		Id: buildTaskId(prefix, prNumber) + "_" + threadId,
+		Args: []string{
+			"api",
+			"graphql",
+			"-f",
+			"query=" + mutation,
+			"-f",
+			"threadId=" + threadId,
+		},
+		Section:      section,
+		StartText:    startText,
+		FinishedText: finishedText,
+		Msg: func(c *exec.Cmd, err error) tea.Msg {
+			if err != nil {
+				return UpdatePRMsg{PrNumber: prNumber}
+			}
+			return UpdatePRMsg{
+				PrNumber: prNumber,
+				ReviewThread: &ReviewThreadUpdate{
+					Id:         threadId,
+					IsResolved: &resolve,
+				},
+			}
+		},
+	}
+}
+
+// ResolveReviewThread resolves the review thread, or unresolves it when resolve is false.
+func ResolveReviewThread(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	pr data.RowData,
+	threadId string,
+	resolve bool,
+) tea.Cmd {
+	return fireTask(ctx, resolveReviewThreadTask(section, pr, threadId, resolve))
+}
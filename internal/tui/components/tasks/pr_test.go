@@ -165,3 +165,106 @@ func TestApproveWorkflows_SectionIdentifierPropagation(t *testing.T) {
 		})
 	}
 }
+
+func TestReplyToReviewThread_TaskConfiguration(t *testing.T) {
+	ctx := &context.ProgramContext{User: "me"}
+	section := SectionIdentifier{Id: 2, Type: "pr"}
+	pr := mockIssue{number: 42, repoName: "owner/repo"}
+
+	task := replyToReviewThreadTask(ctx, section, pr, "PRRT_1", "sounds good")
+
+	require.Equal(t, "pr_thread_reply_42_PRRT_1", task.Id)
+	require.Equal(t, []string{
+		"api", "graphql",
+		"-f", "query=" + replyToReviewThreadMutation,
+		"-f", "threadId=PRRT_1",
+		"-f", "body=sounds good",
+	}, task.Args)
+
+	updateMsg, ok := task.Msg(nil, nil).(UpdatePRMsg)
+	require.True(t, ok, "Msg should return UpdatePRMsg")
+	require.NotNil(t, updateMsg.ReviewThread)
+	require.Equal(t, "PRRT_1", updateMsg.ReviewThread.Id)
+	require.Equal(t, "me", updateMsg.ReviewThread.NewComment.Author.Login)
+	require.Equal(t, "sounds good", updateMsg.ReviewThread.NewComment.Body)
+
+	failedMsg := task.Msg(nil, fmt.Errorf("boom")).(UpdatePRMsg)
+	require.Nil(t, failedMsg.ReviewThread, "a failed reply must not add the comment")
+}
+
+func TestResolveReviewThread_TaskConfiguration(t *testing.T) {
+	section := SectionIdentifier{Id: 2, Type: "pr"}
+	pr := mockIssue{number: 42, repoName: "owner/repo"}
+
+	for _, resolve := range []bool{true, false} {
+		task := resolveReviewThreadTask(section, pr, "PRRT_1", resolve)
+
+		mutation, id := resolveReviewThreadMutation, "pr_thread_resolve_42_PRRT_1"
+		if !resolve {
+			mutation, id = unresolveReviewThreadMutation, "pr_thread_unresolve_42_PRRT_1"
+		}
+		require.Equal(t, id, task.Id)
+		require.Equal(t, []string{
+			"api", "graphql", "-f", "query=" + mutation, "-f", "threadId=PRRT_1",
+		}, task.Args)
+
+		updateMsg := task.Msg(nil, nil).(UpdatePRMsg)
+		require.Equal(t, "PRRT_1", updateMsg.ReviewThread.Id)
+		require.Equal(t, resolve, *updateMsg.ReviewThread.IsResolved)
+	}
+}
+
+func TestSubmitReview_TaskConfiguration(t *testing.T) {
+	ctx := &context.ProgramContext{User: "me"}
+	section := SectionIdentifier{Id: 2, Type: "pr"}
+	pr := mockIssue{number: 42, repoName: "owner/repo"}
+
+	testCases := []struct {
+		event    ReviewEvent
+		id       string
+		flag     string
+		state    string
+		decision bool
+	}{
+		{ReviewEventComment, "pr_review_42", "--comment", "COMMENTED", false},
+		{ReviewEventRequestChanges, "pr_request_changes_42", "--request-changes",
+			"CHANGES_REQUESTED", true},
+		{ReviewEventApprove, "pr_approve_42", "--approve", "APPROVED", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.event), func(t *testing.T) {
+			task := submitReviewTask(ctx, section, pr, tc.event, "line one\nline two")
+
+			require.Equal(t, tc.id, task.Id)
+			require.Equal(t, []string{
+				"pr", "review", "-R", "owner/repo", "42", tc.flag,
+				"--body", "line one\nline two",
+			}, task.Args)
+
+			updateMsg := task.Msg(nil, nil).(UpdatePRMsg)
+			require.NotNil(t, updateMsg.NewReview)
+			require.Equal(t, "me", updateMsg.NewReview.Author.Login)
+			require.Equal(t, tc.state, updateMsg.NewReview.State)
+			if tc.decision {
+				require.Equal(t, tc.state, *updateMsg.ReviewDecision)
+			} else {
+				require.Nil(t, updateMsg.ReviewDecision,
+					"a comment review must not change the review decision")
+			}
+
+			failedMsg := task.Msg(nil, fmt.Errorf("boom")).(UpdatePRMsg)
+			require.Nil(t, failedMsg.NewReview)
+			require.Nil(t, failedMsg.ReviewDecision)
+		})
+	}
+}
+
+func TestSubmitReview_ApproveWithoutBody(t *testing.T) {
+	pr := mockIssue{number: 42, repoName: "owner/repo"}
+
+	task := submitReviewTask(&context.ProgramContext{}, SectionIdentifier{}, pr,
+		ReviewEventApprove, "")
+
+	require.Equal(t, []string{"pr", "review", "-R", "owner/repo", "42", "--approve"}, task.Args)
+}
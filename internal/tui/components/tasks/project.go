@@ -0,0 +1,200 @@
+package tasks
+
+import (
+	"fmt"
+	"os/exec"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+// UpdateProjectItemMsg updates the project item with the given id after it was moved. Status
+// is nil when the move failed.
+type UpdateProjectItemMsg struct {
+	ItemId string
+	Status *data.ProjectFieldOption
+}
+
+func moveProjectItemTask(
+	section SectionIdentifier,
+	projectId string,
+	fieldId string,
+	item *data.ProjectItem,
+	status data.ProjectFieldOption,
+) GitHubTask {
+	itemId := item.Id
+	title := item.GetTitle()
+	return GitHubTask{
+		Id: fmt.Sprintf("project_item_move_%s", itemId),
+		Args: []string{
+			"project",
+			"item-edit",
+			"--id",
+			itemId,
+			"--project-id",
+			projectId,
+			"--field-id",
+			fieldId,
+			"--single-select-option-id",
+			status.Id,
+		},
+		Section:      section,
+		StartText:    fmt.Sprintf("Moving %q to %s", title, status.Name),
+		FinishedText: fmt.Sprintf("%q has been moved to %s", title, status.Name),
+		Msg: func(c *exec.Cmd, err error) tea.Msg {
+			if err != nil {
+				return UpdateProjectItemMsg{ItemId: itemId}
+			}
+			return UpdateProjectItemMsg{ItemId: itemId, Status: &status}
+		},
+	}
+}
+
+// MoveProjectItem sets the status field of a project item to the given option, moving it to
+// another column of the board.
+func MoveProjectItem(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	projectId string,
+	fieldId string,
+	item *data.ProjectItem,
+	status data.ProjectFieldOption,
+) tea.Cmd {
+	return fireTask(ctx, moveProjectItemTask(section, projectId, fieldId, item, status))
+}
+
+// UpdateProjectFieldMsg sets a field of a project item of the issue or PR with the given url
+// after it was edited. An empty value clears the field.
+type UpdateProjectFieldMsg struct {
+	Url    string
+	ItemId string
+	Field  string
+	Value  string
+}
+
+// projectFieldValue returns the "gh project item-edit" flags setting the field to value, and the
+// value as shown by GitHub, e.g. with the case of the option's name.
+func projectFieldValue(field data.ProjectField, value string) ([]string, string, error) {
+	if value == "" {
+		return []string{"--clear"}, "", nil
+	}
+
+	switch field.Common.DataType {
+	case data.ProjectFieldSingleSelect:
+		options := field.SingleSelect.Options
+		i := slices.IndexFunc(options, func(option data.ProjectFieldOption) bool {
+			return strings.EqualFold(option.Name, value)
+		})
+		if i < 0 {
+			return nil, "", fmt.Errorf("%s has no option %q", field.Common.Name, value)
+		}
+		return []string{"--single-select-option-id", options[i].Id}, options[i].Name, nil
+	case data.ProjectFieldIteration:
+		iterations := field.Iteration.Configuration.Iterations
+		i := slices.IndexFunc(iterations, func(iteration data.ProjectIteration) bool {
+			return strings.EqualFold(iteration.Title, value)
+		})
+		if i < 0 {
+			return nil, "", fmt.Errorf("%s has no iteration %q", field.Common.Name, value)
+		}
+		return []string{"--iteration-id", iterations[i].Id}, iterations[i].Title, nil
+	case data.ProjectFieldNumber:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return nil, "", fmt.Errorf("%s is a number, got %q", field.Common.Name, value)
+		}
+		return []string{"--number", value}, value, nil
+	case data.ProjectFieldDate:
+		if _, err := time.Parse(time.DateOnly, value); err != nil {
+			return nil, "", fmt.Errorf("%s is a date like 2006-01-02, got %q", field.Common.Name,
+				value)
+		}
+		return []string{"--date", value}, value, nil
+	case data.ProjectFieldText:
+		return []string{"--text", value}, value, nil
+	}
+	return nil, "", fmt.Errorf("%s can't be edited", field.Common.Name)
+}
+
+func setProjectFieldTask(
+	section SectionIdentifier,
+	row data.RowData,
+	item data.EditableProjectItem,
+	field data.ProjectField,
+	value string,
+) (GitHubTask, error) {
+	valueArgs, value, err := projectFieldValue(field, value)
+	if err != nil {
+		return GitHubTask{}, err
+	}
+
+	url := row.GetUrl()
+	number := row.GetNumber()
+	name := field.Common.Name
+	startText := fmt.Sprintf("Setting %s of #%d to %s", name, number, value)
+	finishedText := fmt.Sprintf("%s of #%d has been set to %s", name, number, value)
+	if value == "" {
+		startText = fmt.Sprintf("Clearing %s of #%d", name, number)
+		finishedText = fmt.Sprintf("%s of #%d has been cleared", name, number)
+	}
+	return GitHubTask{
+		Id: fmt.Sprintf("project_field_%s_%s", item.Id, field.Common.Id),
+		Args: append([]string{
+			"project",
+			"item-edit",
+			"--id",
+			item.Id,
+			"--project-id",
+			item.Project.Id,
+			"--field-id",
+			field.Common.Id,
+		}, valueArgs...),
+		Section:      section,
+		StartText:    startText,
+		FinishedText: finishedText,
+		Msg: func(c *exec.Cmd, err error) tea.Msg {
+			if err != nil {
+				// No issue or PR has an empty url
+				return UpdateProjectFieldMsg{}
+			}
+			return UpdateProjectFieldMsg{Url: url, ItemId: item.Id, Field: name, Value: value}
+		},
+	}, nil
+}
+
+// SetProjectField sets the field in all the projects of an issue or PR that have it. The value
+// is matched against the options of single select and iteration fields, and an empty value
+// clears the field.
+func SetProjectField(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	row data.RowData,
+	items []data.EditableProjectItem,
+	field string,
+	value string,
+) tea.Cmd {
+	var cmds []tea.Cmd
+	for _, item := range items {
+		for _, projectField := range item.Project.Fields.Nodes {
+			if !projectField.IsEditable() || !strings.EqualFold(projectField.Common.Name, field) {
+				continue
+			}
+			task, err := setProjectFieldTask(section, row, item, projectField, value)
+			if err != nil {
+				return func() tea.Msg { return constants.ErrMsg{Err: err} }
+			}
+			cmds = append(cmds, fireTask(ctx, task))
+		}
+	}
+	if len(cmds) == 0 {
+		err := fmt.Errorf("no project of #%d has a field %q", row.GetNumber(), field)
+		return func() tea.Msg { return constants.ErrMsg{Err: err} }
+	}
+	return tea.Batch(cmds...)
+}
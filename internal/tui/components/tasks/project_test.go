@@ -0,0 +1,152 @@
+package tasks
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+)
+
+func TestMoveProjectItem_TaskConfiguration(t *testing.T) {
+	section := SectionIdentifier{Id: 1, Type: "project"}
+	item := &data.ProjectItem{Id: "PVTI_1", Type: data.ProjectItemDraftIssue}
+	item.Content.DraftIssue.Title = "Board view"
+	done := data.ProjectFieldOption{Id: "98236657", Name: "Done"}
+
+	task := moveProjectItemTask(section, "PVT_1", "PVTSSF_1", item, done)
+
+	require.Equal(t, "project_item_move_PVTI_1", task.Id)
+	require.Equal(t, []string{
+		"project", "item-edit", "--id", "PVTI_1", "--project-id", "PVT_1",
+		"--field-id", "PVTSSF_1", "--single-select-option-id", "98236657",
+	}, task.Args)
+	require.Equal(t, `Moving "Board view" to Done`, task.StartText)
+
+	updateMsg := task.Msg(nil, nil).(UpdateProjectItemMsg)
+	require.Equal(t, "PVTI_1", updateMsg.ItemId)
+	require.Equal(t, &done, updateMsg.Status)
+
+	failedMsg := task.Msg(nil, fmt.Errorf("boom")).(UpdateProjectItemMsg)
+	require.Nil(t, failedMsg.Status, "a failed move must keep the item in its column")
+}
+
+func newProjectField(id string, name string, dataType string) data.ProjectField {
+	var field data.ProjectField
+	field.Common.Id = id
+	field.Common.Name = name
+	field.Common.DataType = dataType
+	return field
+}
+
+func TestSetProjectField_TaskConfiguration(t *testing.T) {
+	section := SectionIdentifier{Id: 1, Type: "pr"}
+	row := mockIssue{number: 42, url: "https://github.com/dlvhdr/gh-dash/pull/42"}
+	var item data.EditableProjectItem
+	item.Id = "PVTI_1"
+	item.Project.Id = "PVT_1"
+	status := newProjectField("PVTSSF_1", "Status", data.ProjectFieldSingleSelect)
+	status.SingleSelect.Options = []data.ProjectFieldOption{{Id: "47fc9ee4", Name: "In Progress"}}
+
+	task, err := setProjectFieldTask(section, row, item, status, "in progress")
+	require.NoError(t, err)
+	require.Equal(t, "project_field_PVTI_1_PVTSSF_1", task.Id)
+	require.Equal(t, []string{
+		"project", "item-edit", "--id", "PVTI_1", "--project-id", "PVT_1",
+		"--field-id", "PVTSSF_1", "--single-select-option-id", "47fc9ee4",
+	}, task.Args)
+	require.Equal(t, "Setting Status of #42 to In Progress", task.StartText)
+
+	updateMsg := task.Msg(nil, nil).(UpdateProjectFieldMsg)
+	require.Equal(t, row.url, updateMsg.Url)
+	require.Equal(t, "In Progress", updateMsg.Value, "the option name is used as typed on GitHub")
+
+	failedMsg := task.Msg(nil, fmt.Errorf("boom")).(UpdateProjectFieldMsg)
+	require.Empty(t, failedMsg.Url, "a failed edit must not update any row")
+
+	task, err = setProjectFieldTask(section, row, item, status, "")
+	require.NoError(t, err)
+	require.Equal(t, "--clear", task.Args[len(task.Args)-1])
+	require.Equal(t, "Clearing Status of #42", task.StartText)
+}
+
+func TestProjectFieldValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		field    data.ProjectField
+		value    string
+		expected []string
+		wantErr  bool
+	}{
+		{
+			name:     "number",
+			field:    newProjectField("F_1", "Estimate", data.ProjectFieldNumber),
+			value:    "2.5",
+			expected: []string{"--number", "2.5"},
+		},
+		{
+			name:    "invalid number",
+			field:   newProjectField("F_1", "Estimate", data.ProjectFieldNumber),
+			value:   "two",
+			wantErr: true,
+		},
+		{
+			name:     "date",
+			field:    newProjectField("F_2", "Due", data.ProjectFieldDate),
+			value:    "2026-10-17",
+			expected: []string{"--date", "2026-10-17"},
+		},
+		{
+			name:    "invalid date",
+			field:   newProjectField("F_2", "Due", data.ProjectFieldDate),
+			value:   "tomorrow",
+			wantErr: true,
+		},
+		{
+			name:     "text",
+			field:    newProjectField("F_3", "Notes", data.ProjectFieldText),
+			value:    "needs design",
+			expected: []string{"--text", "needs design"},
+		},
+		{
+			name:    "unknown option",
+			field:   newProjectField("F_4", "Status", data.ProjectFieldSingleSelect),
+			value:   "Blocked",
+			wantErr: true,
+		},
+		{
+			name:    "read-only field",
+			field:   newProjectField("F_5", "Title", "TITLE"),
+			value:   "New title",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args, _, err := projectFieldValue(tt.field, tt.value)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, args)
+		})
+	}
+}
+
+func TestSetProjectField_UnknownField(t *testing.T) {
+	section := SectionIdentifier{Id: 1, Type: "issue"}
+	row := mockIssue{number: 7}
+	var item data.EditableProjectItem
+	item.Project.Fields.Nodes = []data.ProjectField{
+		newProjectField("F_1", "Estimate", data.ProjectFieldNumber),
+	}
+
+	msg := SetProjectField(nil, section, row, []data.EditableProjectItem{item}, "Priority", "1")()
+	errMsg, ok := msg.(constants.ErrMsg)
+	require.True(t, ok)
+	require.EqualError(t, errMsg.Err, `no project of #7 has a field "Priority"`)
+}
@@ -0,0 +1,108 @@
+package tasks
+
+import (
+	"fmt"
+	"os/exec"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+// ReactionUpdate describes the user adding or removing their reaction on a PR, issue or
+// comment.
+type ReactionUpdate struct {
+	// SubjectId is the node id of the PR, issue or comment reacted to
+	SubjectId string
+	Content   string
+	Removed   bool
+}
+
+const (
+	addReactionMutation = `mutation($subjectId: ID!, $content: ReactionContent!) {
+  addReaction(input: {subjectId: $subjectId, content: $content}) { reaction { content } }
+}`
+	removeReactionMutation = `mutation($subjectId: ID!, $content: ReactionContent!) {
+  removeReaction(input: {subjectId: $subjectId, content: $content}) { reaction { content } }
+}`
+)
+
+// ReactionSubject is the PR, issue or comment reacted to.
+type ReactionSubject struct {
+	Id string
+	// Name describes the subject in the task texts, e.g. "@dlvhdr's comment"
+	Name string
+}
+
+func reactTask(
+	section SectionIdentifier,
+	kind string,
+	number int,
+	subject ReactionSubject,
+	content string,
+	remove bool,
+) GitHubTask {
+	mutation, prefix := addReactionMutation, kind+"_react"
+	emoji := data.ReactionEmoji(content)
+	startText := fmt.Sprintf("Reacting with %s to %s", emoji, subject.Name)
+	finishedText := fmt.Sprintf("Reacted with %s to %s", emoji, subject.Name)
+	if remove {
+		mutation, prefix = removeReactionMutation, kind+"_unreact"
+		startText = fmt.Sprintf("Removing the %s reaction from %s", emoji, subject.Name)
+		finishedText = fmt.Sprintf("Removed the %s reaction from %s", emoji, subject.Name)
+	}
+
+	return GitHubTask{
+		Id: fmt.Sprintf("%s_%s_%s", buildTaskId(prefix, number), subject.Id, content),
+		Args: []string{
+			"api",
+			"graphql",
+			"-f",
+			"query=" + mutation,
+			"-f",
+			"subjectId=" + subject.Id,
+			"-f",
+			"content=" + content,
+		},
+		Section:      section,
+		StartText:    startText,
+		FinishedText: finishedText,
+		Msg: func(c *exec.Cmd, err error) tea.Msg {
+			var update *ReactionUpdate
+			if err == nil {
+				update = &ReactionUpdate{SubjectId: subject.Id, Content: content, Removed: remove}
+			}
+			if kind == "pr" {
+				return UpdatePRMsg{PrNumber: number, Reaction: update}
+			}
+			return UpdateIssueMsg{IssueNumber: number, Reaction: update}
+		},
+	}
+}
+
+// ReactOnPR adds the user's reaction with the content, e.g. THUMBS_UP, to the PR or one of its
+// comments, or removes it when remove is true.
+func ReactOnPR(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	pr data.RowData,
+	subject ReactionSubject,
+	content string,
+	remove bool,
+) tea.Cmd {
+	return fireTask(ctx, reactTask(section, "pr", pr.GetNumber(), subject, content, remove))
+}
+
+// ReactOnIssue adds the user's reaction with the content to the issue or one of its comments,
+// or removes it when remove is true.
+func ReactOnIssue(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	issue data.RowData,
+	subject ReactionSubject,
+	content string,
+	remove bool,
+) tea.Cmd {
+	return fireTask(ctx, reactTask(section, "issue", issue.GetNumber(), subject, content, remove))
+}
@@ -0,0 +1,37 @@
+package tasks
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReactTask(t *testing.T) {
+	section := SectionIdentifier{Id: 1, Type: "pr"}
+	subject := ReactionSubject{Id: "IC_1", Name: "@octo's comment on pr #7"}
+
+	task := reactTask(section, "pr", 7, subject, "HEART", false)
+	require.Equal(t, "pr_react_7_IC_1_HEART", task.Id)
+	require.Equal(t, []string{
+		"api", "graphql",
+		"-f", "query=" + addReactionMutation,
+		"-f", "subjectId=IC_1",
+		"-f", "content=HEART",
+	}, task.Args)
+	require.Equal(t, "Reacting with ❤️ to @octo's comment on pr #7", task.StartText)
+	require.Equal(t, UpdatePRMsg{
+		PrNumber: 7,
+		Reaction: &ReactionUpdate{SubjectId: "IC_1", Content: "HEART"},
+	}, task.Msg(nil, nil))
+	require.Equal(t, UpdatePRMsg{PrNumber: 7}, task.Msg(nil, fmt.Errorf("boom")),
+		"a failed reaction must not be shown")
+
+	task = reactTask(section, "issue", 3, ReactionSubject{Id: "I_3"}, "EYES", true)
+	require.Equal(t, "issue_unreact_3_I_3_EYES", task.Id)
+	require.Contains(t, task.Args, "query="+removeReactionMutation)
+	require.Equal(t, UpdateIssueMsg{
+		IssueNumber: 3,
+		Reaction:    &ReactionUpdate{SubjectId: "I_3", Content: "EYES", Removed: true},
+	}, task.Msg(nil, nil))
+}
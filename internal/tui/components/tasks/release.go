@@ -0,0 +1,94 @@
+package tasks
+
+import (
+	"fmt"
+	"os/exec"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+// UpdateReleaseMsg updates the release with the given node id after an action on it finished.
+type UpdateReleaseMsg struct {
+	ReleaseId   string
+	PublishedAt *time.Time
+}
+
+// ReleaseCreatedMsg is sent after a release was created, so the section can refetch its
+// releases.
+type ReleaseCreatedMsg struct {
+	Repo    string
+	TagName string
+}
+
+func publishReleaseTask(section SectionIdentifier, release *data.ReleaseData) GitHubTask {
+	releaseId := release.Id
+	tagName := release.TagName
+	return GitHubTask{
+		Id: fmt.Sprintf("release_publish_%s_%s", release.GetRepoNameWithOwner(), tagName),
+		Args: []string{
+			"release",
+			"edit",
+			tagName,
+			"-R",
+			release.GetRepoNameWithOwner(),
+			"--draft=false",
+		},
+		Section:      section,
+		StartText:    fmt.Sprintf("Publishing release %s", tagName),
+		FinishedText: fmt.Sprintf("Release %s has been published", tagName),
+		Msg: func(c *exec.Cmd, err error) tea.Msg {
+			if err != nil {
+				return UpdateReleaseMsg{ReleaseId: releaseId}
+			}
+			publishedAt := time.Now()
+			return UpdateReleaseMsg{ReleaseId: releaseId, PublishedAt: &publishedAt}
+		},
+	}
+}
+
+// PublishRelease publishes a draft release.
+func PublishRelease(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	release *data.ReleaseData,
+) tea.Cmd {
+	return fireTask(ctx, publishReleaseTask(section, release))
+}
+
+func createReleaseTask(section SectionIdentifier, repo string, tagName string) GitHubTask {
+	return GitHubTask{
+		Id: fmt.Sprintf("release_create_%s_%s", repo, tagName),
+		Args: []string{
+			"release",
+			"create",
+			tagName,
+			"-R",
+			repo,
+			"--verify-tag",
+			"--generate-notes",
+		},
+		Section:      section,
+		StartText:    fmt.Sprintf("Creating release %s", tagName),
+		FinishedText: fmt.Sprintf("Release %s has been created", tagName),
+		Msg: func(c *exec.Cmd, err error) tea.Msg {
+			if err != nil {
+				return UpdateReleaseMsg{}
+			}
+			return ReleaseCreatedMsg{Repo: repo, TagName: tagName}
+		},
+	}
+}
+
+// CreateReleaseFromTag creates a release with generated notes for an existing tag.
+func CreateReleaseFromTag(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	repo string,
+	tagName string,
+) tea.Cmd {
+	return fireTask(ctx, createReleaseTask(section, repo, tagName))
+}
@@ -0,0 +1,44 @@
+package tasks
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+func TestPublishRelease_TaskConfiguration(t *testing.T) {
+	section := SectionIdentifier{Id: 1, Type: "release"}
+	release := &data.ReleaseData{Id: "RE_1", TagName: "v4.1.0", IsDraft: true}
+	release.Repository.NameWithOwner = "dlvhdr/gh-dash"
+
+	task := publishReleaseTask(section, release)
+
+	require.Equal(t, "release_publish_dlvhdr/gh-dash_v4.1.0", task.Id)
+	require.Equal(t, []string{
+		"release", "edit", "v4.1.0", "-R", "dlvhdr/gh-dash", "--draft=false",
+	}, task.Args)
+
+	updateMsg := task.Msg(nil, nil).(UpdateReleaseMsg)
+	require.Equal(t, "RE_1", updateMsg.ReleaseId)
+	require.NotNil(t, updateMsg.PublishedAt)
+
+	failedMsg := task.Msg(nil, fmt.Errorf("boom")).(UpdateReleaseMsg)
+	require.Nil(t, failedMsg.PublishedAt, "a failed publish must keep the draft")
+}
+
+func TestCreateReleaseFromTag_TaskConfiguration(t *testing.T) {
+	section := SectionIdentifier{Id: 1, Type: "release"}
+
+	task := createReleaseTask(section, "dlvhdr/gh-dash", "v4.1.0")
+
+	require.Equal(t, "release_create_dlvhdr/gh-dash_v4.1.0", task.Id)
+	require.Equal(t, []string{
+		"release", "create", "v4.1.0", "-R", "dlvhdr/gh-dash", "--verify-tag", "--generate-notes",
+	}, task.Args)
+	require.Equal(t, ReleaseCreatedMsg{Repo: "dlvhdr/gh-dash", TagName: "v4.1.0"},
+		task.Msg(nil, nil))
+	require.IsType(t, UpdateReleaseMsg{}, task.Msg(nil, fmt.Errorf("boom")))
+}
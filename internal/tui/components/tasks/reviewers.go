@@ -0,0 +1,96 @@
+package tasks
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+// reviewRequests converts reviewers, users or org/team teams, to the review requests of a PR.
+func reviewRequests(reviewers []string) data.ReviewRequests {
+	requests := data.ReviewRequests{Nodes: make([]data.ReviewRequestNode, 0, len(reviewers))}
+	for _, reviewer := range reviewers {
+		var node data.ReviewRequestNode
+		if _, slug, isTeam := strings.Cut(reviewer, "/"); isTeam {
+			node.RequestedReviewer.Team.Slug = slug
+		} else {
+			node.RequestedReviewer.User.Login = reviewer
+		}
+		requests.Nodes = append(requests.Nodes, node)
+	}
+	requests.TotalCount = len(requests.Nodes)
+	return requests
+}
+
+func editReviewersTask(
+	section SectionIdentifier,
+	pr data.RowData,
+	reviewers []string,
+	remove bool,
+) GitHubTask {
+	prNumber := pr.GetNumber()
+	flag := "--add-reviewer"
+	prefix := "pr_request_review"
+	startText := fmt.Sprintf("Requesting reviews on pr #%d from %s", prNumber, reviewers)
+	finishedText := fmt.Sprintf("Reviews have been requested on pr #%d from %s", prNumber,
+		reviewers)
+	if remove {
+		flag = "--remove-reviewer"
+		prefix = "pr_remove_review_request"
+		startText = fmt.Sprintf("Removing review requests of %s from pr #%d", reviewers, prNumber)
+		finishedText = fmt.Sprintf("Review requests of %s removed from pr #%d", reviewers,
+			prNumber)
+	}
+
+	return GitHubTask{
+		Id: buildTaskId(prefix, prNumber),
+		Args: []string{
+			"pr",
+			"edit",
+			fmt.Sprint(prNumber),
+			"-R",
+			pr.GetRepoNameWithOwner(),
+			flag,
+			strings.Join(reviewers, ","),
+		},
+		Section:      section,
+		StartText:    startText,
+		FinishedText: finishedText,
+		Msg: func(c *exec.Cmd, err error) tea.Msg {
+			if err != nil {
+				return UpdatePRMsg{PrNumber: prNumber}
+			}
+			requests := reviewRequests(reviewers)
+			if remove {
+				return UpdatePRMsg{PrNumber: prNumber, RemovedReviewRequests: &requests}
+			}
+			return UpdatePRMsg{PrNumber: prNumber, AddedReviewRequests: &requests}
+		},
+	}
+}
+
+// RequestPRReviews requests reviews from users and org/team teams. Reviewers who already
+// reviewed the PR are asked to review it again.
+func RequestPRReviews(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	pr data.RowData,
+	reviewers []string,
+) tea.Cmd {
+	return fireTask(ctx, editReviewersTask(section, pr, reviewers, false))
+}
+
+// RemovePRReviewRequests removes the review requests of users and org/team teams.
+func RemovePRReviewRequests(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	pr data.RowData,
+	reviewers []string,
+) tea.Cmd {
+	return fireTask(ctx, editReviewersTask(section, pr, reviewers, true))
+}
@@ -0,0 +1,34 @@
+package tasks
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEditReviewers_TaskConfiguration(t *testing.T) {
+	section := SectionIdentifier{Id: 2, Type: "pr"}
+	pr := mockIssue{number: 42, repoName: "cli/cli"}
+	reviewers := []string{"octo", "cli/maintainers"}
+
+	task := editReviewersTask(section, pr, reviewers, false)
+	require.Equal(t, "pr_request_review_42", task.Id)
+	require.Equal(t, []string{
+		"pr", "edit", "42", "-R", "cli/cli", "--add-reviewer", "octo,cli/maintainers",
+	}, task.Args)
+
+	added := task.Msg(nil, nil).(UpdatePRMsg).AddedReviewRequests
+	require.NotNil(t, added)
+	require.Equal(t, 2, added.TotalCount)
+	require.Equal(t, "octo", added.Nodes[0].RequestedReviewer.User.Login)
+	require.Equal(t, "maintainers", added.Nodes[1].RequestedReviewer.Team.Slug)
+
+	task = editReviewersTask(section, pr, reviewers, true)
+	require.Equal(t, "pr_remove_review_request_42", task.Id)
+	require.Contains(t, task.Args, "--remove-reviewer")
+	require.NotNil(t, task.Msg(nil, nil).(UpdatePRMsg).RemovedReviewRequests)
+
+	updateMsg := task.Msg(nil, fmt.Errorf("exit status 1")).(UpdatePRMsg)
+	require.Nil(t, updateMsg.RemovedReviewRequests, "failed tasks don't change the reviewers")
+}
@@ -0,0 +1,258 @@
+package tasks
+
+import (
+	gocontext "context"
+	"errors"
+	"fmt"
+	"slices"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/log/v2"
+	ghchecks "github.com/dlvhdr/x/gh-checks"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+// WorkflowRunsTask acts on each workflow run of the head commit of a PR that the action
+// applies to, e.g. re-running the failed ones, while showing a single task in the footer.
+type WorkflowRunsTask struct {
+	Id           string
+	Section      SectionIdentifier
+	PrNumber     int
+	PrUrl        string
+	Repo         string
+	StartText    string
+	FinishedText string
+	// RunId is the single workflow run to act on, or 0 to act on the runs Matches applies to
+	RunId int64
+	// Matches returns whether the action applies to the workflow run of a check suite
+	Matches func(suite data.CheckSuiteNode) bool
+	// NoRunsErr is the error of the task when the action applies to no workflow run
+	NoRunsErr error
+	Action    func(repo string, runId int64) error
+}
+
+func newWorkflowRunsTask(
+	prefix string,
+	section SectionIdentifier,
+	pr data.RowData,
+) WorkflowRunsTask {
+	return WorkflowRunsTask{
+		Id:       buildTaskId(prefix, pr.GetNumber()),
+		Section:  section,
+		PrNumber: pr.GetNumber(),
+		PrUrl:    pr.GetUrl(),
+		Repo:     pr.GetRepoNameWithOwner(),
+	}
+}
+
+func rerunFailedWorkflowsTask(section SectionIdentifier, pr data.RowData) WorkflowRunsTask {
+	task := newWorkflowRunsTask("pr_rerun_failed_workflows", section, pr)
+	task.StartText = fmt.Sprintf("Re-running failed workflows for PR #%d", task.PrNumber)
+	task.FinishedText = fmt.Sprintf("Failed workflows for PR #%d were re-run", task.PrNumber)
+	task.Matches = func(suite data.CheckSuiteNode) bool {
+		conclusion := string(suite.Conclusion)
+		return suite.Status == "COMPLETED" && (ghchecks.IsConclusionAFailure(conclusion) ||
+			conclusion == string(ghchecks.CheckRunStateCancelled))
+	}
+	task.NoRunsErr = errors.New("no failed workflows")
+	task.Action = data.RerunFailedJobs
+	return task
+}
+
+func rerunAllWorkflowsTask(section SectionIdentifier, pr data.RowData) WorkflowRunsTask {
+	task := newWorkflowRunsTask("pr_rerun_workflows", section, pr)
+	task.StartText = fmt.Sprintf("Re-running workflows for PR #%d", task.PrNumber)
+	task.FinishedText = fmt.Sprintf("Workflows for PR #%d were re-run", task.PrNumber)
+	task.Matches = func(suite data.CheckSuiteNode) bool {
+		// Workflows awaiting approval have to be approved rather than re-run
+		return suite.Status == "COMPLETED" && suite.Conclusion != "ACTION_REQUIRED"
+	}
+	task.NoRunsErr = errors.New("no completed workflows")
+	task.Action = data.RerunWorkflowRun
+	return task
+}
+
+func cancelWorkflowsTask(section SectionIdentifier, pr data.RowData) WorkflowRunsTask {
+	task := newWorkflowRunsTask("pr_cancel_workflows", section, pr)
+	task.StartText = fmt.Sprintf("Cancelling workflows for PR #%d", task.PrNumber)
+	task.FinishedText = fmt.Sprintf("Workflows for PR #%d have been cancelled", task.PrNumber)
+	task.Matches = func(suite data.CheckSuiteNode) bool {
+		return ghchecks.IsStatusWaiting(string(suite.Status)) || suite.Status == "REQUESTED"
+	}
+	task.NoRunsErr = errors.New("no workflows in progress")
+	task.Action = data.CancelWorkflowRun
+	return task
+}
+
+func rerunFailedJobsTask(
+	section SectionIdentifier,
+	pr data.RowData,
+	runId int64,
+) WorkflowRunsTask {
+	task := newWorkflowRunsTask("pr_rerun_failed_jobs", section, pr)
+	task.Id = fmt.Sprintf("pr_rerun_failed_jobs_%d", runId)
+	task.RunId = runId
+	task.StartText = fmt.Sprintf("Re-running failed jobs of run %d for PR #%d", runId,
+		task.PrNumber)
+	task.FinishedText = fmt.Sprintf("Failed jobs of run %d for PR #%d were re-run", runId,
+		task.PrNumber)
+	task.Action = data.RerunFailedJobs
+	return task
+}
+
+func cancelWorkflowRunTask(
+	section SectionIdentifier,
+	pr data.RowData,
+	runId int64,
+) WorkflowRunsTask {
+	task := newWorkflowRunsTask("pr_cancel_run", section, pr)
+	task.Id = fmt.Sprintf("pr_cancel_run_%d", runId)
+	task.RunId = runId
+	task.StartText = fmt.Sprintf("Cancelling run %d for PR #%d", runId, task.PrNumber)
+	task.FinishedText = fmt.Sprintf("Run %d for PR #%d has been cancelled", runId, task.PrNumber)
+	task.Action = data.CancelWorkflowRun
+	return task
+}
+
+// RerunFailedJobs re-runs the failed jobs of a workflow run of the PR, and the jobs depending
+// on them.
+func RerunFailedJobs(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	pr data.RowData,
+	runId int64,
+) tea.Cmd {
+	return fireWorkflowRunsTask(ctx, rerunFailedJobsTask(section, pr, runId))
+}
+
+// CancelWorkflowRun cancels a workflow run of the PR that is queued or in progress.
+func CancelWorkflowRun(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	pr data.RowData,
+	runId int64,
+) tea.Cmd {
+	return fireWorkflowRunsTask(ctx, cancelWorkflowRunTask(section, pr, runId))
+}
+
+// RerunFailedWorkflows re-runs the failed jobs of the workflows of the head commit of the PR.
+func RerunFailedWorkflows(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	pr data.RowData,
+) tea.Cmd {
+	return fireWorkflowRunsTask(ctx, rerunFailedWorkflowsTask(section, pr))
+}
+
+// RerunAllWorkflows re-runs all the jobs of the completed workflows of the head commit of
+// the PR.
+func RerunAllWorkflows(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	pr data.RowData,
+) tea.Cmd {
+	return fireWorkflowRunsTask(ctx, rerunAllWorkflowsTask(section, pr))
+}
+
+// CancelWorkflows cancels the queued and in progress workflows of the head commit of the PR.
+func CancelWorkflows(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	pr data.RowData,
+) tea.Cmd {
+	return fireWorkflowRunsTask(ctx, cancelWorkflowsTask(section, pr))
+}
+
+func fireWorkflowRunsTask(ctx *context.ProgramContext, task WorkflowRunsTask) tea.Cmd {
+	start := context.Task{
+		Id:           task.Id,
+		StartText:    task.StartText,
+		FinishedText: task.FinishedText,
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+
+	startCmd := ctx.StartTask(start)
+	return tea.Batch(startCmd, func() tea.Msg {
+		fetchChecks := func(prUrl string) (data.LastCommitWithStatusChecks, error) {
+			return data.FetchPullRequestChecks(gocontext.Background(), prUrl)
+		}
+		return runWorkflowRunsTask(task, fetchChecks)
+	})
+}
+
+// runWorkflowRunsTask resolves the workflow runs behind the check suites of the head commit
+// of the PR, unless the task is for a single run, runs the action on the ones it applies to
+// and refetches the checks, so the check rollup of the PR reflects the runs that were re-run
+// or cancelled.
+func runWorkflowRunsTask(
+	task WorkflowRunsTask,
+	fetchChecks func(prUrl string) (data.LastCommitWithStatusChecks, error),
+) constants.TaskFinishedMsg {
+	finished := constants.TaskFinishedMsg{
+		TaskId:      task.Id,
+		SectionId:   task.Section.Id,
+		SectionType: task.Section.Type,
+	}
+	update := UpdatePRMsg{PrNumber: task.PrNumber}
+
+	runIds := []int64{task.RunId}
+	if task.RunId == 0 {
+		commits, err := fetchChecks(task.PrUrl)
+		if err != nil {
+			finished.Err = fmt.Errorf("failed to get workflow runs: %w", err)
+			finished.Msg = update
+			return finished
+		}
+
+		runIds = matchingWorkflowRuns(commits, task.Matches)
+		if len(runIds) == 0 {
+			finished.Err = task.NoRunsErr
+			finished.Msg = update
+			return finished
+		}
+	}
+
+	errs := make([]error, 0)
+	for _, runId := range runIds {
+		log.Info("Running workflow run action", "task", task.Id, "runId", runId)
+		if err := task.Action(task.Repo, runId); err != nil {
+			errs = append(errs, fmt.Errorf("run %d: %w", runId, err))
+		}
+	}
+	if len(errs) > 0 {
+		finished.Err = fmt.Errorf("%d of %d failed: %w",
+			len(errs), len(runIds), errors.Join(errs...))
+	}
+
+	if refreshed, err := fetchChecks(task.PrUrl); err != nil {
+		log.Error("Error refreshing PR checks", "url", task.PrUrl, "err", err)
+	} else {
+		update.Checks = &refreshed
+	}
+	finished.Msg = update
+	return finished
+}
+
+// matchingWorkflowRuns returns the ids of the workflow runs of the check suites of the last
+// commit that match.
+func matchingWorkflowRuns(
+	commits data.LastCommitWithStatusChecks,
+	matches func(suite data.CheckSuiteNode) bool,
+) []int64 {
+	runIds := make([]int64, 0)
+	if len(commits.Nodes) == 0 {
+		return runIds
+	}
+	for _, suite := range commits.Nodes[0].Commit.CheckSuites.Nodes {
+		runId := suite.WorkflowRun.DatabaseId
+		if runId == 0 || !matches(suite) || slices.Contains(runIds, runId) {
+			continue
+		}
+		runIds = append(runIds, runId)
+	}
+	return runIds
+}
@@ -0,0 +1,174 @@
+package tasks
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+func TestRerunFailedJobs_TaskConfiguration(t *testing.T) {
+	section := SectionIdentifier{Id: 2, Type: "pr"}
+	task := rerunFailedJobsTask(section, mockIssue{number: 42, repoName: "owner/repo"}, 1234)
+
+	require.Equal(t, "pr_rerun_failed_jobs_1234", task.Id)
+	require.Equal(t, int64(1234), task.RunId)
+	require.Equal(t, "owner/repo", task.Repo)
+	require.Equal(t, section, task.Section)
+	require.Equal(t, "Re-running failed jobs of run 1234 for PR #42", task.StartText)
+}
+
+func TestCancelWorkflowRun_TaskConfiguration(t *testing.T) {
+	section := SectionIdentifier{Id: 2, Type: "pr"}
+	task := cancelWorkflowRunTask(section, mockIssue{number: 42, repoName: "owner/repo"}, 1234)
+
+	require.Equal(t, "pr_cancel_run_1234", task.Id)
+	require.Equal(t, int64(1234), task.RunId)
+	require.Equal(t, "Run 1234 for PR #42 has been cancelled", task.FinishedText)
+}
+
+func TestRunWorkflowRunsTask_SingleRun(t *testing.T) {
+	pr := mockIssue{number: 42, repoName: "owner/repo"}
+	after := makeLastCommit(t,
+		mockCheckSuite{runId: 1234, status: "IN_PROGRESS"})
+
+	task := rerunFailedJobsTask(SectionIdentifier{Id: 2, Type: "pr"}, pr, 1234)
+	rerun := make([]int64, 0)
+	task.Action = func(repo string, runId int64) error {
+		require.Equal(t, "owner/repo", repo)
+		rerun = append(rerun, runId)
+		return nil
+	}
+
+	fetches := 0
+	finished := runWorkflowRunsTask(task, func(string) (data.LastCommitWithStatusChecks, error) {
+		fetches++
+		return after, nil
+	})
+	require.Equal(t, []int64{1234}, rerun)
+	require.Equal(t, 1, fetches, "the run isn't resolved from the checks, only refetched")
+	require.NoError(t, finished.Err)
+	require.Equal(t, UpdatePRMsg{PrNumber: 42, Checks: &after}, finished.Msg)
+}
+
+type mockCheckSuite struct {
+	runId      int64
+	status     string
+	conclusion string
+}
+
+func makeLastCommit(t *testing.T, suites ...mockCheckSuite) data.LastCommitWithStatusChecks {
+	t.Helper()
+	nodes := make([]map[string]any, 0, len(suites))
+	for _, suite := range suites {
+		nodes = append(nodes, map[string]any{
+			"Status":      suite.status,
+			"Conclusion":  suite.conclusion,
+			"WorkflowRun": map[string]any{"DatabaseId": suite.runId},
+		})
+	}
+	raw, err := json.Marshal(map[string]any{
+		"Nodes": []any{map[string]any{
+			"Commit": map[string]any{"CheckSuites": map[string]any{"Nodes": nodes}},
+		}},
+	})
+	require.NoError(t, err)
+
+	var commits data.LastCommitWithStatusChecks
+	require.NoError(t, json.Unmarshal(raw, &commits))
+	return commits
+}
+
+func TestWorkflowRunsTask_MatchingRuns(t *testing.T) {
+	section := SectionIdentifier{Id: 1, Type: "pr"}
+	pr := mockIssue{number: 7, repoName: "owner/repo"}
+	commits := makeLastCommit(t,
+		mockCheckSuite{runId: 1, status: "COMPLETED", conclusion: "SUCCESS"},
+		mockCheckSuite{runId: 2, status: "COMPLETED", conclusion: "FAILURE"},
+		mockCheckSuite{runId: 3, status: "COMPLETED", conclusion: "CANCELLED"},
+		mockCheckSuite{runId: 4, status: "IN_PROGRESS"},
+		mockCheckSuite{runId: 5, status: "QUEUED"},
+		mockCheckSuite{runId: 6, status: "COMPLETED", conclusion: "ACTION_REQUIRED"},
+		// Check suites of apps other than GitHub Actions have no workflow run
+		mockCheckSuite{status: "COMPLETED", conclusion: "FAILURE"},
+	)
+
+	tests := []struct {
+		name string
+		task WorkflowRunsTask
+		want []int64
+	}{
+		{name: "rerun failed", task: rerunFailedWorkflowsTask(section, pr), want: []int64{2, 3}},
+		{name: "rerun all", task: rerunAllWorkflowsTask(section, pr), want: []int64{1, 2, 3}},
+		{name: "cancel", task: cancelWorkflowsTask(section, pr), want: []int64{4, 5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, matchingWorkflowRuns(commits, tt.task.Matches))
+		})
+	}
+}
+
+func TestRunWorkflowRunsTask(t *testing.T) {
+	section := SectionIdentifier{Id: 3, Type: "pr"}
+	pr := mockIssue{number: 42, repoName: "owner/repo", url: "https://github.com/owner/repo/pull/42"}
+	before := makeLastCommit(t,
+		mockCheckSuite{runId: 10, status: "COMPLETED", conclusion: "FAILURE"},
+		mockCheckSuite{runId: 11, status: "COMPLETED", conclusion: "TIMED_OUT"},
+	)
+	after := makeLastCommit(t,
+		mockCheckSuite{runId: 10, status: "QUEUED"},
+		mockCheckSuite{runId: 11, status: "COMPLETED", conclusion: "TIMED_OUT"},
+	)
+
+	fetches := 0
+	fetchChecks := func(prUrl string) (data.LastCommitWithStatusChecks, error) {
+		require.Equal(t, pr.url, prUrl)
+		fetches++
+		if fetches == 1 {
+			return before, nil
+		}
+		return after, nil
+	}
+
+	task := rerunFailedWorkflowsTask(section, pr)
+	rerun := make([]int64, 0)
+	task.Action = func(repo string, runId int64) error {
+		require.Equal(t, "owner/repo", repo)
+		rerun = append(rerun, runId)
+		if runId == 11 {
+			return errors.New("run is too old to be re-run")
+		}
+		return nil
+	}
+
+	finished := runWorkflowRunsTask(task, fetchChecks)
+	require.Equal(t, []int64{10, 11}, rerun)
+	require.Equal(t, task.Id, finished.TaskId)
+	require.Equal(t, section.Id, finished.SectionId)
+	require.ErrorContains(t, finished.Err, "1 of 2 failed")
+	require.ErrorContains(t, finished.Err, "run 11: run is too old to be re-run")
+	require.Equal(t, UpdatePRMsg{PrNumber: 42, Checks: &after}, finished.Msg,
+		"the checks are refetched after the runs were re-run")
+}
+
+func TestRunWorkflowRunsTask_NoMatchingRuns(t *testing.T) {
+	pr := mockIssue{number: 42, repoName: "owner/repo"}
+	commits := makeLastCommit(t,
+		mockCheckSuite{runId: 10, status: "COMPLETED", conclusion: "SUCCESS"})
+
+	task := cancelWorkflowsTask(SectionIdentifier{Id: 3, Type: "pr"}, pr)
+	task.Action = func(repo string, runId int64) error {
+		t.Fatalf("no run should be cancelled, got %d", runId)
+		return nil
+	}
+
+	finished := runWorkflowRunsTask(task, func(string) (data.LastCommitWithStatusChecks, error) {
+		return commits, nil
+	})
+	require.Equal(t, task.NoRunsErr, finished.Err)
+	require.Equal(t, UpdatePRMsg{PrNumber: 42}, finished.Msg)
+}
@@ -0,0 +1,210 @@
+package tasks
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/log/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/git"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+// ExpandHome replaces a leading ~ of path with the home directory of the user.
+func ExpandHome(path string) string {
+	if !strings.HasPrefix(path, "~") {
+		return path
+	}
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return strings.Replace(path, "~", homeDir, 1)
+}
+
+// WorktreePath returns where a PR is checked out with worktrees enabled, i.e.
+// <root>/<owner>/<repo>/pr-<number>.
+func WorktreePath(root string, repoName string, number int) string {
+	owner, repo, _ := strings.Cut(repoName, "/")
+	return filepath.Join(ExpandHome(root), owner, repo, fmt.Sprintf("pr-%d", number))
+}
+
+// worktreeCheckout runs the git and gh commands a worktree checkout is made of, swapped in tests.
+type worktreeCheckout struct {
+	add      func(repoPath string, path string) error
+	checkout func(dir string, number int) error
+	branch   func(dir string) (string, error)
+}
+
+var defaultWorktreeCheckout = worktreeCheckout{
+	add: git.AddWorktree,
+	checkout: func(dir string, number int) error {
+		c := exec.Command("gh", "pr", "checkout", fmt.Sprint(number))
+		c.Dir = dir
+		log.Info("Running task", "cmd", "gh pr checkout "+fmt.Sprint(number), "dir", dir)
+		if output, err := c.CombinedOutput(); err != nil {
+			return fmt.Errorf("%w: %s", err, strings.TrimSpace(string(output)))
+		}
+		return nil
+	},
+	branch: git.GetCurrentBranch,
+}
+
+// checkoutWorktree checks the PR out in the worktree at path, adding the worktree first unless
+// an earlier checkout already did, and records it in the store.
+func checkoutWorktree(
+	store *data.WorktreeStore,
+	run worktreeCheckout,
+	repoPath string,
+	path string,
+	repoName string,
+	number int,
+) (data.Worktree, error) {
+	worktree, ok := store.Get(repoName, number)
+	if !ok || worktree.Path != path {
+		worktree = data.Worktree{
+			Repo:      repoName,
+			Number:    number,
+			Path:      path,
+			RepoPath:  repoPath,
+			CreatedAt: time.Now(),
+		}
+	}
+
+	if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+		if err := run.add(repoPath, path); err != nil {
+			return worktree, fmt.Errorf("failed adding a worktree at %s: %w", path, err)
+		}
+	}
+	if err := run.checkout(path, number); err != nil {
+		return worktree, err
+	}
+
+	branch, err := run.branch(path)
+	if err != nil {
+		return worktree, err
+	}
+	worktree.Branch = branch
+	return worktree, store.Put(worktree)
+}
+
+// CheckoutPRWorktree checks the PR out in a worktree of its own under the worktrees root, so the
+// branch of the repoPaths directory is left alone. The worktree of an earlier checkout of the PR
+// is reused.
+func CheckoutPRWorktree(
+	ctx *context.ProgramContext,
+	repoPath string,
+	repoName string,
+	number int,
+) tea.Cmd {
+	repoPath, _ = filepath.Abs(ExpandHome(repoPath))
+	path := WorktreePath(ctx.Config.Worktrees.Root, repoName, number)
+
+	taskId := fmt.Sprintf("checkout_%d", number)
+	startCmd := ctx.StartTask(context.Task{
+		Id:           taskId,
+		StartText:    fmt.Sprintf("Checking out PR #%d in a worktree", number),
+		FinishedText: fmt.Sprintf("PR #%d has been checked out at %s", number, path),
+		State:        context.TaskStart,
+		Error:        nil,
+	})
+	return tea.Batch(startCmd, func() tea.Msg {
+		_, err := checkoutWorktree(data.GetWorktreeStore(), defaultWorktreeCheckout, repoPath,
+			path, repoName, number)
+		return constants.TaskFinishedMsg{TaskId: taskId, Err: err}
+	})
+}
+
+// prunableWorktrees returns the worktrees whose PRs were merged or closed and the ones whose
+// directories are gone.
+func prunableWorktrees(
+	worktrees []data.Worktree,
+	fetchState func(repo string, number int) (string, error),
+) ([]data.Worktree, error) {
+	var prunable []data.Worktree
+	var errs []error
+	for _, worktree := range worktrees {
+		if _, err := os.Stat(worktree.Path); errors.Is(err, os.ErrNotExist) {
+			prunable = append(prunable, worktree)
+			continue
+		}
+		state, err := fetchState(worktree.Repo, worktree.Number)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed fetching PR #%d: %w", worktree.Number, err))
+			continue
+		}
+		if state == "MERGED" || state == "CLOSED" {
+			prunable = append(prunable, worktree)
+		}
+	}
+	return prunable, errors.Join(errs...)
+}
+
+// pruneWorktrees removes the worktrees of the repo at repoPath whose PRs were merged or closed.
+// Worktrees with uncommitted changes are kept.
+func pruneWorktrees(
+	store *data.WorktreeStore,
+	repoPath string,
+	fetchState func(repo string, number int) (string, error),
+	remove func(repoPath string, path string) error,
+) (int, error) {
+	prunable, err := prunableWorktrees(store.ForRepoPath(repoPath), fetchState)
+	errs := []error{err}
+	pruned := 0
+	for _, worktree := range prunable {
+		if _, statErr := os.Stat(worktree.Path); statErr == nil {
+			if err := remove(repoPath, worktree.Path); err != nil {
+				errs = append(errs, fmt.Errorf("kept the worktree of PR #%d: %w",
+					worktree.Number, err))
+				continue
+			}
+		}
+		if err := store.Remove(worktree.Path); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		pruned++
+	}
+	return pruned, errors.Join(errs...)
+}
+
+// PruneWorktrees removes the worktrees of the repo at repoPath whose PRs were merged or closed,
+// along with the ones whose directories were deleted. Worktrees with uncommitted changes are
+// kept so the changes aren't lost.
+func PruneWorktrees(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	repoPath string,
+) tea.Cmd {
+	repoPath, _ = filepath.Abs(ExpandHome(repoPath))
+	taskId := fmt.Sprintf("prune_worktrees_%d", time.Now().Unix())
+	startCmd := ctx.StartTask(context.Task{
+		Id:           taskId,
+		StartText:    "Pruning the worktrees of merged and closed PRs",
+		FinishedText: "Worktrees have been pruned",
+		State:        context.TaskStart,
+		Error:        nil,
+	})
+	return tea.Batch(startCmd, func() tea.Msg {
+		pruned, err := pruneWorktrees(data.GetWorktreeStore(), repoPath,
+			data.FetchPullRequestState, git.RemoveWorktree)
+		if pruneErr := git.PruneWorktrees(repoPath); pruneErr != nil {
+			log.Warn("Failed pruning stale worktrees", "repoPath", repoPath, "err", pruneErr)
+		}
+		log.Info("Pruned worktrees", "repoPath", repoPath, "count", pruned)
+		return constants.TaskFinishedMsg{
+			TaskId:      taskId,
+			SectionId:   section.Id,
+			SectionType: section.Type,
+			Err:         err,
+		}
+	})
+}
@@ -0,0 +1,109 @@
+package tasks
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+func TestWorktreePath(t *testing.T) {
+	require.Equal(t, filepath.Join("/wt", "dlvhdr", "gh-dash", "pr-42"),
+		WorktreePath("/wt", "dlvhdr/gh-dash", 42))
+
+	homeDir, err := os.UserHomeDir()
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(homeDir, "wt", "cli", "cli", "pr-7"),
+		WorktreePath("~/wt", "cli/cli", 7))
+}
+
+func TestCheckoutWorktree(t *testing.T) {
+	dir := t.TempDir()
+	store := data.NewWorktreeStoreForTesting(filepath.Join(dir, "worktrees.json"))
+	path := filepath.Join(dir, "dlvhdr", "gh-dash", "pr-42")
+
+	var added, checkedOut []string
+	run := worktreeCheckout{
+		add: func(repoPath string, path string) error {
+			added = append(added, path)
+			return os.MkdirAll(path, 0o755)
+		},
+		checkout: func(dir string, number int) error {
+			checkedOut = append(checkedOut, dir)
+			return nil
+		},
+		branch: func(dir string) (string, error) { return "fix-typo", nil },
+	}
+
+	worktree, err := checkoutWorktree(store, run, "/code/gh-dash", path, "dlvhdr/gh-dash", 42)
+	require.NoError(t, err)
+	require.Equal(t, "fix-typo", worktree.Branch)
+	require.Equal(t, "/code/gh-dash", worktree.RepoPath)
+	require.Equal(t, []string{path}, added)
+
+	stored, ok := store.Get("dlvhdr/gh-dash", 42)
+	require.True(t, ok)
+	require.Equal(t, path, stored.Path)
+
+	_, err = checkoutWorktree(store, run, "/code/gh-dash", path, "dlvhdr/gh-dash", 42)
+	require.NoError(t, err)
+	require.Len(t, added, 1, "the worktree of an earlier checkout is reused")
+	require.Equal(t, []string{path, path}, checkedOut)
+	require.Len(t, store.ForRepoPath("/code/gh-dash"), 1)
+
+	run.checkout = func(dir string, number int) error { return errors.New("no such PR") }
+	_, err = checkoutWorktree(store, run, "/code/gh-dash", filepath.Join(dir, "pr-1"),
+		"dlvhdr/gh-dash", 1)
+	require.Error(t, err)
+	_, ok = store.Get("dlvhdr/gh-dash", 1)
+	require.False(t, ok, "failed checkouts aren't recorded")
+}
+
+func TestPruneWorktrees(t *testing.T) {
+	dir := t.TempDir()
+	store := data.NewWorktreeStoreForTesting(filepath.Join(dir, "worktrees.json"))
+	worktree := func(number int, exists bool) data.Worktree {
+		path := filepath.Join(dir, fmt.Sprintf("pr-%d", number))
+		if exists {
+			require.NoError(t, os.MkdirAll(path, 0o755))
+		}
+		return data.Worktree{Repo: "dlvhdr/gh-dash", Number: number, Path: path, RepoPath: "/repo"}
+	}
+	for _, w := range []data.Worktree{
+		worktree(1, true),  // open
+		worktree(2, true),  // merged
+		worktree(3, true),  // closed, with uncommitted changes
+		worktree(4, false), // deleted
+	} {
+		require.NoError(t, store.Put(w))
+	}
+
+	states := map[int]string{1: "OPEN", 2: "MERGED", 3: "CLOSED"}
+	fetchState := func(repo string, number int) (string, error) {
+		return states[number], nil
+	}
+	var removed []string
+	remove := func(repoPath string, path string) error {
+		if filepath.Base(path) == "pr-3" {
+			return errors.New("contains modified or untracked files")
+		}
+		removed = append(removed, filepath.Base(path))
+		return nil
+	}
+
+	pruned, err := pruneWorktrees(store, "/repo", fetchState, remove)
+	require.Equal(t, 2, pruned)
+	require.ErrorContains(t, err, "kept the worktree of PR #3")
+	require.Equal(t, []string{"pr-2"}, removed, "deleted directories aren't removed again")
+
+	var left []int
+	for _, w := range store.ForRepoPath("/repo") {
+		left = append(left, w.Number)
+	}
+	require.ElementsMatch(t, []int{1, 3}, left)
+}
@@ -8,6 +8,7 @@ import (
 	"charm.land/lipgloss/v2"
 	"charm.land/lipgloss/v2/compat"
 
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
 )
 
@@ -56,3 +57,17 @@ func RenderIssueTitle(
 	res := fmt.Sprintf("%s%s", prNumber, rTitle)
 	return res
 }
+
+// RenderProjectFields renders the values of the given project fields of an issue or PR, one cell
+// per field.
+func RenderProjectFields(
+	ctx *context.ProgramContext,
+	items data.ProjectItems,
+	fields []string,
+) []string {
+	cells := make([]string, 0, len(fields))
+	for _, field := range fields {
+		cells = append(cells, GetIssueTextStyle(ctx).Render(items.FieldValue(field)))
+	}
+	return cells
+}
@@ -49,6 +49,12 @@ const (
 	CommentsIcon       = ""
 	DonateIcon         = "󱃱"
 	DraftIcon          = ""
+	DiscussionIcon     = "" // \uf442 nf-oct-comment_discussion
+	AnsweredIcon       = "" // \uf49e nf-oct-check_circle
+	UpvoteIcon         = "" // \uf431 nf-oct-arrow_up
+	ReleaseIcon        = "" // \uf412 nf-oct-tag
+	ProjectIcon        = "" // \uf502 nf-oct-project
+	MilestoneIcon      = "" // \uf45d nf-oct-milestone
 	CommitIcon         = ""
 	VerticalCommitIcon = "󰜘"
 	LabelsIcon         = "󰌖"
@@ -56,6 +62,12 @@ const (
 	MergeQueueIcon     = "" // \uf4db nf-oct-git_merge_queue
 	OpenIcon           = ""
 	SelectionIcon      = "→"
+	MarkedIcon         = "▌"
+	ExpandedGroupIcon  = "▾"
+	CollapsedGroupIcon = "▸"
+	WorktreeIcon       = "󰙅" // \udb81\ude45 nf-md-file_tree
+	ConflictIcon       = "" // \uf421 nf-oct-alert
+	DirtyIcon          = "" // \uf448 nf-oct-pencil
 
 	AutocompleteColumnGap              = 2
 	AutocompleteMinValueWidth          = 8
@@ -92,8 +104,32 @@ const (
 	AssignPrompt   = "Assign users (whitespace-separated)" + Ellipsis
 	UnassignPrompt = "Unassign users (whitespace-separated)" + Ellipsis
 	CommentPrompt  = "Leave a comment" + Ellipsis
+	ReplyPrompt    = "Reply to thread" + Ellipsis
 	ApprovalPrompt = "Approve with comment" + Ellipsis
+	ReviewPrompt   = "Submit review: %s (%s to change)" + Ellipsis
 	LabelPrompt    = "Add/remove labels (comma-separated)" + Ellipsis
+	// BulkLabelPrompt is used when labeling several rows, where labels can only be added
+	BulkLabelPrompt  = "Add labels (comma-separated)" + Ellipsis
+	SubjectPrompt    = "Commit title" + Ellipsis
+	MessagePrompt    = "Commit message" + Ellipsis
+	ReleaseTagPrompt = "Create a release from the tag" + Ellipsis
+	// ProjectFieldPrompt takes a "Field: value" assignment, an empty value clears the field
+	ProjectFieldPrompt = "Set a project field (Field: value)" + Ellipsis
+	// MilestonePrompt takes the title of a milestone, an empty title removes the milestone
+	MilestonePrompt = "Set the milestone (empty to remove)" + Ellipsis
+	// Prompts of the fields of a new issue
+	IssueRepoPrompt      = "Repository (owner/name)" + Ellipsis
+	IssueTitlePrompt     = "Issue title" + Ellipsis
+	IssueBodyPrompt      = "Issue description" + Ellipsis
+	IssueLabelsPrompt    = "Labels (comma-separated)" + Ellipsis
+	IssueAssigneesPrompt = "Assignees (whitespace-separated)" + Ellipsis
+	// Reviewers are users or org/team teams
+	RequestReviewersPrompt = "Request reviews (whitespace-separated)" + Ellipsis
+	RemoveReviewersPrompt  = "Remove review requests (whitespace-separated)" + Ellipsis
+	// ReactionPrompt takes what is reacted to, picking a reaction the user has removes it
+	ReactionPrompt = "React to %s" + Ellipsis
+	// TimelineActionPrompt takes the selected entry of the activity timeline
+	TimelineActionPrompt = "Act on %s" + Ellipsis
 
 	Logo = `▜▔▚▐▔▌▚▔▐ ▌
 ▟▁▞▐▔▌▁▚▐▔▌`
@@ -83,6 +83,18 @@ func (ctx *ProgramContext) GetViewSectionsConfig() []config.SectionConfig {
 		for _, cfg := range ctx.Config.IssuesSections {
 			configs = append(configs, cfg.ToSectionConfig())
 		}
+	case config.DiscussionsView:
+		for _, cfg := range ctx.Config.DiscussionsSections {
+			configs = append(configs, cfg.ToSectionConfig())
+		}
+	case config.ReleasesView:
+		for _, cfg := range ctx.Config.ReleasesSections {
+			configs = append(configs, cfg.ToSectionConfig())
+		}
+	case config.ProjectsView:
+		for _, cfg := range ctx.Config.ProjectsSections {
+			configs = append(configs, cfg.ToSectionConfig())
+		}
 	}
 
 	return append([]config.SectionConfig{{Title: ""}}, configs...)
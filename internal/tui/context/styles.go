@@ -79,6 +79,8 @@ type Styles struct {
 	Table struct {
 		CellStyle                lipgloss.Style
 		SelectedCellStyle        lipgloss.Style
+		MarkerStyle              lipgloss.Style
+		GroupHeaderStyle         lipgloss.Style
 		TitleCellStyle           lipgloss.Style
 		SingleRuneTitleCellStyle lipgloss.Style
 		HeaderStyle              lipgloss.Style
@@ -220,6 +222,11 @@ func InitStyles(theme theme.Theme) Styles {
 		MaxHeight(1)
 	s.Table.SelectedCellStyle = s.Table.CellStyle.
 		Background(theme.SelectedBackground)
+	s.Table.MarkerStyle = lipgloss.NewStyle().
+		Foreground(theme.SuccessText)
+	s.Table.GroupHeaderStyle = s.Table.CellStyle.
+		Bold(true).
+		Foreground(theme.SecondaryText)
 	s.Table.TitleCellStyle = s.Table.CellStyle.
 		Bold(true).
 		Foreground(theme.PrimaryText)
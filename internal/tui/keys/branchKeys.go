@@ -10,15 +10,22 @@ import (
 )
 
 type BranchKeyMap struct {
-	Checkout    key.Binding
-	New         key.Binding
-	CreatePr    key.Binding
-	FastForward key.Binding
-	Push        key.Binding
-	ForcePush   key.Binding
-	Delete      key.Binding
-	UpdatePr    key.Binding
-	ViewPRs     key.Binding
+	Checkout       key.Binding
+	New            key.Binding
+	CreatePr       key.Binding
+	FastForward    key.Binding
+	Push           key.Binding
+	ForcePush      key.Binding
+	Delete         key.Binding
+	UpdatePr       key.Binding
+	ViewPRs        key.Binding
+	OpenShell      key.Binding
+	OpenEditor     key.Binding
+	PruneWorktrees key.Binding
+	Rebase         key.Binding
+	ForcePushLease key.Binding
+	ContinueRebase key.Binding
+	AbortRebase    key.Binding
 }
 
 var BranchKeys = BranchKeyMap{
@@ -58,6 +65,34 @@ var BranchKeys = BranchKeyMap{
 		key.WithKeys("s"),
 		key.WithHelp("s", "Switch to PRs"),
 	),
+	OpenShell: key.NewBinding(
+		key.WithKeys("t"),
+		key.WithHelp("t", "shell in worktree"),
+	),
+	OpenEditor: key.NewBinding(
+		key.WithKeys("e"),
+		key.WithHelp("e", "editor in worktree"),
+	),
+	PruneWorktrees: key.NewBinding(
+		key.WithKeys("X"),
+		key.WithHelp("X", "prune worktrees"),
+	),
+	Rebase: key.NewBinding(
+		key.WithKeys("b"),
+		key.WithHelp("b", "rebase onto base"),
+	),
+	ForcePushLease: key.NewBinding(
+		key.WithKeys("L"),
+		key.WithHelp("L", "force-push with lease"),
+	),
+	ContinueRebase: key.NewBinding(
+		key.WithKeys("c"),
+		key.WithHelp("c", "continue rebase"),
+	),
+	AbortRebase: key.NewBinding(
+		key.WithKeys("A"),
+		key.WithHelp("A", "abort rebase"),
+	),
 }
 
 func BranchFullHelp() []key.Binding {
@@ -66,11 +101,18 @@ func BranchFullHelp() []key.Binding {
 		BranchKeys.FastForward,
 		BranchKeys.Push,
 		BranchKeys.ForcePush,
+		BranchKeys.ForcePushLease,
+		BranchKeys.Rebase,
+		BranchKeys.ContinueRebase,
+		BranchKeys.AbortRebase,
 		BranchKeys.New,
 		BranchKeys.CreatePr,
 		BranchKeys.Delete,
 		BranchKeys.UpdatePr,
 		BranchKeys.ViewPRs,
+		BranchKeys.OpenShell,
+		BranchKeys.OpenEditor,
+		BranchKeys.PruneWorktrees,
 	}
 }
 
@@ -119,6 +161,20 @@ func rebindBranchKeys(keys []config.Keybinding) error {
 			key = &BranchKeys.ViewPRs
 		case "updatePr":
 			key = &BranchKeys.UpdatePr
+		case "openShell":
+			key = &BranchKeys.OpenShell
+		case "openEditor":
+			key = &BranchKeys.OpenEditor
+		case "pruneWorktrees":
+			key = &BranchKeys.PruneWorktrees
+		case "rebase":
+			key = &BranchKeys.Rebase
+		case "forcePushWithLease":
+			key = &BranchKeys.ForcePushLease
+		case "continueRebase":
+			key = &BranchKeys.ContinueRebase
+		case "abortRebase":
+			key = &BranchKeys.AbortRebase
 		default:
 			return fmt.Errorf("unknown built-in branch key: '%s'", branchKey.Builtin)
 		}
@@ -0,0 +1,121 @@
+package keys
+
+import (
+	"fmt"
+
+	"charm.land/bubbles/v2/key"
+	log "charm.land/log/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+)
+
+type DiscussionKeyMap struct {
+	Comment              key.Binding
+	PrevComment          key.Binding
+	NextComment          key.Binding
+	MarkAnswer           key.Binding
+	UnmarkAnswer         key.Binding
+	ToggleSmartFiltering key.Binding
+	ViewNotifications    key.Binding
+}
+
+var DiscussionKeys = DiscussionKeyMap{
+	Comment: key.NewBinding(
+		key.WithKeys("c"),
+		key.WithHelp("c", "comment"),
+	),
+	PrevComment: key.NewBinding(
+		key.WithKeys("{"),
+		key.WithHelp("{", "previous comment"),
+	),
+	NextComment: key.NewBinding(
+		key.WithKeys("}"),
+		key.WithHelp("}", "next comment"),
+	),
+	MarkAnswer: key.NewBinding(
+		key.WithKeys("a"),
+		key.WithHelp("a", "mark comment as answer"),
+	),
+	UnmarkAnswer: key.NewBinding(
+		key.WithKeys("A"),
+		key.WithHelp("A", "unmark answer"),
+	),
+	ToggleSmartFiltering: key.NewBinding(
+		key.WithKeys("t"),
+		key.WithHelp("t", "toggle smart filtering"),
+	),
+	ViewNotifications: key.NewBinding(
+		key.WithKeys("s"),
+		key.WithHelp("s", "switch to notifications"),
+	),
+}
+
+func DiscussionFullHelp() []key.Binding {
+	return []key.Binding{
+		DiscussionKeys.Comment,
+		DiscussionKeys.PrevComment,
+		DiscussionKeys.NextComment,
+		DiscussionKeys.MarkAnswer,
+		DiscussionKeys.UnmarkAnswer,
+		DiscussionKeys.ToggleSmartFiltering,
+		DiscussionKeys.ViewNotifications,
+	}
+}
+
+func rebindDiscussionKeys(keys []config.Keybinding) error {
+	CustomDiscussionBindings = []key.Binding{}
+
+	for _, discussionKey := range keys {
+		if discussionKey.Builtin == "" {
+			// Handle custom commands
+			if discussionKey.Command != "" {
+				name := discussionKey.Name
+				if discussionKey.Name == "" {
+					name = config.TruncateCommand(discussionKey.Command)
+				}
+
+				customBinding := key.NewBinding(
+					key.WithKeys(discussionKey.Key),
+					key.WithHelp(discussionKey.Key, name),
+				)
+
+				CustomDiscussionBindings = append(CustomDiscussionBindings, customBinding)
+			}
+			continue
+		}
+
+		log.Debug("Rebinding discussion key",
+			"builtin", discussionKey.Builtin, "key", discussionKey.Key)
+
+		var key *key.Binding
+
+		switch discussionKey.Builtin {
+		case "comment":
+			key = &DiscussionKeys.Comment
+		case "prevComment":
+			key = &DiscussionKeys.PrevComment
+		case "nextComment":
+			key = &DiscussionKeys.NextComment
+		case "markAnswer":
+			key = &DiscussionKeys.MarkAnswer
+		case "unmarkAnswer":
+			key = &DiscussionKeys.UnmarkAnswer
+		case "toggleSmartFiltering":
+			key = &DiscussionKeys.ToggleSmartFiltering
+		case "viewNotifications":
+			key = &DiscussionKeys.ViewNotifications
+		default:
+			return fmt.Errorf("unknown built-in discussion key: '%s'", discussionKey.Builtin)
+		}
+
+		key.SetKeys(discussionKey.Key)
+
+		helpDesc := key.Help().Desc
+		if discussionKey.Name != "" {
+			helpDesc = discussionKey.Name
+		}
+		key.SetHelp(discussionKey.Key, helpDesc)
+	}
+
+	return nil
+}
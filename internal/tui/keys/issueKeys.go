@@ -11,13 +11,25 @@ import (
 
 type IssueKeyMap struct {
 	Label                key.Binding
+	EditProjectField     key.Binding
+	Milestone            key.Binding
 	Assign               key.Binding
 	Unassign             key.Binding
 	Comment              key.Binding
+	Edit                 key.Binding
+	React                key.Binding
+	TimelineAction       key.Binding
+	PrevComment          key.Binding
+	NextComment          key.Binding
 	Checkout             key.Binding
 	Close                key.Binding
 	Reopen               key.Binding
+	New                  key.Binding
 	ToggleSmartFiltering key.Binding
+	SortBy               key.Binding
+	ReverseSort          key.Binding
+	GroupBy              key.Binding
+	ToggleGroup          key.Binding
 	ViewPRs              key.Binding
 }
 
@@ -26,6 +38,14 @@ var IssueKeys = IssueKeyMap{
 		key.WithKeys("L"),
 		key.WithHelp("L", "label"),
 	),
+	EditProjectField: key.NewBinding(
+		key.WithKeys("b"),
+		key.WithHelp("b", "set project field"),
+	),
+	Milestone: key.NewBinding(
+		key.WithKeys("M"),
+		key.WithHelp("M", "set milestone"),
+	),
 	Assign: key.NewBinding(
 		key.WithKeys("a"),
 		key.WithHelp("a", "assign"),
@@ -38,6 +58,26 @@ var IssueKeys = IssueKeyMap{
 		key.WithKeys("c"),
 		key.WithHelp("c", "comment"),
 	),
+	Edit: key.NewBinding(
+		key.WithKeys("ctrl+e"),
+		key.WithHelp("ctrl+e", "edit title/body"),
+	),
+	React: key.NewBinding(
+		key.WithKeys("+"),
+		key.WithHelp("+", "react"),
+	),
+	TimelineAction: key.NewBinding(
+		key.WithKeys("."),
+		key.WithHelp(".", "act on timeline entry"),
+	),
+	PrevComment: key.NewBinding(
+		key.WithKeys("{"),
+		key.WithHelp("{", "previous timeline entry"),
+	),
+	NextComment: key.NewBinding(
+		key.WithKeys("}"),
+		key.WithHelp("}", "next timeline entry"),
+	),
 	Checkout: key.NewBinding(
 		key.WithKeys("C"),
 		key.WithHelp("C", "checkout"),
@@ -50,10 +90,30 @@ var IssueKeys = IssueKeyMap{
 		key.WithKeys("X"),
 		key.WithHelp("X", "reopen"),
 	),
+	New: key.NewBinding(
+		key.WithKeys("n"),
+		key.WithHelp("n", "new issue"),
+	),
 	ToggleSmartFiltering: key.NewBinding(
 		key.WithKeys("t"),
 		key.WithHelp("t", "toggle smart filtering"),
 	),
+	SortBy: key.NewBinding(
+		key.WithKeys("S"),
+		key.WithHelp("S", "sort by next column"),
+	),
+	ReverseSort: key.NewBinding(
+		key.WithKeys("I"),
+		key.WithHelp("I", "reverse sort order"),
+	),
+	GroupBy: key.NewBinding(
+		key.WithKeys("B"),
+		key.WithHelp("B", "group by next field"),
+	),
+	ToggleGroup: key.NewBinding(
+		key.WithKeys("enter"),
+		key.WithHelp("enter", "collapse/expand group"),
+	),
 	ViewPRs: key.NewBinding(
 		key.WithKeys("s"),
 		key.WithHelp("s", "switch to notifications"),
@@ -63,13 +123,25 @@ var IssueKeys = IssueKeyMap{
 func IssueFullHelp() []key.Binding {
 	return []key.Binding{
 		IssueKeys.Label,
+		IssueKeys.EditProjectField,
+		IssueKeys.Milestone,
 		IssueKeys.Assign,
 		IssueKeys.Unassign,
 		IssueKeys.Comment,
+		IssueKeys.Edit,
+		IssueKeys.React,
+		IssueKeys.TimelineAction,
+		IssueKeys.PrevComment,
+		IssueKeys.NextComment,
 		IssueKeys.Checkout,
 		IssueKeys.Close,
 		IssueKeys.Reopen,
+		IssueKeys.New,
 		IssueKeys.ToggleSmartFiltering,
+		IssueKeys.SortBy,
+		IssueKeys.ReverseSort,
+		IssueKeys.GroupBy,
+		IssueKeys.ToggleGroup,
 		IssueKeys.ViewPRs,
 	}
 }
@@ -103,18 +175,42 @@ func rebindIssueKeys(keys []config.Keybinding) error {
 		switch issueKey.Builtin {
 		case "label":
 			key = &IssueKeys.Label
+		case "editProjectField":
+			key = &IssueKeys.EditProjectField
+		case "milestone":
+			key = &IssueKeys.Milestone
 		case "assign":
 			key = &IssueKeys.Assign
 		case "unassign":
 			key = &IssueKeys.Unassign
 		case "comment":
 			key = &IssueKeys.Comment
+		case "edit":
+			key = &IssueKeys.Edit
+		case "react":
+			key = &IssueKeys.React
+		case "timelineAction":
+			key = &IssueKeys.TimelineAction
+		case "prevComment":
+			key = &IssueKeys.PrevComment
+		case "nextComment":
+			key = &IssueKeys.NextComment
 		case "checkout":
 			key = &IssueKeys.Checkout
 		case "close":
 			key = &IssueKeys.Close
 		case "reopen":
 			key = &IssueKeys.Reopen
+		case "new":
+			key = &IssueKeys.New
+		case "sortBy":
+			key = &IssueKeys.SortBy
+		case "reverseSort":
+			key = &IssueKeys.ReverseSort
+		case "groupBy":
+			key = &IssueKeys.GroupBy
+		case "toggleGroup":
+			key = &IssueKeys.ToggleGroup
 		case "viewPrs":
 			key = &IssueKeys.ViewPRs
 		default:
@@ -46,6 +46,8 @@ type KeyMap struct {
 	Search                key.Binding
 	CopyUrl               key.Binding
 	CopyNumber            key.Binding
+	ToggleSelection       key.Binding
+	ClearSelection        key.Binding
 	Help                  key.Binding
 	Quit                  key.Binding
 }
@@ -74,6 +76,15 @@ func (k KeyMap) FullHelp() [][]key.Binding {
 	case config.RepoView:
 		additionalKeys = BranchFullHelp()
 		customKeys = append(customKeys, CustomBranchBindings...)
+	case config.DiscussionsView:
+		additionalKeys = DiscussionFullHelp()
+		customKeys = append(customKeys, CustomDiscussionBindings...)
+	case config.ReleasesView:
+		additionalKeys = ReleaseFullHelp()
+		customKeys = append(customKeys, CustomReleaseBindings...)
+	case config.ProjectsView:
+		additionalKeys = ProjectFullHelp()
+		customKeys = append(customKeys, CustomProjectBindings...)
 	case config.NotificationsView:
 		additionalKeys = NotificationFullHelp()
 		customKeys = append(customKeys, CustomNotificationBindings...)
@@ -129,6 +140,8 @@ func (k KeyMap) AppKeys() []key.Binding {
 		k.CopyNumber,
 		k.CopyUrl,
 		k.Search,
+		k.ToggleSelection,
+		k.ClearSelection,
 	}
 }
 
@@ -201,6 +214,14 @@ var Keys = &KeyMap{
 		key.WithKeys("Y"),
 		key.WithHelp("Y", "copy url"),
 	),
+	ToggleSelection: key.NewBinding(
+		key.WithKeys("tab"),
+		key.WithHelp("tab", "select/unselect row"),
+	),
+	ClearSelection: key.NewBinding(
+		key.WithKeys("esc"),
+		key.WithHelp("esc", "clear selection"),
+	),
 	Help: key.NewBinding(
 		key.WithKeys("?"),
 		key.WithHelp("?", "help"),
@@ -213,7 +234,8 @@ var Keys = &KeyMap{
 
 // Rebind will update our saved keybindings from configuration values.
 func Rebind(
-	universal, issueKeys, prKeys, branchKeys, notificationKeys, cmpKeys []config.Keybinding,
+	universal, issueKeys, prKeys, branchKeys, notificationKeys, discussionKeys, releaseKeys,
+	projectKeys, cmpKeys []config.Keybinding,
 ) error {
 	err := rebindUniversal(universal)
 	if err != nil {
@@ -235,6 +257,21 @@ func Rebind(
 		return err
 	}
 
+	err = rebindDiscussionKeys(discussionKeys)
+	if err != nil {
+		return err
+	}
+
+	err = rebindReleaseKeys(releaseKeys)
+	if err != nil {
+		return err
+	}
+
+	err = rebindProjectKeys(projectKeys)
+	if err != nil {
+		return err
+	}
+
 	err = rebindCmpKeys(cmpKeys)
 	if err != nil {
 		return err
@@ -250,6 +287,9 @@ var (
 	CustomIssueBindings        []key.Binding
 	CustomBranchBindings       []key.Binding
 	CustomNotificationBindings []key.Binding
+	CustomDiscussionBindings   []key.Binding
+	CustomReleaseBindings      []key.Binding
+	CustomProjectBindings      []key.Binding
 	CustomCmpBindings          []key.Binding
 )
 
@@ -316,6 +356,10 @@ func rebindUniversal(universal []config.Keybinding) error {
 			key = &Keys.CopyUrl
 		case "copyNumber":
 			key = &Keys.CopyNumber
+		case "toggleSelection":
+			key = &Keys.ToggleSelection
+		case "clearSelection":
+			key = &Keys.ClearSelection
 		case "help":
 			key = &Keys.Help
 		case "quit":
@@ -13,11 +13,28 @@ type PRKeyMap struct {
 	PrevSidebarTab       key.Binding
 	NextSidebarTab       key.Binding
 	Approve              key.Binding
+	Review               key.Binding
 	Assign               key.Binding
 	Unassign             key.Binding
+	RequestReviews       key.Binding
+	RemoveReviewRequests key.Binding
 	Label                key.Binding
+	EditProjectField     key.Binding
+	Milestone            key.Binding
 	Comment              key.Binding
+	Edit                 key.Binding
+	React                key.Binding
+	TimelineAction       key.Binding
 	Diff                 key.Binding
+	PrevDiffFile         key.Binding
+	NextDiffFile         key.Binding
+	PrevDiffHunk         key.Binding
+	NextDiffHunk         key.Binding
+	ResolveThread        key.Binding
+	ToggleOutdated       key.Binding
+	ViewCheckLog         key.Binding
+	RerunFailedJobs      key.Binding
+	CancelRun            key.Binding
 	Checkout             key.Binding
 	Close                key.Binding
 	SummaryViewMore      key.Binding
@@ -27,7 +44,14 @@ type PRKeyMap struct {
 	Update               key.Binding
 	WatchChecks          key.Binding
 	ApproveWorkflows     key.Binding
+	RerunFailedWorkflows key.Binding
+	RerunAllWorkflows    key.Binding
+	CancelWorkflows      key.Binding
 	ToggleSmartFiltering key.Binding
+	SortBy               key.Binding
+	ReverseSort          key.Binding
+	GroupBy              key.Binding
+	ToggleGroup          key.Binding
 	ViewIssues           key.Binding
 }
 
@@ -44,6 +68,10 @@ var PRKeys = PRKeyMap{
 		key.WithKeys("v"),
 		key.WithHelp("v", "approve"),
 	),
+	Review: key.NewBinding(
+		key.WithKeys("f"),
+		key.WithHelp("f", "submit review"),
+	),
 	Assign: key.NewBinding(
 		key.WithKeys("a"),
 		key.WithHelp("a", "assign"),
@@ -52,18 +80,82 @@ var PRKeys = PRKeyMap{
 		key.WithKeys("A"),
 		key.WithHelp("A", "unassign"),
 	),
+	RequestReviews: key.NewBinding(
+		key.WithKeys("i"),
+		key.WithHelp("i", "request reviews"),
+	),
+	RemoveReviewRequests: key.NewBinding(
+		key.WithKeys("alt+i"),
+		key.WithHelp("alt+i", "remove review requests"),
+	),
 	Label: key.NewBinding(
 		key.WithKeys("L"),
 		key.WithHelp("L", "label"),
 	),
+	EditProjectField: key.NewBinding(
+		key.WithKeys("b"),
+		key.WithHelp("b", "set project field"),
+	),
+	Milestone: key.NewBinding(
+		key.WithKeys("M"),
+		key.WithHelp("M", "set milestone"),
+	),
 	Comment: key.NewBinding(
 		key.WithKeys("c"),
 		key.WithHelp("c", "comment"),
 	),
+	Edit: key.NewBinding(
+		key.WithKeys("ctrl+e"),
+		key.WithHelp("ctrl+e", "edit title/body"),
+	),
+	React: key.NewBinding(
+		key.WithKeys("+"),
+		key.WithHelp("+", "react"),
+	),
+	TimelineAction: key.NewBinding(
+		key.WithKeys("."),
+		key.WithHelp(".", "act on timeline entry"),
+	),
 	Diff: key.NewBinding(
 		key.WithKeys("d"),
 		key.WithHelp("d", "diff"),
 	),
+	PrevDiffFile: key.NewBinding(
+		key.WithKeys("("),
+		key.WithHelp("(", "previous file"),
+	),
+	NextDiffFile: key.NewBinding(
+		key.WithKeys(")"),
+		key.WithHelp(")", "next file"),
+	),
+	PrevDiffHunk: key.NewBinding(
+		key.WithKeys("{"),
+		key.WithHelp("{", "previous hunk/thread/entry"),
+	),
+	NextDiffHunk: key.NewBinding(
+		key.WithKeys("}"),
+		key.WithHelp("}", "next hunk/thread/entry"),
+	),
+	ResolveThread: key.NewBinding(
+		key.WithKeys("z"),
+		key.WithHelp("z", "resolve/unresolve thread"),
+	),
+	ToggleOutdated: key.NewBinding(
+		key.WithKeys("O"),
+		key.WithHelp("O", "show/hide outdated threads"),
+	),
+	ViewCheckLog: key.NewBinding(
+		key.WithKeys("E"),
+		key.WithHelp("E", "show/hide check log"),
+	),
+	RerunFailedJobs: key.NewBinding(
+		key.WithKeys("F"),
+		key.WithHelp("F", "re-run failed jobs"),
+	),
+	CancelRun: key.NewBinding(
+		key.WithKeys("K"),
+		key.WithHelp("K", "cancel workflow run"),
+	),
 	Checkout: key.NewBinding(
 		key.WithKeys("C", "space"),
 		key.WithHelp("C/Space", "checkout"),
@@ -100,10 +192,38 @@ var PRKeys = PRKeyMap{
 		key.WithKeys("V"),
 		key.WithHelp("V", "approve all workflows"),
 	),
+	RerunFailedWorkflows: key.NewBinding(
+		key.WithKeys("ctrl+r"),
+		key.WithHelp("ctrl+r", "re-run failed workflows"),
+	),
+	RerunAllWorkflows: key.NewBinding(
+		key.WithKeys("alt+r"),
+		key.WithHelp("alt+r", "re-run all workflows"),
+	),
+	CancelWorkflows: key.NewBinding(
+		key.WithKeys("ctrl+x"),
+		key.WithHelp("ctrl+x", "cancel running workflows"),
+	),
 	ToggleSmartFiltering: key.NewBinding(
 		key.WithKeys("t"),
 		key.WithHelp("t", "toggle smart filtering"),
 	),
+	SortBy: key.NewBinding(
+		key.WithKeys("S"),
+		key.WithHelp("S", "sort by next column"),
+	),
+	ReverseSort: key.NewBinding(
+		key.WithKeys("I"),
+		key.WithHelp("I", "reverse sort order"),
+	),
+	GroupBy: key.NewBinding(
+		key.WithKeys("B"),
+		key.WithHelp("B", "group by next field"),
+	),
+	ToggleGroup: key.NewBinding(
+		key.WithKeys("enter"),
+		key.WithHelp("enter", "collapse/expand group"),
+	),
 	ViewIssues: key.NewBinding(
 		key.WithKeys("s"),
 		key.WithHelp("s", "switch to issues"),
@@ -115,11 +235,28 @@ func PRFullHelp() []key.Binding {
 		PRKeys.PrevSidebarTab,
 		PRKeys.NextSidebarTab,
 		PRKeys.Approve,
+		PRKeys.Review,
 		PRKeys.Assign,
 		PRKeys.Unassign,
+		PRKeys.RequestReviews,
+		PRKeys.RemoveReviewRequests,
 		PRKeys.Label,
+		PRKeys.EditProjectField,
+		PRKeys.Milestone,
 		PRKeys.Comment,
+		PRKeys.Edit,
+		PRKeys.React,
+		PRKeys.TimelineAction,
 		PRKeys.Diff,
+		PRKeys.PrevDiffFile,
+		PRKeys.NextDiffFile,
+		PRKeys.PrevDiffHunk,
+		PRKeys.NextDiffHunk,
+		PRKeys.ResolveThread,
+		PRKeys.ToggleOutdated,
+		PRKeys.ViewCheckLog,
+		PRKeys.RerunFailedJobs,
+		PRKeys.CancelRun,
 		PRKeys.Checkout,
 		PRKeys.Close,
 		PRKeys.Ready,
@@ -128,7 +265,14 @@ func PRFullHelp() []key.Binding {
 		PRKeys.Update,
 		PRKeys.WatchChecks,
 		PRKeys.ApproveWorkflows,
+		PRKeys.RerunFailedWorkflows,
+		PRKeys.RerunAllWorkflows,
+		PRKeys.CancelWorkflows,
 		PRKeys.ToggleSmartFiltering,
+		PRKeys.SortBy,
+		PRKeys.ReverseSort,
+		PRKeys.GroupBy,
+		PRKeys.ToggleGroup,
 		PRKeys.ViewIssues,
 	}
 }
@@ -166,16 +310,50 @@ func rebindPRKeys(keys []config.Keybinding) error {
 			key = &PRKeys.NextSidebarTab
 		case "approve":
 			key = &PRKeys.Approve
+		case "review":
+			key = &PRKeys.Review
 		case "assign":
 			key = &PRKeys.Assign
 		case "unassign":
 			key = &PRKeys.Unassign
+		case "requestReviews":
+			key = &PRKeys.RequestReviews
+		case "removeReviewRequests":
+			key = &PRKeys.RemoveReviewRequests
 		case "label":
 			key = &PRKeys.Label
+		case "editProjectField":
+			key = &PRKeys.EditProjectField
+		case "milestone":
+			key = &PRKeys.Milestone
 		case "comment":
 			key = &PRKeys.Comment
+		case "edit":
+			key = &PRKeys.Edit
+		case "react":
+			key = &PRKeys.React
+		case "timelineAction":
+			key = &PRKeys.TimelineAction
 		case "diff":
 			key = &PRKeys.Diff
+		case "prevDiffFile":
+			key = &PRKeys.PrevDiffFile
+		case "nextDiffFile":
+			key = &PRKeys.NextDiffFile
+		case "prevDiffHunk":
+			key = &PRKeys.PrevDiffHunk
+		case "nextDiffHunk":
+			key = &PRKeys.NextDiffHunk
+		case "resolveThread":
+			key = &PRKeys.ResolveThread
+		case "toggleOutdated":
+			key = &PRKeys.ToggleOutdated
+		case "viewCheckLog":
+			key = &PRKeys.ViewCheckLog
+		case "rerunFailedJobs":
+			key = &PRKeys.RerunFailedJobs
+		case "cancelRun":
+			key = &PRKeys.CancelRun
 		case "checkout":
 			key = &PRKeys.Checkout
 		case "close":
@@ -192,6 +370,20 @@ func rebindPRKeys(keys []config.Keybinding) error {
 			key = &PRKeys.WatchChecks
 		case "approveWorkflows":
 			key = &PRKeys.ApproveWorkflows
+		case "rerunFailedWorkflows":
+			key = &PRKeys.RerunFailedWorkflows
+		case "rerunAllWorkflows":
+			key = &PRKeys.RerunAllWorkflows
+		case "cancelWorkflows":
+			key = &PRKeys.CancelWorkflows
+		case "sortBy":
+			key = &PRKeys.SortBy
+		case "reverseSort":
+			key = &PRKeys.ReverseSort
+		case "groupBy":
+			key = &PRKeys.GroupBy
+		case "toggleGroup":
+			key = &PRKeys.ToggleGroup
 		case "viewIssues":
 			key = &PRKeys.ViewIssues
 		case "summaryViewMore":
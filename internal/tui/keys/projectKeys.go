@@ -0,0 +1,112 @@
+package keys
+
+import (
+	"fmt"
+
+	"charm.land/bubbles/v2/key"
+	log "charm.land/log/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+)
+
+type ProjectKeyMap struct {
+	PrevColumn           key.Binding
+	NextColumn           key.Binding
+	MoveLeft             key.Binding
+	MoveRight            key.Binding
+	ToggleSmartFiltering key.Binding
+	ViewNotifications    key.Binding
+}
+
+var ProjectKeys = ProjectKeyMap{
+	PrevColumn: key.NewBinding(
+		key.WithKeys("H"),
+		key.WithHelp("H", "previous column"),
+	),
+	NextColumn: key.NewBinding(
+		key.WithKeys("L"),
+		key.WithHelp("L", "next column"),
+	),
+	MoveLeft: key.NewBinding(
+		key.WithKeys("<"),
+		key.WithHelp("<", "move to previous status"),
+	),
+	MoveRight: key.NewBinding(
+		key.WithKeys(">"),
+		key.WithHelp(">", "move to next status"),
+	),
+	ToggleSmartFiltering: key.NewBinding(
+		key.WithKeys("t"),
+		key.WithHelp("t", "toggle smart filtering"),
+	),
+	ViewNotifications: key.NewBinding(
+		key.WithKeys("s"),
+		key.WithHelp("s", "switch to notifications"),
+	),
+}
+
+func ProjectFullHelp() []key.Binding {
+	return []key.Binding{
+		ProjectKeys.PrevColumn,
+		ProjectKeys.NextColumn,
+		ProjectKeys.MoveLeft,
+		ProjectKeys.MoveRight,
+		ProjectKeys.ToggleSmartFiltering,
+		ProjectKeys.ViewNotifications,
+	}
+}
+
+func rebindProjectKeys(keys []config.Keybinding) error {
+	CustomProjectBindings = []key.Binding{}
+
+	for _, projectKey := range keys {
+		if projectKey.Builtin == "" {
+			// Handle custom commands
+			if projectKey.Command != "" {
+				name := projectKey.Name
+				if projectKey.Name == "" {
+					name = config.TruncateCommand(projectKey.Command)
+				}
+
+				customBinding := key.NewBinding(
+					key.WithKeys(projectKey.Key),
+					key.WithHelp(projectKey.Key, name),
+				)
+
+				CustomProjectBindings = append(CustomProjectBindings, customBinding)
+			}
+			continue
+		}
+
+		log.Debug("Rebinding project key", "builtin", projectKey.Builtin, "key", projectKey.Key)
+
+		var key *key.Binding
+
+		switch projectKey.Builtin {
+		case "prevColumn":
+			key = &ProjectKeys.PrevColumn
+		case "nextColumn":
+			key = &ProjectKeys.NextColumn
+		case "moveLeft":
+			key = &ProjectKeys.MoveLeft
+		case "moveRight":
+			key = &ProjectKeys.MoveRight
+		case "toggleSmartFiltering":
+			key = &ProjectKeys.ToggleSmartFiltering
+		case "viewNotifications":
+			key = &ProjectKeys.ViewNotifications
+		default:
+			return fmt.Errorf("unknown built-in project key: '%s'", projectKey.Builtin)
+		}
+
+		key.SetKeys(projectKey.Key)
+
+		helpDesc := key.Help().Desc
+		if projectKey.Name != "" {
+			helpDesc = projectKey.Name
+		}
+		key.SetHelp(projectKey.Key, helpDesc)
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package keys
+
+import (
+	"fmt"
+
+	"charm.land/bubbles/v2/key"
+	log "charm.land/log/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+)
+
+type ReleaseKeyMap struct {
+	Publish              key.Binding
+	CreateFromTag        key.Binding
+	ToggleSmartFiltering key.Binding
+	ViewNotifications    key.Binding
+}
+
+var ReleaseKeys = ReleaseKeyMap{
+	Publish: key.NewBinding(
+		key.WithKeys("W"),
+		key.WithHelp("W", "publish draft"),
+	),
+	CreateFromTag: key.NewBinding(
+		key.WithKeys("n"),
+		key.WithHelp("n", "create release from tag"),
+	),
+	ToggleSmartFiltering: key.NewBinding(
+		key.WithKeys("t"),
+		key.WithHelp("t", "toggle smart filtering"),
+	),
+	ViewNotifications: key.NewBinding(
+		key.WithKeys("s"),
+		key.WithHelp("s", "switch to notifications"),
+	),
+}
+
+func ReleaseFullHelp() []key.Binding {
+	return []key.Binding{
+		ReleaseKeys.Publish,
+		ReleaseKeys.CreateFromTag,
+		ReleaseKeys.ToggleSmartFiltering,
+		ReleaseKeys.ViewNotifications,
+	}
+}
+
+func rebindReleaseKeys(keys []config.Keybinding) error {
+	CustomReleaseBindings = []key.Binding{}
+
+	for _, releaseKey := range keys {
+		if releaseKey.Builtin == "" {
+			// Handle custom commands
+			if releaseKey.Command != "" {
+				name := releaseKey.Name
+				if releaseKey.Name == "" {
+					name = config.TruncateCommand(releaseKey.Command)
+				}
+
+				customBinding := key.NewBinding(
+					key.WithKeys(releaseKey.Key),
+					key.WithHelp(releaseKey.Key, name),
+				)
+
+				CustomReleaseBindings = append(CustomReleaseBindings, customBinding)
+			}
+			continue
+		}
+
+		log.Debug("Rebinding release key", "builtin", releaseKey.Builtin, "key", releaseKey.Key)
+
+		var key *key.Binding
+
+		switch releaseKey.Builtin {
+		case "publish":
+			key = &ReleaseKeys.Publish
+		case "createFromTag":
+			key = &ReleaseKeys.CreateFromTag
+		case "toggleSmartFiltering":
+			key = &ReleaseKeys.ToggleSmartFiltering
+		case "viewNotifications":
+			key = &ReleaseKeys.ViewNotifications
+		default:
+			return fmt.Errorf("unknown built-in release key: '%s'", releaseKey.Builtin)
+		}
+
+		key.SetKeys(releaseKey.Key)
+
+		helpDesc := key.Help().Desc
+		if releaseKey.Name != "" {
+			helpDesc = releaseKey.Name
+		}
+		key.SetHelp(releaseKey.Key, helpDesc)
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"maps"
 	"reflect"
+	"strings"
 	"text/template"
 	"time"
 
@@ -20,6 +21,7 @@ import (
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/notificationrow"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prrow"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/markdown"
@@ -101,6 +103,44 @@ func (m *Model) executeKeybinding(key string) tea.Cmd {
 				return m.runCustomPRCommand(keybinding.Command, data)
 			}
 		}
+	case config.DiscussionsView:
+		for _, keybinding := range m.ctx.Config.Keybindings.Discussions {
+			if keybinding.Key != key || keybinding.Command == "" {
+				continue
+			}
+
+			log.Debug("executing keybind", "key", keybinding.Key, "command", keybinding.Command)
+
+			switch data := currRowData.(type) {
+			case *data.DiscussionData:
+				return m.runCustomDiscussionCommand(keybinding.Command, data)
+			}
+		}
+	case config.ReleasesView:
+		for _, keybinding := range m.ctx.Config.Keybindings.Releases {
+			if keybinding.Key != key || keybinding.Command == "" {
+				continue
+			}
+
+			log.Debug("executing keybind", "key", keybinding.Key, "command", keybinding.Command)
+
+			switch data := currRowData.(type) {
+			case *data.ReleaseData:
+				return m.runCustomReleaseCommand(keybinding.Command, data)
+			}
+		}
+	case config.ProjectsView:
+		for _, keybinding := range m.ctx.Config.Keybindings.Projects {
+			if keybinding.Key != key || keybinding.Command == "" {
+				continue
+			}
+
+			log.Debug("executing keybind", "key", keybinding.Key, "command", keybinding.Command)
+
+			if currRowData != nil {
+				return m.runCustomProjectCommand(keybinding.Command, currRowData)
+			}
+		}
 	case config.RepoView:
 		for _, keybinding := range m.ctx.Config.Keybindings.Branches {
 			if keybinding.Key != key || keybinding.Command == "" {
@@ -171,7 +211,8 @@ func (m *Model) executeKeybinding(key string) tea.Cmd {
 }
 
 // resolveTemplateInput builds the input map for a keybinding command template.
-// It merges context-specific data and resolves RepoPath via the repoPaths config mapping.
+// It merges context-specific data and resolves RepoPath via the repoPaths config mapping, or
+// where the repo was cloned to on demand.
 // ctxRepoPath is the path of the repo gh-dash was started from (may be empty).
 func resolveTemplateInput(
 	contextData *map[string]any,
@@ -190,7 +231,7 @@ func resolveTemplateInput(
 
 	// Append in the local RepoPath only if it can be found
 	if input["RepoName"] != nil {
-		if repoPath, ok := common.GetRepoLocalPath(
+		if repoPath, ok := common.FindRepoLocalPath(
 			input["RepoName"].(string),
 			repoPaths,
 		); ok {
@@ -212,6 +253,20 @@ func resolveTemplateInput(
 func (m *Model) runCustomCommand(commandTemplate string, contextData *map[string]any) tea.Cmd {
 	input := resolveTemplateInput(contextData, m.ctx.Config.RepoPaths, m.ctx.RepoPath)
 
+	// Clone the repo first when the command needs the path of a repo that has none
+	if repoName, ok := input["RepoName"].(string); ok && m.ctx.Config.Clone.Enabled &&
+		strings.Contains(commandTemplate, ".RepoPath") {
+		if _, found := common.FindRepoLocalPath(repoName, m.ctx.Config.RepoPaths); !found {
+			cmd, err := tasks.CloneRepo(m.ctx, repoName, func(string) tea.Cmd {
+				return m.runCustomCommand(commandTemplate, contextData)
+			})
+			if err != nil {
+				return func() tea.Msg { return constants.ErrMsg{Err: err} }
+			}
+			return cmd
+		}
+	}
+
 	cmd, err := template.New("keybinding_command").Parse(commandTemplate)
 	if err != nil {
 		log.Fatal("Failed parse keybinding template", "error", err)
@@ -253,6 +308,47 @@ func (m *Model) runCustomIssueCommand(commandTemplate string, issueData *data.Is
 	)
 }
 
+func (m *Model) runCustomDiscussionCommand(
+	commandTemplate string,
+	discussionData *data.DiscussionData,
+) tea.Cmd {
+	return m.runCustomCommand(commandTemplate,
+		&map[string]any{
+			"RepoName":         discussionData.GetRepoNameWithOwner(),
+			"DiscussionNumber": discussionData.Number,
+			"DiscussionTitle":  discussionData.Title,
+			"Author":           discussionData.Author.Login,
+		},
+	)
+}
+
+func (m *Model) runCustomReleaseCommand(
+	commandTemplate string,
+	releaseData *data.ReleaseData,
+) tea.Cmd {
+	return m.runCustomCommand(commandTemplate,
+		&map[string]any{
+			"RepoName":    releaseData.GetRepoNameWithOwner(),
+			"TagName":     releaseData.TagName,
+			"ReleaseName": releaseData.GetTitle(),
+			"Author":      releaseData.Author.Login,
+		},
+	)
+}
+
+// runCustomProjectCommand runs a command for the current item of a board, which is either the
+// item itself or its issue or PR once it was fetched.
+func (m *Model) runCustomProjectCommand(commandTemplate string, item data.RowData) tea.Cmd {
+	return m.runCustomCommand(commandTemplate,
+		&map[string]any{
+			"RepoName": item.GetRepoNameWithOwner(),
+			"Number":   item.GetNumber(),
+			"Title":    item.GetTitle(),
+			"Url":      item.GetUrl(),
+		},
+	)
+}
+
 func (m *Model) runCustomBranchCommand(commandTemplate string, branchData *prrow.Data) tea.Cmd {
 	if reflect.ValueOf(branchData).IsNil() {
 		return m.executeCustomCommand(commandTemplate)
@@ -28,15 +28,21 @@ import (
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/common"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/branch"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/branchsidebar"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/checkswatcher"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/discussionssection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/discussionview"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/footer"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/issuessection"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/issueview"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/notificationrow"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/notificationssection"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/notificationview"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/projectssection"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prrow"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prssection"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prview"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/releasessection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/releaseview"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/reposection"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/sidebar"
@@ -47,26 +53,34 @@ import (
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/markdown"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/theme"
+	"github.com/dlvhdr/gh-dash/v4/internal/watcher"
 )
 
 type Model struct {
-	keys             *keys.KeyMap
-	sidebar          sidebar.Model
-	prView           prview.Model
-	issueSidebar     issueview.Model
-	branchSidebar    branchsidebar.Model
-	notificationView notificationview.Model
-	currSectionId    int
-	footer           footer.Model
-	repo             section.Section
-	prs              []section.Section
-	issues           []section.Section
-	notifications    []section.Section
-	tabs             tabs.Model
-	ctx              *context.ProgramContext
-	taskSpinner      spinner.Model
-	tasks            map[string]context.Task
-	positionOverride string // "" means no override, "right" or "bottom"
+	keys              *keys.KeyMap
+	sidebar           sidebar.Model
+	prView            prview.Model
+	issueSidebar      issueview.Model
+	discussionSidebar discussionview.Model
+	releaseSidebar    releaseview.Model
+	branchSidebar     branchsidebar.Model
+	notificationView  notificationview.Model
+	currSectionId     int
+	footer            footer.Model
+	repo              section.Section
+	prs               []section.Section
+	issues            []section.Section
+	discussions       []section.Section
+	releases          []section.Section
+	projects          []section.Section
+	notifications     []section.Section
+	tabs              tabs.Model
+	ctx               *context.ProgramContext
+	taskSpinner       spinner.Model
+	tasks             map[string]context.Task
+	positionOverride  string // "" means no override, "right" or "bottom"
+	watcher           *watcher.Watcher
+	checksWatcher     *checkswatcher.Watcher
 }
 
 type Repositories struct {
@@ -77,10 +91,11 @@ type Repositories struct {
 func NewModel(location config.Location, repos Repositories) Model {
 	taskSpinner := spinner.Model{Spinner: spinner.Dot}
 	m := Model{
-		keys:        keys.Keys,
-		sidebar:     sidebar.NewModel(),
-		taskSpinner: taskSpinner,
-		tasks:       map[string]context.Task{},
+		keys:          keys.Keys,
+		sidebar:       sidebar.NewModel(),
+		taskSpinner:   taskSpinner,
+		tasks:         map[string]context.Task{},
+		checksWatcher: checkswatcher.New(),
 	}
 
 	version := "dev"
@@ -107,7 +122,10 @@ func NewModel(location config.Location, repos Repositories) Model {
 
 	m.footer = footer.NewModel(m.ctx)
 	m.prView = prview.NewModel(m.ctx)
+	m.prView.SetChecksWatcher(m.checksWatcher)
 	m.issueSidebar = issueview.NewModel(m.ctx)
+	m.discussionSidebar = discussionview.NewModel(m.ctx)
+	m.releaseSidebar = releaseview.NewModel(m.ctx)
 	m.branchSidebar = branchsidebar.NewModel(m.ctx)
 	m.notificationView = notificationview.NewModel(m.ctx)
 	m.tabs = tabs.NewModel(m.ctx)
@@ -147,6 +165,7 @@ func (m *Model) initScreen() tea.Msg {
 		showError(err)
 		return initMsg{Config: cfg}
 	}
+	data.SetFetchProjectItems(cfg.HasProjectFieldColumns())
 
 	var url string
 	if config.IsFeatureEnabled(config.FF_REPO_VIEW) && m.ctx.RepoPath != "" {
@@ -164,6 +183,9 @@ func (m *Model) initScreen() tea.Msg {
 		cfg.Keybindings.Prs,
 		cfg.Keybindings.Branches,
 		cfg.Keybindings.Notifications,
+		cfg.Keybindings.Discussions,
+		cfg.Keybindings.Releases,
+		cfg.Keybindings.Projects,
 		cfg.Keybindings.Cmp,
 	)
 	if err != nil {
@@ -174,7 +196,7 @@ func (m *Model) initScreen() tea.Msg {
 }
 
 func (m Model) Init() tea.Cmd {
-	return tea.Batch(tea.RequestBackgroundColor, m.initScreen)
+	return tea.Batch(tea.RequestBackgroundColor, m.initScreen, m.checksWatcher.WaitForUpdate())
 }
 
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -184,6 +206,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		sidebarCmd      tea.Cmd
 		prViewCmd       tea.Cmd
 		issueSidebarCmd tea.Cmd
+		discSidebarCmd  tea.Cmd
+		relSidebarCmd   tea.Cmd
 		footerCmd       tea.Cmd
 		cmds            []tea.Cmd
 		currSection     = m.getCurrSection()
@@ -201,20 +225,32 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, cmd
 		}
 
-		if m.prView.IsTextInputBoxFocused() {
+		if m.prView.IsTextInputBoxFocused() || m.prView.IsMergeDialogOpen() {
 			m.prView, cmd = m.prView.Update(msg)
 			m.syncSidebar()
 			return m, cmd
 		}
 
-		if m.issueSidebar.IsTextInputBoxFocused() {
+		if m.issueSidebar.IsTextInputBoxFocused() || m.issueSidebar.IsNewIssueFormOpen() {
 			m.issueSidebar, cmd, _ = m.issueSidebar.Update(msg)
 			m.syncSidebar()
 			return m, cmd
 		}
 
+		if m.discussionSidebar.IsTextInputBoxFocused() {
+			m.discussionSidebar, cmd = m.discussionSidebar.Update(msg)
+			m.syncSidebar()
+			return m, cmd
+		}
+
+		if m.releaseSidebar.IsTextInputBoxFocused() {
+			m.releaseSidebar, cmd = m.releaseSidebar.Update(msg)
+			m.syncSidebar()
+			return m, cmd
+		}
+
 		if m.footer.ShowConfirmQuit && (msg.String() == "y" || msg.String() == "enter") {
-			return m, tea.Quit
+			return m, m.quit()
 		} else if m.footer.ShowConfirmQuit {
 			m.footer.SetShowConfirmQuit(false)
 			return m, nil
@@ -286,6 +322,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case key.Matches(msg, m.keys.TogglePreview):
 			m.sidebar.IsOpen = !m.sidebar.IsOpen
 			m.syncMainContentDimensions()
+			m.syncSidebar()
+			if m.ctx.View == config.IssuesView && m.sidebar.IsOpen {
+				cmds = append(cmds, m.issueSidebar.LoadProjectItems())
+			}
 
 		case key.Matches(msg, m.keys.TogglePreviewPosition):
 			if m.sidebar.IsOpen {
@@ -303,6 +343,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case key.Matches(msg, m.keys.Refresh):
 			if currSection != nil {
 				data.ClearEnrichmentCache()
+				currSection.InvalidateCache()
 				currSection.ResetFilters()
 				currSection.ResetRows()
 				m.syncSidebar()
@@ -312,6 +353,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		case key.Matches(msg, m.keys.RefreshAll):
 			data.ClearEnrichmentCache()
+			for _, s := range m.getCurrentViewSections() {
+				if s != nil {
+					s.InvalidateCache()
+				}
+			}
 			newSections, fetchSectionsCmds := m.fetchAllViewSections()
 			m.setCurrentViewSections(newSections)
 			cmds = append(cmds, fetchSectionsCmds)
@@ -360,9 +406,20 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 			return m, cmd
 
+		case key.Matches(msg, m.keys.ToggleSelection):
+			if currSection != nil {
+				currSection.ToggleSelection()
+				currSection.NextRow()
+				cmd = m.onViewedRowChanged()
+			}
+
+		case key.Matches(msg, m.keys.ClearSelection) && currSection != nil &&
+			currSection.SelectionCount() > 0:
+			currSection.ClearSelection()
+
 		case key.Matches(msg, m.keys.Quit):
 			if !m.ctx.Config.ConfirmQuit {
-				return m, tea.Quit
+				return m, m.quit()
 			}
 
 			m.footer.SetShowConfirmQuit(true)
@@ -393,6 +450,13 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 				return m, cmd
 
+			case key.Matches(msg, keys.BranchKeys.PruneWorktrees):
+				if currSection != nil {
+					currSection.SetPromptConfirmationAction("prune_worktrees")
+					cmd = currSection.SetIsPromptConfirmationShown(true)
+				}
+				return m, cmd
+
 			case key.Matches(msg, keys.BranchKeys.ViewPRs):
 				cmds = append(cmds, m.switchSelectedView())
 			}
@@ -403,27 +467,82 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				var scmds []tea.Cmd
 				var scmd tea.Cmd
 				m.prView, scmd = m.prView.Update(msg)
-				scmds = append(scmds, scmd)
+				scmds = append(scmds, scmd, m.prView.LoadDiff())
 				m.syncSidebar()
 				return m, tea.Batch(scmds...)
 
+			case prview.IsSelectionKey(msg) && m.prView.IsSelectionTabSelected():
+				m.prView, cmd = m.prView.Update(msg)
+				m.syncSidebar()
+				m.sidebar.ScrollToLine(m.prView.SelectionOffset())
+				return m, cmd
+
+			case key.Matches(msg, keys.PRKeys.ResolveThread, keys.PRKeys.ToggleOutdated,
+				keys.PRKeys.RerunFailedJobs, keys.PRKeys.CancelRun):
+				m.prView, cmd = m.prView.Update(msg)
+				m.syncSidebar()
+				return m, cmd
+
+			case key.Matches(msg, keys.PRKeys.ViewCheckLog):
+				m.prView, cmd = m.prView.Update(msg)
+				m.syncSidebar()
+				m.sidebar.ScrollToLine(m.prView.SelectionOffset())
+				return m, cmd
+
+			case key.Matches(msg, keys.PRKeys.Diff) && m.ctx.Config.IsInlineDiff():
+				if currRowData != nil {
+					cmd = m.openSidebarForPRDiff()
+				}
+				return m, cmd
+
 			case key.Matches(msg, m.keys.OpenGithub):
 				cmds = append(cmds, m.openBrowser())
 
 			case key.Matches(msg, keys.PRKeys.Approve):
-				return m, m.openSidebarForPRInput(m.prView.SetIsApproving)
+				return m, m.openSidebarForBulkPRInput(m.prView.SetIsApproving)
+
+			case key.Matches(msg, keys.PRKeys.Review):
+				return m, m.openSidebarForPRInput(m.prView.SetIsReviewing)
 
 			case key.Matches(msg, keys.PRKeys.Assign):
-				return m, m.openSidebarForPRInput(m.prView.SetIsAssigning)
+				return m, m.openSidebarForBulkPRInput(m.prView.SetIsAssigning)
 
 			case key.Matches(msg, keys.PRKeys.Unassign):
-				return m, m.openSidebarForPRInput(m.prView.SetIsUnassigning)
+				return m, m.openSidebarForBulkPRInput(m.prView.SetIsUnassigning)
+
+			case key.Matches(msg, keys.PRKeys.RequestReviews):
+				return m, m.openSidebarForPRInput(m.prView.SetIsRequestingReviews)
+
+			case key.Matches(msg, keys.PRKeys.RemoveReviewRequests):
+				return m, m.openSidebarForPRInput(m.prView.SetIsRemovingReviewRequests)
 
 			case key.Matches(msg, keys.PRKeys.Label):
-				return m, m.openSidebarForPRInput(m.prView.SetIsLabeling)
+				return m, m.openSidebarForBulkPRInput(m.prView.SetIsLabeling)
+
+			case key.Matches(msg, keys.PRKeys.EditProjectField):
+				return m, m.openSidebarForPRInput(m.prView.SetIsEditingProjectField)
+
+			case key.Matches(msg, keys.PRKeys.Milestone):
+				return m, m.openSidebarForBulkPRInput(m.prView.SetIsSettingMilestone)
 
 			case key.Matches(msg, keys.PRKeys.Comment):
-				return m, m.openSidebarForPRInput(m.prView.SetIsCommenting)
+				if currSection != nil && currSection.SelectionCount() > 0 {
+					return m, m.openSidebarForBulkPRInput(m.prView.SetIsCommenting)
+				}
+				return m, m.openSidebarForPRComment()
+
+			case key.Matches(msg, keys.PRKeys.Edit):
+				cmd, err := m.prView.Edit()
+				if err != nil {
+					m.ctx.Error = err
+				}
+				return m, cmd
+
+			case key.Matches(msg, keys.PRKeys.React):
+				return m, m.openSidebarForPRActivityInput(m.prView.SetIsReacting)
+
+			case key.Matches(msg, keys.PRKeys.TimelineAction):
+				return m, m.openSidebarForPRActivityInput(m.prView.SetIsActingOnEntry)
 
 			case key.Matches(msg, keys.PRKeys.Close):
 				if currRowData != nil {
@@ -445,7 +564,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 			case key.Matches(msg, keys.PRKeys.Merge):
 				if currRowData != nil {
-					cmd = m.promptConfirmation(currSection, "merge")
+					cmd = m.openSidebarForPRInput(m.prView.SetIsMerging)
 				}
 				return m, cmd
 
@@ -461,9 +580,34 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 				return m, cmd
 
+			case key.Matches(msg, keys.PRKeys.RerunFailedWorkflows):
+				if currRowData != nil {
+					cmd = m.promptConfirmation(currSection, "rerunFailedWorkflows")
+				}
+				return m, cmd
+
+			case key.Matches(msg, keys.PRKeys.RerunAllWorkflows):
+				if currRowData != nil {
+					cmd = m.promptConfirmation(currSection, "rerunAllWorkflows")
+				}
+				return m, cmd
+
+			case key.Matches(msg, keys.PRKeys.CancelWorkflows):
+				if currRowData != nil {
+					cmd = m.promptConfirmation(currSection, "cancelWorkflows")
+				}
+				return m, cmd
+
 			case key.Matches(msg, keys.PRKeys.ViewIssues):
 				cmds = append(cmds, m.switchSelectedView())
 
+			case key.Matches(msg, keys.PRKeys.SortBy, keys.PRKeys.ReverseSort,
+				keys.PRKeys.GroupBy, keys.PRKeys.ToggleGroup):
+				if currSection != nil {
+					cmd = m.updateSection(currSection.GetId(), currSection.GetType(), msg)
+				}
+				return m, tea.Batch(cmd, m.onViewedRowChanged())
+
 			case key.Matches(msg, keys.PRKeys.SummaryViewMore):
 				m.prView.SetSummaryViewMore()
 				m.syncSidebar()
@@ -475,16 +619,43 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				cmds = append(cmds, m.openBrowser())
 
 			case key.Matches(msg, keys.IssueKeys.Label):
-				return m, m.openSidebarForInput(m.issueSidebar.SetIsLabeling)
+				return m, m.openSidebarForBulkIssueInput(m.issueSidebar.SetIsLabeling)
+
+			case key.Matches(msg, keys.IssueKeys.EditProjectField):
+				return m, m.openSidebarForInput(m.issueSidebar.SetIsEditingProjectField)
+
+			case key.Matches(msg, keys.IssueKeys.Milestone):
+				return m, m.openSidebarForBulkIssueInput(m.issueSidebar.SetIsSettingMilestone)
 
 			case key.Matches(msg, keys.IssueKeys.Assign):
-				return m, m.openSidebarForInput(m.issueSidebar.SetIsAssigning)
+				return m, m.openSidebarForBulkIssueInput(m.issueSidebar.SetIsAssigning)
 
 			case key.Matches(msg, keys.IssueKeys.Unassign):
-				return m, m.openSidebarForInput(m.issueSidebar.SetIsUnassigning)
+				return m, m.openSidebarForBulkIssueInput(m.issueSidebar.SetIsUnassigning)
 
 			case key.Matches(msg, keys.IssueKeys.Comment):
-				return m, m.openSidebarForInput(m.issueSidebar.SetIsCommenting)
+				return m, m.openSidebarForBulkIssueInput(m.issueSidebar.SetIsCommenting)
+
+			case key.Matches(msg, keys.IssueKeys.Edit):
+				cmd, err := m.issueSidebar.Edit()
+				if err != nil {
+					m.ctx.Error = err
+				}
+				return m, cmd
+
+			case key.Matches(msg, keys.IssueKeys.React):
+				return m, m.openSidebarForInput(m.issueSidebar.SetIsReacting)
+
+			case key.Matches(msg, keys.IssueKeys.TimelineAction):
+				return m, m.openSidebarForInput(m.issueSidebar.SetIsActingOnEntry)
+
+			case issueview.IsSelectionKey(msg):
+				if currRowData != nil {
+					m.issueSidebar, cmd, _ = m.issueSidebar.Update(msg)
+					m.syncSidebar()
+					m.sidebar.ScrollToLine(m.issueSidebar.SelectionOffset())
+				}
+				return m, cmd
 
 			case key.Matches(msg, keys.IssueKeys.Checkout):
 				cmd, err := m.issueSidebar.Checkout()
@@ -505,8 +676,93 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 				return m, cmd
 
+			case key.Matches(msg, keys.IssueKeys.New):
+				return m, m.openSidebarForInput(m.issueSidebar.SetIsCreatingIssue)
+
 			case key.Matches(msg, keys.IssueKeys.ViewPRs):
 				cmds = append(cmds, m.switchSelectedView())
+
+			case key.Matches(msg, keys.IssueKeys.SortBy, keys.IssueKeys.ReverseSort,
+				keys.IssueKeys.GroupBy, keys.IssueKeys.ToggleGroup):
+				if currSection != nil {
+					cmd = m.updateSection(currSection.GetId(), currSection.GetType(), msg)
+				}
+				return m, tea.Batch(cmd, m.onViewedRowChanged())
+			}
+		case m.ctx.View == config.DiscussionsView:
+			switch {
+			case key.Matches(msg, m.keys.OpenGithub):
+				cmds = append(cmds, m.openBrowser())
+
+			case key.Matches(msg, keys.DiscussionKeys.Comment):
+				if currRowData != nil {
+					cmd = m.openSidebarForInput(m.discussionSidebar.SetIsCommenting)
+				}
+				return m, cmd
+
+			case discussionview.IsSelectionKey(msg):
+				m.discussionSidebar, cmd = m.discussionSidebar.Update(msg)
+				m.syncSidebar()
+				m.sidebar.ScrollToLine(m.discussionSidebar.SelectionOffset())
+				return m, cmd
+
+			case key.Matches(msg, keys.DiscussionKeys.MarkAnswer,
+				keys.DiscussionKeys.UnmarkAnswer):
+				if currRowData != nil {
+					m.discussionSidebar, cmd = m.discussionSidebar.Update(msg)
+				}
+				return m, cmd
+
+			case key.Matches(msg, keys.DiscussionKeys.ViewNotifications):
+				cmds = append(cmds, m.switchSelectedView())
+			}
+		case m.ctx.View == config.ReleasesView:
+			switch {
+			case key.Matches(msg, m.keys.OpenGithub):
+				cmds = append(cmds, m.openBrowser())
+
+			case key.Matches(msg, keys.ReleaseKeys.CreateFromTag):
+				if currRowData != nil {
+					cmd = m.openSidebarForInput(m.releaseSidebar.SetIsCreatingRelease)
+				}
+				return m, cmd
+
+			case key.Matches(msg, keys.ReleaseKeys.Publish):
+				if release, ok := currRowData.(*data.ReleaseData); ok {
+					m.releaseSidebar.SetSectionId(m.currSectionId)
+					m.releaseSidebar.SetRow(release)
+					m.releaseSidebar, cmd = m.releaseSidebar.Update(msg)
+				}
+				return m, cmd
+
+			case key.Matches(msg, keys.ReleaseKeys.ViewNotifications):
+				cmds = append(cmds, m.switchSelectedView())
+			}
+		case m.ctx.View == config.ProjectsView:
+			board, ok := currSection.(*projectssection.Model)
+			if !ok {
+				break
+			}
+			switch {
+			case key.Matches(msg, m.keys.OpenGithub):
+				cmds = append(cmds, m.openBrowser())
+
+			case key.Matches(msg, keys.ProjectKeys.PrevColumn):
+				board.PrevColumn()
+				return m, m.onViewedRowChanged()
+
+			case key.Matches(msg, keys.ProjectKeys.NextColumn):
+				board.NextColumn()
+				return m, m.onViewedRowChanged()
+
+			case key.Matches(msg, keys.ProjectKeys.MoveLeft):
+				return m, board.MoveCurrItem(-1)
+
+			case key.Matches(msg, keys.ProjectKeys.MoveRight):
+				return m, board.MoveCurrItem(1)
+
+			case key.Matches(msg, keys.ProjectKeys.ViewNotifications):
+				cmds = append(cmds, m.switchSelectedView())
 			}
 		case m.ctx.View == config.NotificationsView:
 			switch {
@@ -529,23 +785,57 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				if !m.prView.IsTextInputBoxFocused() {
 					action := prview.MsgToAction(msg)
 					if action != nil {
+						m.prView.SetBulkRows(nil)
 						switch action.Type {
 						case prview.PRActionApprove:
 							return m, m.openSidebarForPRInput(m.prView.SetIsApproving)
 
+						case prview.PRActionReview:
+							return m, m.openSidebarForPRInput(m.prView.SetIsReviewing)
+
 						case prview.PRActionAssign:
 							return m, m.openSidebarForPRInput(m.prView.SetIsAssigning)
 
 						case prview.PRActionUnassign:
 							return m, m.openSidebarForPRInput(m.prView.SetIsUnassigning)
 
+						case prview.PRActionRequestReviews:
+							return m, m.openSidebarForPRInput(m.prView.SetIsRequestingReviews)
+
+						case prview.PRActionRemoveReviewRequests:
+							return m, m.openSidebarForPRInput(
+								m.prView.SetIsRemovingReviewRequests)
+
 						case prview.PRActionLabel:
 							return m, m.openSidebarForPRInput(m.prView.SetIsLabeling)
 
+						case prview.PRActionEditProjectField:
+							return m, m.openSidebarForPRInput(m.prView.SetIsEditingProjectField)
+
+						case prview.PRActionMilestone:
+							return m, m.openSidebarForPRInput(m.prView.SetIsSettingMilestone)
+
 						case prview.PRActionComment:
-							return m, m.openSidebarForPRInput(m.prView.SetIsCommenting)
+							return m, m.openSidebarForPRComment()
+
+						case prview.PRActionEdit:
+							cmd, err := m.prView.Edit()
+							if err != nil {
+								m.ctx.Error = err
+							}
+							return m, cmd
+
+						case prview.PRActionReact:
+							return m, m.openSidebarForPRActivityInput(m.prView.SetIsReacting)
+
+						case prview.PRActionTimelineAction:
+							return m, m.openSidebarForPRActivityInput(
+								m.prView.SetIsActingOnEntry)
 
 						case prview.PRActionDiff:
+							if m.ctx.Config.IsInlineDiff() {
+								return m, m.openSidebarForPRDiff()
+							}
 							if pr := m.notificationView.GetSubjectPR(); pr != nil {
 								cmd = common.DiffPR(pr.GetNumber(), pr.GetRepoNameWithOwner(),
 									m.ctx.Config.GetFullScreenDiffPagerEnv())
@@ -572,8 +862,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 							return m, cmd
 
 						case prview.PRActionMerge:
-							cmd = m.promptConfirmationForNotificationPR("merge")
-							return m, cmd
+							return m, m.openSidebarForPRInput(m.prView.SetIsMerging)
 
 						case prview.PRActionUpdate:
 							cmd = m.promptConfirmationForNotificationPR("update")
@@ -583,6 +872,18 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 							cmd = m.promptConfirmationForNotificationPR("approveWorkflows")
 							return m, cmd
 
+						case prview.PRActionRerunFailedWorkflows:
+							cmd = m.promptConfirmationForNotificationPR("rerunFailedWorkflows")
+							return m, cmd
+
+						case prview.PRActionRerunAllWorkflows:
+							cmd = m.promptConfirmationForNotificationPR("rerunAllWorkflows")
+							return m, cmd
+
+						case prview.PRActionCancelWorkflows:
+							cmd = m.promptConfirmationForNotificationPR("cancelWorkflows")
+							return m, cmd
+
 						case prview.PRActionSummaryViewMore:
 							m.prView.SetSummaryViewMore()
 							m.syncSidebar()
@@ -600,7 +901,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				var prCmd tea.Cmd
 				m.prView, prCmd = m.prView.Update(msg)
 				m.syncSidebar()
-				cmds = append(cmds, prCmd)
+				if prview.IsSelectionKey(msg) && m.prView.IsSelectionTabSelected() {
+					m.sidebar.ScrollToLine(m.prView.SelectionOffset())
+				}
+				cmds = append(cmds, prCmd, m.prView.LoadDiff())
 
 			// Issue keybindings when viewing an Issue notification
 			case m.notificationView.GetSubjectIssue() != nil:
@@ -609,10 +913,17 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.issueSidebar, issueCmd, action = m.issueSidebar.Update(msg)
 
 				if action != nil {
+					m.issueSidebar.SetBulkRows(nil)
 					switch action.Type {
 					case issueview.IssueActionLabel:
 						return m, m.openSidebarForInput(m.issueSidebar.SetIsLabeling)
 
+					case issueview.IssueActionEditProjectField:
+						return m, m.openSidebarForInput(m.issueSidebar.SetIsEditingProjectField)
+
+					case issueview.IssueActionMilestone:
+						return m, m.openSidebarForInput(m.issueSidebar.SetIsSettingMilestone)
+
 					case issueview.IssueActionAssign:
 						return m, m.openSidebarForInput(m.issueSidebar.SetIsAssigning)
 
@@ -622,6 +933,19 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					case issueview.IssueActionComment:
 						return m, m.openSidebarForInput(m.issueSidebar.SetIsCommenting)
 
+					case issueview.IssueActionEdit:
+						cmd, err := m.issueSidebar.Edit()
+						if err != nil {
+							m.ctx.Error = err
+						}
+						return m, cmd
+
+					case issueview.IssueActionReact:
+						return m, m.openSidebarForInput(m.issueSidebar.SetIsReacting)
+
+					case issueview.IssueActionTimelineAction:
+						return m, m.openSidebarForInput(m.issueSidebar.SetIsActingOnEntry)
+
 					case issueview.IssueActionCheckout:
 						cmd, err := m.issueSidebar.Checkout()
 						if err != nil {
@@ -646,6 +970,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 				// Sync sidebar and return issueCmd for navigation
 				m.syncSidebar()
+				if issueview.IsSelectionKey(msg) {
+					m.sidebar.ScrollToLine(m.issueSidebar.SelectionOffset())
+				}
 				cmds = append(cmds, issueCmd)
 
 			case key.Matches(msg, keys.NotificationKeys.MarkAsDone):
@@ -703,11 +1030,27 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cmds = append(cmds, fetchSectionsCmds, m.tabs.Init(), fetchUser,
 			m.doRefreshAtInterval(), m.doUpdateFooterAtInterval())
 
+		if msg.Config.Watch.Enabled && len(msg.Config.Watch.Rules) > 0 {
+			m.watcher = watcher.New(
+				msg.Config.Watch, watcher.ConfigSections(m.ctx.Config, m.ctx.GHRepo), data.GetNotifiedStore())
+			cmds = append(cmds, m.pollWatcher())
+		}
+
 	case intervalRefresh:
 		newSections, fetchSectionsCmds := m.fetchAllViewSections()
 		m.setCurrentViewSections(newSections)
 		cmds = append(cmds, fetchSectionsCmds, m.doRefreshAtInterval())
 
+	case watchTickMsg:
+		cmds = append(cmds, m.pollWatcher())
+
+	case watchPolledMsg:
+		if msg.err != nil {
+			log.Error("Error polling watch rules", "err", msg.err)
+		}
+		log.Debug("Watch rules polled", "events", msg.events)
+		cmds = append(cmds, m.doWatchAtInterval())
+
 	case userFetchedMsg:
 		m.ctx.User = msg.user
 
@@ -733,10 +1076,36 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			scmd := m.updateSection(msg.SectionId, msg.SectionType, msg.Msg)
 			cmds = append(cmds, scmd)
 
+			if cloned, ok := msg.Msg.(tasks.RepoClonedMsg); ok && msg.Err == nil {
+				cmds = append(cmds, cloned.Then(cloned.Path))
+			}
+
 			syncCmd := m.syncSidebar()
 			cmds = append(cmds, syncCmd)
 		}
 
+	case checkswatcher.ToggleWatchMsg:
+		cmds = append(cmds, m.toggleWatchChecks(msg.PR))
+
+	case checkswatcher.ChecksUpdatedMsg:
+		cmds = append(cmds, m.onChecksUpdated(msg), m.checksWatcher.WaitForUpdate())
+
+	case section.ConfirmMsg:
+		if currSection != nil {
+			cmds = append(cmds, currSection.Confirm(msg))
+		}
+
+	case issueview.ProjectItemsFetchedMsg:
+		if msg.Err != nil {
+			// Project items need a scope gh may not have, so they're only left out
+			log.Debug("failed fetching issue project items", "err", msg.Err)
+		} else if msg.Id >= len(m.issues) {
+			break
+		} else if section, ok := m.issues[msg.Id].(*issuessection.Model); ok {
+			section.SetProjectItems(msg.Url, msg.Items)
+			cmds = append(cmds, m.syncSidebar())
+		}
+
 	case prview.EnrichedPrMsg:
 		if msg.Err == nil {
 			m.prView.SetEnrichedPR(msg.Data)
@@ -747,6 +1116,52 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			log.Error("failed enriching pr", "err", msg.Err)
 		}
 
+	case releaseview.CommitsFetchedMsg:
+		if msg.Err != nil {
+			log.Error("failed fetching release commits", "err", msg.Err)
+		}
+		if m.releaseSidebar.SetCommits(msg) {
+			cmds = append(cmds, m.syncSidebar())
+		}
+
+	case issueview.IssueTemplatesFetchedMsg:
+		if msg.Err != nil {
+			log.Error("failed fetching issue templates", "err", msg.Err)
+		}
+		if m.issueSidebar.SetIssueTemplates(msg) {
+			cmds = append(cmds, m.syncSidebar())
+		}
+
+	case projectssection.ItemContentFetchedMsg:
+		if msg.Err != nil {
+			log.Error("failed fetching project item", "err", msg.Err)
+		}
+		if msg.SectionId < len(m.projects) {
+			board, ok := m.projects[msg.SectionId].(*projectssection.Model)
+			if ok && board.SetItemContent(msg) && m.ctx.View == config.ProjectsView &&
+				m.currSectionId == msg.SectionId {
+				cmds = append(cmds, m.onViewedRowChanged())
+			}
+		}
+
+	case prview.DiffFetchedMsg:
+		if msg.Err != nil {
+			log.Error("failed fetching pr diff", "err", msg.Err)
+		}
+		if m.prView.SetDiff(msg) && m.prView.IsDiffTabSelected() {
+			cmds = append(cmds, m.syncSidebar())
+			m.sidebar.ScrollToLine(m.prView.SelectionOffset())
+		}
+
+	case prview.CheckLogFetchedMsg:
+		if msg.Err != nil {
+			log.Error("failed fetching check log", "err", msg.Err)
+		}
+		if m.prView.SetCheckLog(msg) && m.prView.IsCheckLogOpen() {
+			cmds = append(cmds, m.syncSidebar())
+			m.sidebar.ScrollToLine(m.prView.SelectionOffset())
+		}
+
 	case notificationPRFetchedMsg:
 		if msg.Err == nil {
 			// Convert enriched PR to prrow.Data for display
@@ -878,6 +1293,15 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	m.branchSidebar, bsCmd = m.branchSidebar.Update(msg)
 	cmds = append(cmds, bsCmd)
 
+	if m.ctx.View == config.ReleasesView && m.sidebar.IsOpen {
+		cmds = append(cmds, m.releaseSidebar.LoadCommits())
+	}
+
+	if board, ok := m.getCurrSection().(*projectssection.Model); ok &&
+		m.ctx.View == config.ProjectsView && m.sidebar.IsOpen {
+		cmds = append(cmds, board.LoadCurrItemContent())
+	}
+
 	m.sidebar, sidebarCmd = m.sidebar.Update(msg)
 
 	if m.prView.IsTextInputBoxFocused() {
@@ -890,6 +1314,16 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.syncSidebar()
 	}
 
+	if m.discussionSidebar.IsTextInputBoxFocused() {
+		m.discussionSidebar, discSidebarCmd = m.discussionSidebar.Update(msg)
+		m.syncSidebar()
+	}
+
+	if m.releaseSidebar.IsTextInputBoxFocused() {
+		m.releaseSidebar, relSidebarCmd = m.releaseSidebar.Update(msg)
+		m.syncSidebar()
+	}
+
 	if currSection != nil {
 		if currSection.IsPromptConfirmationFocused() {
 			m.footer.SetLeftSection(currSection.GetPromptConfirmation())
@@ -913,6 +1347,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		sectionCmd,
 		prViewCmd,
 		issueSidebarCmd,
+		discSidebarCmd,
+		relSidebarCmd,
 	)
 
 	return m, tea.Batch(cmds...)
@@ -999,6 +1435,20 @@ func (m Model) View() tea.View {
 		layers = append(layers, lipgloss.NewLayer(issueCmp).X(previewPos.X+3).Y(y))
 	}
 
+	discussionCmp := m.discussionSidebar.ViewCompletions()
+	if discussionCmp != "" {
+		y := m.ctx.ScreenHeight - common.FooterHeight -
+			m.discussionSidebar.InputBoxLineFromBottom() - common.InputBoxHeight - 6
+		layers = append(layers, lipgloss.NewLayer(discussionCmp).X(previewPos.X+3).Y(y))
+	}
+
+	releaseCmp := m.releaseSidebar.ViewCompletions()
+	if releaseCmp != "" {
+		y := m.ctx.ScreenHeight - common.FooterHeight -
+			m.releaseSidebar.InputBoxLineFromBottom() - common.InputBoxHeight - 6
+		layers = append(layers, lipgloss.NewLayer(releaseCmp).X(previewPos.X+3).Y(y))
+	}
+
 	comp := lipgloss.NewCompositor(layers...)
 	v.SetContent(comp.Render())
 
@@ -1055,6 +1505,9 @@ func (m *Model) onViewedRowChanged() tea.Cmd {
 	m.prView.GoToFirstTab()
 	sidebarCmd := m.syncSidebar()
 	enrichCmd := m.prView.EnrichCurrRow()
+	if m.ctx.View == config.IssuesView && m.sidebar.IsOpen {
+		enrichCmd = tea.Batch(enrichCmd, m.issueSidebar.LoadProjectItems())
+	}
 	m.sidebar.ScrollToTop()
 	m.notificationView.ResetSubject()
 	keys.SetNotificationSubject(keys.NotificationSubjectNone)
@@ -1085,6 +1538,8 @@ func (m *Model) syncProgramContext() {
 	m.sidebar.UpdateProgramContext(m.ctx)
 	m.prView.UpdateProgramContext(m.ctx)
 	m.issueSidebar.UpdateProgramContext(m.ctx)
+	m.discussionSidebar.UpdateProgramContext(m.ctx)
+	m.releaseSidebar.UpdateProgramContext(m.ctx)
 	m.branchSidebar.UpdateProgramContext(m.ctx)
 	m.notificationView.UpdateProgramContext(m.ctx)
 }
@@ -1106,6 +1561,21 @@ func (m *Model) updateSection(id int, sType string, msg tea.Msg) (cmd tea.Cmd) {
 	case issuessection.SectionType:
 		updatedSection, cmd = m.issues[id].Update(msg)
 		m.issues[id] = updatedSection
+	case discussionssection.SectionType:
+		if id < len(m.discussions) {
+			updatedSection, cmd = m.discussions[id].Update(msg)
+			m.discussions[id] = updatedSection
+		}
+	case releasessection.SectionType:
+		if id < len(m.releases) {
+			updatedSection, cmd = m.releases[id].Update(msg)
+			m.releases[id] = updatedSection
+		}
+	case projectssection.SectionType:
+		if id < len(m.projects) {
+			updatedSection, cmd = m.projects[id].Update(msg)
+			m.projects[id] = updatedSection
+		}
 	}
 
 	currSection := m.getCurrSection()
@@ -1214,6 +1684,56 @@ func (m *Model) openSidebarForPRInput(setFunc func(bool) tea.Cmd) tea.Cmd {
 	return m.openSidebarForInput(setFunc)
 }
 
+// openSidebarForBulkPRInput opens a PR input that applies to the PRs selected in the current
+// section, or to the previewed PR when none are selected.
+func (m *Model) openSidebarForBulkPRInput(setFunc func(bool) tea.Cmd) tea.Cmd {
+	m.prView.SetBulkRows(m.selectedRows())
+	return m.openSidebarForPRInput(setFunc)
+}
+
+// openSidebarForBulkIssueInput opens an issue input that applies to the issues selected in the
+// current section, or to the previewed issue when none are selected.
+func (m *Model) openSidebarForBulkIssueInput(setFunc func(bool) tea.Cmd) tea.Cmd {
+	m.issueSidebar.SetBulkRows(m.selectedRows())
+	return m.openSidebarForInput(setFunc)
+}
+
+func (m *Model) selectedRows() []data.RowData {
+	currSection := m.getCurrSection()
+	if currSection == nil {
+		return nil
+	}
+	return currSection.GetSelectedRows()
+}
+
+// openSidebarForPRComment replies to the selected review thread when the Threads tab is shown
+// and comments on the PR otherwise.
+func (m *Model) openSidebarForPRComment() tea.Cmd {
+	if m.sidebar.IsOpen && m.prView.HasSelectedThread() {
+		return m.openSidebarForInput(m.prView.SetIsReplying)
+	}
+	return m.openSidebarForPRInput(m.prView.SetIsCommenting)
+}
+
+// openSidebarForPRActivityInput opens an input that applies to the selected entry of the
+// Activity tab, staying on the tab while it's shown so the entry stays selected.
+func (m *Model) openSidebarForPRActivityInput(setFunc func(bool) tea.Cmd) tea.Cmd {
+	if m.sidebar.IsOpen && m.prView.IsActivityTabSelected() {
+		return m.openSidebarForInput(setFunc)
+	}
+	return m.openSidebarForPRInput(setFunc)
+}
+
+// openSidebarForPRDiff shows the Diff tab, starting at the file selected in the Files Changed tab.
+func (m *Model) openSidebarForPRDiff() tea.Cmd {
+	m.sidebar.IsOpen = true
+	m.prView.GoToDiffTab()
+	m.syncMainContentDimensions()
+	m.syncSidebar()
+	m.sidebar.ScrollToLine(m.prView.SelectionOffset())
+	return m.prView.LoadDiff()
+}
+
 func (m *Model) openSidebarForInput(setFunc func(bool) tea.Cmd) tea.Cmd {
 	m.sidebar.IsOpen = true
 	cmd := setFunc(true)
@@ -1251,6 +1771,14 @@ func (m *Model) syncSidebar() tea.Cmd {
 	width := m.sidebar.GetSidebarContentWidth()
 	var cmd tea.Cmd
 
+	// The form of a new issue is shown even when the section has no issues
+	if m.ctx.View == config.IssuesView && m.issueSidebar.IsNewIssueFormOpen() {
+		m.issueSidebar.SetSectionId(m.currSectionId)
+		m.issueSidebar.SetWidth(width)
+		m.sidebar.SetContent(m.issueSidebar.View())
+		return nil
+	}
+
 	if currRowData == nil {
 		m.sidebar.SetContent("")
 		return nil
@@ -1266,7 +1794,7 @@ func (m *Model) syncSidebar() tea.Cmd {
 		m.prView.SetWidth(width)
 		m.sidebar.SetContent(m.prView.View())
 		// Scroll to bottom if in input mode to keep inputbox visible
-		if m.prView.IsTextInputBoxFocused() {
+		if m.prView.IsTextInputBoxFocused() || m.prView.IsMergeDialogOpen() {
 			m.sidebar.ScrollToBottom()
 		}
 	case *data.IssueData:
@@ -1278,6 +1806,30 @@ func (m *Model) syncSidebar() tea.Cmd {
 		if m.issueSidebar.IsTextInputBoxFocused() {
 			m.sidebar.ScrollToBottom()
 		}
+	case *data.DiscussionData:
+		m.discussionSidebar.SetSectionId(m.currSectionId)
+		m.discussionSidebar.SetRow(row)
+		m.discussionSidebar.SetWidth(width)
+		m.sidebar.SetContent(m.discussionSidebar.View())
+		// Scroll to bottom if in input mode to keep inputbox visible
+		if m.discussionSidebar.IsTextInputBoxFocused() {
+			m.sidebar.ScrollToBottom()
+		}
+	case *data.ReleaseData:
+		m.releaseSidebar.SetSectionId(m.currSectionId)
+		m.releaseSidebar.SetRow(row)
+		m.releaseSidebar.SetWidth(width)
+		m.sidebar.SetContent(m.releaseSidebar.View())
+		// Scroll to bottom if in input mode to keep inputbox visible
+		if m.releaseSidebar.IsTextInputBoxFocused() {
+			m.sidebar.ScrollToBottom()
+		}
+	case *data.ProjectItem:
+		var err error
+		if board, ok := m.getCurrSection().(*projectssection.Model); ok {
+			err = board.GetItemContentErr(row.Id)
+		}
+		m.sidebar.SetContent(m.renderProjectItem(row, err))
 	case *notificationrow.Data:
 		notifId := row.GetId()
 
@@ -1318,6 +1870,39 @@ func (m *Model) syncSidebar() tea.Cmd {
 	return cmd
 }
 
+// renderProjectItem renders a project item until its issue or PR is fetched, and draft issues,
+// which aren't shown by another view.
+func (m *Model) renderProjectItem(item *data.ProjectItem, err error) string {
+	width := m.sidebar.GetSidebarContentWidth()
+	faintText := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText)
+	title := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(m.ctx.Theme.PrimaryText).
+		Width(width).
+		Render(item.GetTitle())
+
+	var body string
+	switch {
+	case item.Type == data.ProjectItemDraftIssue && item.Content.DraftIssue.Body == "":
+		body = faintText.Italic(true).Render("No description provided.")
+	case item.Type == data.ProjectItemDraftIssue:
+		markdownRenderer := markdown.GetMarkdownRenderer(width, m.ctx)
+		rendered, renderErr := markdownRenderer.Render(item.Content.DraftIssue.Body)
+		if renderErr != nil {
+			rendered = item.Content.DraftIssue.Body
+		}
+		body = lipgloss.NewStyle().Width(width).MaxWidth(width).Render(rendered)
+	case err != nil:
+		body = faintText.Width(width).Render(fmt.Sprintf("Failed fetching %s#%d: %v",
+			item.GetRepoNameWithOwner(), item.GetNumber(), err))
+	default:
+		body = faintText.Render(fmt.Sprintf("Loading %s#%d...", item.GetRepoNameWithOwner(),
+			item.GetNumber()))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, title, "", body)
+}
+
 func (m *Model) renderNotificationPrompt(row *notificationrow.Data) string {
 	var content strings.Builder
 
@@ -1512,6 +2097,18 @@ func (m *Model) fetchAllViewSections() ([]section.Section, tea.Cmd) {
 		s, prcmds := prssection.FetchAllSections(m.ctx, m.prs)
 		cmds = append(cmds, prcmds)
 		return s, tea.Batch(cmds...)
+	case config.DiscussionsView:
+		s, discussioncmds := discussionssection.FetchAllSections(m.ctx)
+		cmds = append(cmds, discussioncmds)
+		return s, tea.Batch(cmds...)
+	case config.ReleasesView:
+		s, releasecmds := releasessection.FetchAllSections(m.ctx)
+		cmds = append(cmds, releasecmds)
+		return s, tea.Batch(cmds...)
+	case config.ProjectsView:
+		s, projectcmds := projectssection.FetchAllSections(m.ctx)
+		cmds = append(cmds, projectcmds)
+		return s, tea.Batch(cmds...)
 	default:
 		s, issuecmds := issuessection.FetchAllSections(m.ctx)
 		cmds = append(cmds, issuecmds)
@@ -1533,6 +2130,12 @@ func (m *Model) getCurrentViewSections() []section.Section {
 		return m.notifications
 	case config.PRsView:
 		return m.prs
+	case config.DiscussionsView:
+		return m.discussions
+	case config.ReleasesView:
+		return m.releases
+	case config.ProjectsView:
+		return m.projects
 	default:
 		return m.issues
 	}
@@ -1605,6 +2208,54 @@ func (m *Model) setCurrentViewSections(newSections []section.Section) {
 		}
 		m.prs = append(s, newSections...)
 		newSections = m.prs
+	} else if m.ctx.View == config.DiscussionsView {
+		if missingSearchSection {
+			search := discussionssection.NewModel(
+				0,
+				m.ctx,
+				config.DiscussionsSectionConfig{
+					Title:   "",
+					Filters: "",
+				},
+				time.Now(),
+				time.Now(),
+			)
+			s = append(s, &search)
+		}
+		m.discussions = append(s, newSections...)
+		newSections = m.discussions
+	} else if m.ctx.View == config.ReleasesView {
+		if missingSearchSection {
+			search := releasessection.NewModel(
+				0,
+				m.ctx,
+				config.ReleasesSectionConfig{
+					Title:   "",
+					Filters: "",
+				},
+				time.Now(),
+				time.Now(),
+			)
+			s = append(s, &search)
+		}
+		m.releases = append(s, newSections...)
+		newSections = m.releases
+	} else if m.ctx.View == config.ProjectsView {
+		if missingSearchSection {
+			search := projectssection.NewModel(
+				0,
+				m.ctx,
+				config.ProjectsSectionConfig{
+					Title:   "",
+					Filters: "",
+				},
+				time.Now(),
+				time.Now(),
+			)
+			s = append(s, &search)
+		}
+		m.projects = append(s, newSections...)
+		newSections = m.projects
 	} else {
 		if missingSearchSection {
 			search := issuessection.NewModel(
@@ -1635,27 +2286,28 @@ func (m *Model) switchSelectedView() tea.Cmd {
 		m.notificationView.ClearSubject()
 	}
 
-	// View cycle: Notifications → PRs → Issues (→ Repo if enabled) → Notifications
-	if repoFF {
-		switch m.ctx.View {
-		case config.NotificationsView:
-			m.ctx.View = config.PRsView
-		case config.PRsView:
-			m.ctx.View = config.IssuesView
-		case config.IssuesView:
-			m.ctx.View = config.RepoView
-		case config.RepoView:
-			m.ctx.View = config.NotificationsView
-		}
-	} else {
-		switch m.ctx.View {
-		case config.NotificationsView:
-			m.ctx.View = config.PRsView
-		case config.PRsView:
-			m.ctx.View = config.IssuesView
-		default:
-			m.ctx.View = config.NotificationsView
-		}
+	// View cycle: Notifications → PRs → Issues (→ Discussions if configured) (→ Releases if
+	// configured) (→ Projects if configured) (→ Repo if enabled) → Notifications
+	hasDiscussions := len(m.ctx.Config.DiscussionsSections) > 0
+	hasReleases := len(m.ctx.Config.ReleasesSections) > 0
+	hasProjects := len(m.ctx.Config.ProjectsSections) > 0
+	switch {
+	case m.ctx.View == config.NotificationsView:
+		m.ctx.View = config.PRsView
+	case m.ctx.View == config.PRsView:
+		m.ctx.View = config.IssuesView
+	case m.ctx.View == config.IssuesView && hasDiscussions:
+		m.ctx.View = config.DiscussionsView
+	case (m.ctx.View == config.IssuesView || m.ctx.View == config.DiscussionsView) &&
+		hasReleases:
+		m.ctx.View = config.ReleasesView
+	case (m.ctx.View == config.IssuesView || m.ctx.View == config.DiscussionsView ||
+		m.ctx.View == config.ReleasesView) && hasProjects:
+		m.ctx.View = config.ProjectsView
+	case m.ctx.View != config.RepoView && repoFF:
+		m.ctx.View = config.RepoView
+	default:
+		m.ctx.View = config.NotificationsView
 	}
 
 	m.syncMainContentDimensions()
@@ -1701,6 +2353,30 @@ func (m *Model) isUserDefinedKeybinding(msg tea.KeyMsg) bool {
 		}
 	}
 
+	if m.ctx.View == config.DiscussionsView {
+		for _, keybinding := range m.ctx.Config.Keybindings.Discussions {
+			if keybinding.Builtin == "" && keybinding.Key == msg.String() {
+				return true
+			}
+		}
+	}
+
+	if m.ctx.View == config.ReleasesView {
+		for _, keybinding := range m.ctx.Config.Keybindings.Releases {
+			if keybinding.Builtin == "" && keybinding.Key == msg.String() {
+				return true
+			}
+		}
+	}
+
+	if m.ctx.View == config.ProjectsView {
+		for _, keybinding := range m.ctx.Config.Keybindings.Projects {
+			if keybinding.Builtin == "" && keybinding.Key == msg.String() {
+				return true
+			}
+		}
+	}
+
 	if m.ctx.View == config.RepoView {
 		for _, keybinding := range m.ctx.Config.Keybindings.Branches {
 			if keybinding.Builtin == "" && keybinding.Key == msg.String() {
@@ -1818,6 +2494,15 @@ func fetchUser() tea.Msg {
 	}
 }
 
+// quit stops the background work of the program before quitting.
+func (m *Model) quit() tea.Cmd {
+	m.checksWatcher.Stop()
+	if err := data.GetSectionCache().Flush(); err != nil {
+		log.Error("Failed to save section cache", "err", err)
+	}
+	return tea.Quit
+}
+
 type intervalRefresh time.Time
 
 func (m *Model) doRefreshAtInterval() tea.Cmd {
@@ -1833,6 +2518,32 @@ func (m *Model) doRefreshAtInterval() tea.Cmd {
 	)
 }
 
+type (
+	watchTickMsg   struct{}
+	watchPolledMsg struct {
+		events int
+		err    error
+	}
+)
+
+// pollWatcher sends the desktop notifications for the watch rules of the configuration.
+func (m *Model) pollWatcher() tea.Cmd {
+	w := m.watcher
+	return func() tea.Msg {
+		events, err := w.Poll()
+		return watchPolledMsg{events: len(events), err: err}
+	}
+}
+
+func (m *Model) doWatchAtInterval() tea.Cmd {
+	return tea.Tick(
+		time.Minute*time.Duration(m.ctx.Config.Watch.IntervalMinutes),
+		func(t time.Time) tea.Msg {
+			return watchTickMsg{}
+		},
+	)
+}
+
 type updateFooterMsg struct{}
 
 func (m *Model) doUpdateFooterAtInterval() tea.Cmd {
@@ -1892,7 +2603,7 @@ func (m *Model) executeNotificationAction(action string) tea.Cmd {
 		}
 	case "pr_merge":
 		if pr != nil {
-			return tasks.MergePR(m.ctx, sid, pr)
+			return tasks.MergePR(m.ctx, sid, pr, tasks.DefaultMergeOptions(m.ctx, pr))
 		}
 	case "pr_update":
 		if pr != nil {
@@ -1902,6 +2613,18 @@ func (m *Model) executeNotificationAction(action string) tea.Cmd {
 		if pr != nil {
 			return tasks.ApproveWorkflows(m.ctx, sid, pr)
 		}
+	case "pr_rerunFailedWorkflows":
+		if pr != nil {
+			return tasks.RerunFailedWorkflows(m.ctx, sid, pr)
+		}
+	case "pr_rerunAllWorkflows":
+		if pr != nil {
+			return tasks.RerunAllWorkflows(m.ctx, sid, pr)
+		}
+	case "pr_cancelWorkflows":
+		if pr != nil {
+			return tasks.CancelWorkflows(m.ctx, sid, pr)
+		}
 	case "issue_close":
 		if issue != nil {
 			return tasks.CloseIssue(m.ctx, sid, issue)
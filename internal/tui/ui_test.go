@@ -20,6 +20,7 @@ import (
 	"github.com/dlvhdr/gh-dash/v4/internal/config"
 	"github.com/dlvhdr/gh-dash/v4/internal/data"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/branchsidebar"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/discussionview"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/footer"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/issueview"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/notificationrow"
@@ -28,6 +29,7 @@ import (
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prrow"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prssection"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prview"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/releaseview"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/sidebar"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tabs"
@@ -211,6 +213,78 @@ func TestNotificationView_SwitchViewWithSKey(t *testing.T) {
 		"switchSelectedView should set view to PRsView when in NotificationsView")
 }
 
+func TestSwitchSelectedView_OptionalViewsWhenConfigured(t *testing.T) {
+	cfg, err := config.ParseConfig(config.Location{
+		ConfigFlag: "../config/testdata/test-config.yml",
+	})
+	require.NoError(t, err)
+
+	ctx := &context.ProgramContext{
+		Config: &cfg,
+		View:   config.IssuesView,
+		StartTask: func(task context.Task) tea.Cmd {
+			return nil
+		},
+	}
+	ctx.Theme = theme.ParseTheme(ctx.Config)
+	ctx.Styles = context.InitStyles(ctx.Theme)
+
+	m := Model{
+		ctx:               ctx,
+		keys:              keys.Keys,
+		prView:            prview.NewModel(ctx),
+		discussionSidebar: discussionview.NewModel(ctx),
+		releaseSidebar:    releaseview.NewModel(ctx),
+		sidebar:           sidebar.NewModel(),
+		tabs:              tabs.NewModel(ctx),
+	}
+	notifSec := notificationssection.NewModel(
+		0, ctx, config.NotificationsSectionConfig{}, time.Now())
+	m.notifications = []section.Section{&notifSec}
+
+	m.switchSelectedView()
+	require.Equal(t, config.NotificationsView, m.ctx.View,
+		"issues switch to notifications when no discussions sections are configured")
+
+	cfg.DiscussionsSections = []config.DiscussionsSectionConfig{
+		{Title: "RFCs", Filters: "repo:owner/rfcs"},
+	}
+	m.ctx.View = config.IssuesView
+	m.switchSelectedView()
+	require.Equal(t, config.DiscussionsView, m.ctx.View)
+	require.Len(t, m.discussions, 2, "the search section is added to the configured section")
+
+	m.switchSelectedView()
+	require.Equal(t, config.NotificationsView, m.ctx.View)
+
+	cfg.ReleasesSections = []config.ReleasesSectionConfig{
+		{Title: "Releases", Filters: "repo:owner/rfcs"},
+	}
+	m.ctx.View = config.DiscussionsView
+	m.switchSelectedView()
+	require.Equal(t, config.ReleasesView, m.ctx.View, "releases follow discussions")
+	require.Len(t, m.releases, 2)
+
+	m.switchSelectedView()
+	require.Equal(t, config.NotificationsView, m.ctx.View)
+
+	cfg.DiscussionsSections = nil
+	m.ctx.View = config.IssuesView
+	m.switchSelectedView()
+	require.Equal(t, config.ReleasesView, m.ctx.View,
+		"releases follow issues when no discussions sections are configured")
+
+	cfg.ProjectsSections = []config.ProjectsSectionConfig{
+		{Title: "Roadmap", Filters: "project:owner/1"},
+	}
+	m.switchSelectedView()
+	require.Equal(t, config.ProjectsView, m.ctx.View, "projects follow releases")
+	require.Len(t, m.projects, 2)
+
+	m.switchSelectedView()
+	require.Equal(t, config.NotificationsView, m.ctx.View)
+}
+
 func TestNotificationView_SwitchViewWithSKey_WhileViewingPR(t *testing.T) {
 	// Test that pressing 's' when viewing a PR notification switches views
 	cfg, err := config.ParseConfig(config.Location{
@@ -325,12 +399,14 @@ func TestNotificationView_PRViewTabNavigation(t *testing.T) {
 	sidebarModel.UpdateProgramContext(ctx)
 
 	m := Model{
-		ctx:              ctx,
-		keys:             keys.Keys,
-		prView:           prview.NewModel(ctx),
-		sidebar:          sidebarModel,
-		issueSidebar:     issueview.NewModel(ctx),
-		notificationView: notificationview.NewModel(ctx),
+		ctx:               ctx,
+		keys:              keys.Keys,
+		prView:            prview.NewModel(ctx),
+		sidebar:           sidebarModel,
+		issueSidebar:      issueview.NewModel(ctx),
+		discussionSidebar: discussionview.NewModel(ctx),
+		releaseSidebar:    releaseview.NewModel(ctx),
+		notificationView:  notificationview.NewModel(ctx),
 	}
 
 	// Set up a PR notification subject so GetSubjectPR() returns non-nil
@@ -379,14 +455,16 @@ func TestNotificationView_EnterKeyWorksAfterViewingPR(t *testing.T) {
 	sidebarModel.UpdateProgramContext(ctx)
 
 	m := Model{
-		ctx:              ctx,
-		keys:             keys.Keys,
-		footer:           footer.NewModel(ctx),
-		prView:           prview.NewModel(ctx),
-		issueSidebar:     issueview.NewModel(ctx),
-		notificationView: notificationview.NewModel(ctx),
-		sidebar:          sidebarModel,
-		tabs:             tabs.NewModel(ctx),
+		ctx:               ctx,
+		keys:              keys.Keys,
+		footer:            footer.NewModel(ctx),
+		prView:            prview.NewModel(ctx),
+		issueSidebar:      issueview.NewModel(ctx),
+		discussionSidebar: discussionview.NewModel(ctx),
+		releaseSidebar:    releaseview.NewModel(ctx),
+		notificationView:  notificationview.NewModel(ctx),
+		sidebar:           sidebarModel,
+		tabs:              tabs.NewModel(ctx),
 	}
 
 	// Create a notification section with a PR notification
@@ -450,14 +528,16 @@ func TestNotificationView_EnterKeyWorksAfterViewingIssue(t *testing.T) {
 	sidebarModel.UpdateProgramContext(ctx)
 
 	m := Model{
-		ctx:              ctx,
-		keys:             keys.Keys,
-		footer:           footer.NewModel(ctx),
-		prView:           prview.NewModel(ctx),
-		issueSidebar:     issueview.NewModel(ctx),
-		notificationView: notificationview.NewModel(ctx),
-		sidebar:          sidebarModel,
-		tabs:             tabs.NewModel(ctx),
+		ctx:               ctx,
+		keys:              keys.Keys,
+		footer:            footer.NewModel(ctx),
+		prView:            prview.NewModel(ctx),
+		issueSidebar:      issueview.NewModel(ctx),
+		discussionSidebar: discussionview.NewModel(ctx),
+		releaseSidebar:    releaseview.NewModel(ctx),
+		notificationView:  notificationview.NewModel(ctx),
+		sidebar:           sidebarModel,
+		tabs:              tabs.NewModel(ctx),
 	}
 
 	// Create a notification section with an Issue notification
@@ -522,14 +602,16 @@ func TestNotificationView_BackKeyClearsPRSubjectAndRestoresNotificationActions(t
 	sidebarModel.UpdateProgramContext(ctx)
 
 	m := Model{
-		ctx:              ctx,
-		keys:             keys.Keys,
-		footer:           footer.NewModel(ctx),
-		prView:           prview.NewModel(ctx),
-		issueSidebar:     issueview.NewModel(ctx),
-		notificationView: notificationview.NewModel(ctx),
-		sidebar:          sidebarModel,
-		tabs:             tabs.NewModel(ctx),
+		ctx:               ctx,
+		keys:              keys.Keys,
+		footer:            footer.NewModel(ctx),
+		prView:            prview.NewModel(ctx),
+		issueSidebar:      issueview.NewModel(ctx),
+		discussionSidebar: discussionview.NewModel(ctx),
+		releaseSidebar:    releaseview.NewModel(ctx),
+		notificationView:  notificationview.NewModel(ctx),
+		sidebar:           sidebarModel,
+		tabs:              tabs.NewModel(ctx),
 	}
 
 	notifSec := notificationssection.NewModel(
@@ -599,14 +681,16 @@ func TestNotificationView_BackKeyClearsIssueSubject(t *testing.T) {
 	sidebarModel.UpdateProgramContext(ctx)
 
 	m := Model{
-		ctx:              ctx,
-		keys:             keys.Keys,
-		footer:           footer.NewModel(ctx),
-		prView:           prview.NewModel(ctx),
-		issueSidebar:     issueview.NewModel(ctx),
-		notificationView: notificationview.NewModel(ctx),
-		sidebar:          sidebarModel,
-		tabs:             tabs.NewModel(ctx),
+		ctx:               ctx,
+		keys:              keys.Keys,
+		footer:            footer.NewModel(ctx),
+		prView:            prview.NewModel(ctx),
+		issueSidebar:      issueview.NewModel(ctx),
+		discussionSidebar: discussionview.NewModel(ctx),
+		releaseSidebar:    releaseview.NewModel(ctx),
+		notificationView:  notificationview.NewModel(ctx),
+		sidebar:           sidebarModel,
+		tabs:              tabs.NewModel(ctx),
 	}
 
 	notifSec := notificationssection.NewModel(
@@ -667,13 +751,15 @@ func TestNavigationKeysWithNilSection(t *testing.T) {
 	sidebarModel.UpdateProgramContext(ctx)
 
 	m := Model{
-		ctx:          ctx,
-		keys:         keys.Keys,
-		footer:       footer.NewModel(ctx),
-		prView:       prview.NewModel(ctx),
-		issueSidebar: issueview.NewModel(ctx),
-		sidebar:      sidebarModel,
-		tabs:         tabs.NewModel(ctx),
+		ctx:               ctx,
+		keys:              keys.Keys,
+		footer:            footer.NewModel(ctx),
+		prView:            prview.NewModel(ctx),
+		issueSidebar:      issueview.NewModel(ctx),
+		discussionSidebar: discussionview.NewModel(ctx),
+		releaseSidebar:    releaseview.NewModel(ctx),
+		sidebar:           sidebarModel,
+		tabs:              tabs.NewModel(ctx),
 	}
 	// No sections added — currSection will be nil
 
@@ -994,16 +1080,18 @@ func TestSyncSidebar_NoOpWhenSidebarClosed(t *testing.T) {
 	}
 
 	m := Model{
-		ctx:              ctx,
-		keys:             keys.Keys,
-		prs:              []section.Section{&prSection},
-		sidebar:          sidebar.NewModel(),
-		footer:           footer.NewModel(ctx),
-		tabs:             tabs.NewModel(ctx),
-		prView:           prview.NewModel(ctx),
-		issueSidebar:     issueview.NewModel(ctx),
-		branchSidebar:    branchsidebar.NewModel(ctx),
-		notificationView: notificationview.NewModel(ctx),
+		ctx:               ctx,
+		keys:              keys.Keys,
+		prs:               []section.Section{&prSection},
+		sidebar:           sidebar.NewModel(),
+		footer:            footer.NewModel(ctx),
+		tabs:              tabs.NewModel(ctx),
+		prView:            prview.NewModel(ctx),
+		issueSidebar:      issueview.NewModel(ctx),
+		discussionSidebar: discussionview.NewModel(ctx),
+		releaseSidebar:    releaseview.NewModel(ctx),
+		branchSidebar:     branchsidebar.NewModel(ctx),
+		notificationView:  notificationview.NewModel(ctx),
 	}
 
 	// sidebar.IsOpen defaults to false from NewModel(), matching preview.open: false
@@ -1150,16 +1238,18 @@ func TestTogglePreviewPosition_NoOpWhenSidebarClosed(t *testing.T) {
 	)
 
 	m := Model{
-		ctx:              ctx,
-		keys:             keys.Keys,
-		prs:              []section.Section{&prSection},
-		sidebar:          sidebar.NewModel(),
-		footer:           footer.NewModel(ctx),
-		tabs:             tabs.NewModel(ctx),
-		prView:           prview.NewModel(ctx),
-		issueSidebar:     issueview.NewModel(ctx),
-		branchSidebar:    branchsidebar.NewModel(ctx),
-		notificationView: notificationview.NewModel(ctx),
+		ctx:               ctx,
+		keys:              keys.Keys,
+		prs:               []section.Section{&prSection},
+		sidebar:           sidebar.NewModel(),
+		footer:            footer.NewModel(ctx),
+		tabs:              tabs.NewModel(ctx),
+		prView:            prview.NewModel(ctx),
+		issueSidebar:      issueview.NewModel(ctx),
+		discussionSidebar: discussionview.NewModel(ctx),
+		releaseSidebar:    releaseview.NewModel(ctx),
+		branchSidebar:     branchsidebar.NewModel(ctx),
+		notificationView:  notificationview.NewModel(ctx),
 	}
 
 	// Sidebar is closed by default from NewModel()
@@ -1223,16 +1313,18 @@ func TestTogglePreviewPosition_TogglesWhenSidebarOpen(t *testing.T) {
 	)
 
 	m := Model{
-		ctx:              ctx,
-		keys:             keys.Keys,
-		prs:              []section.Section{&prSection},
-		sidebar:          sidebar.NewModel(),
-		footer:           footer.NewModel(ctx),
-		tabs:             tabs.NewModel(ctx),
-		prView:           prview.NewModel(ctx),
-		issueSidebar:     issueview.NewModel(ctx),
-		branchSidebar:    branchsidebar.NewModel(ctx),
-		notificationView: notificationview.NewModel(ctx),
+		ctx:               ctx,
+		keys:              keys.Keys,
+		prs:               []section.Section{&prSection},
+		sidebar:           sidebar.NewModel(),
+		footer:            footer.NewModel(ctx),
+		tabs:              tabs.NewModel(ctx),
+		prView:            prview.NewModel(ctx),
+		issueSidebar:      issueview.NewModel(ctx),
+		discussionSidebar: discussionview.NewModel(ctx),
+		releaseSidebar:    releaseview.NewModel(ctx),
+		branchSidebar:     branchsidebar.NewModel(ctx),
+		notificationView:  notificationview.NewModel(ctx),
 	}
 
 	// Open the sidebar and set initial right-mode dimensions
@@ -1307,16 +1399,18 @@ func TestView_ClosingSidebarFromBottomMode_NoExtraLine(t *testing.T) {
 	)
 
 	m := Model{
-		ctx:              ctx,
-		keys:             keys.Keys,
-		prs:              []section.Section{&prSection},
-		sidebar:          sidebar.NewModel(),
-		footer:           footer.NewModel(ctx),
-		tabs:             tabs.NewModel(ctx),
-		prView:           prview.NewModel(ctx),
-		issueSidebar:     issueview.NewModel(ctx),
-		branchSidebar:    branchsidebar.NewModel(ctx),
-		notificationView: notificationview.NewModel(ctx),
+		ctx:               ctx,
+		keys:              keys.Keys,
+		prs:               []section.Section{&prSection},
+		sidebar:           sidebar.NewModel(),
+		footer:            footer.NewModel(ctx),
+		tabs:              tabs.NewModel(ctx),
+		prView:            prview.NewModel(ctx),
+		issueSidebar:      issueview.NewModel(ctx),
+		discussionSidebar: discussionview.NewModel(ctx),
+		releaseSidebar:    releaseview.NewModel(ctx),
+		branchSidebar:     branchsidebar.NewModel(ctx),
+		notificationView:  notificationview.NewModel(ctx),
 	}
 
 	// Baseline: sidebar closed in right mode
@@ -1641,16 +1735,18 @@ func TestRefresh_ClearsEnrichmentCache(t *testing.T) {
 	)
 
 	m := Model{
-		ctx:              ctx,
-		keys:             keys.Keys,
-		prs:              []section.Section{&prSection},
-		sidebar:          sidebar.NewModel(),
-		footer:           footer.NewModel(ctx),
-		tabs:             tabs.NewModel(ctx),
-		prView:           prview.NewModel(ctx),
-		issueSidebar:     issueview.NewModel(ctx),
-		branchSidebar:    branchsidebar.NewModel(ctx),
-		notificationView: notificationview.NewModel(ctx),
+		ctx:               ctx,
+		keys:              keys.Keys,
+		prs:               []section.Section{&prSection},
+		sidebar:           sidebar.NewModel(),
+		footer:            footer.NewModel(ctx),
+		tabs:              tabs.NewModel(ctx),
+		prView:            prview.NewModel(ctx),
+		issueSidebar:      issueview.NewModel(ctx),
+		discussionSidebar: discussionview.NewModel(ctx),
+		releaseSidebar:    releaseview.NewModel(ctx),
+		branchSidebar:     branchsidebar.NewModel(ctx),
+		notificationView:  notificationview.NewModel(ctx),
 	}
 
 	// Simulate having a populated cache by ensuring it's NOT cleared
@@ -1701,16 +1797,18 @@ func TestRefreshAll_ClearsEnrichmentCache(t *testing.T) {
 	)
 
 	m := Model{
-		ctx:              ctx,
-		keys:             keys.Keys,
-		prs:              []section.Section{&prSection},
-		sidebar:          sidebar.NewModel(),
-		footer:           footer.NewModel(ctx),
-		tabs:             tabs.NewModel(ctx),
-		prView:           prview.NewModel(ctx),
-		issueSidebar:     issueview.NewModel(ctx),
-		branchSidebar:    branchsidebar.NewModel(ctx),
-		notificationView: notificationview.NewModel(ctx),
+		ctx:               ctx,
+		keys:              keys.Keys,
+		prs:               []section.Section{&prSection},
+		sidebar:           sidebar.NewModel(),
+		footer:            footer.NewModel(ctx),
+		tabs:              tabs.NewModel(ctx),
+		prView:            prview.NewModel(ctx),
+		issueSidebar:      issueview.NewModel(ctx),
+		discussionSidebar: discussionview.NewModel(ctx),
+		releaseSidebar:    releaseview.NewModel(ctx),
+		branchSidebar:     branchsidebar.NewModel(ctx),
+		notificationView:  notificationview.NewModel(ctx),
 	}
 
 	// Reset to known state
@@ -0,0 +1,342 @@
+// Package watcher polls GitHub in the background and sends desktop notifications for the
+// events matching the watch rules of the configuration, e.g. a new review request, the checks
+// of one of your PRs turning red or a new result in one of your sections.
+package watcher
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cli/go-gh/v2/pkg/repository"
+	checks "github.com/dlvhdr/x/gh-checks"
+	"github.com/gen2brain/beeep"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+const (
+	notificationsLimit = 50
+	// defaultCIFilters are the PRs a ciFailed rule without filters watches.
+	defaultCIFilters = "is:open author:@me"
+)
+
+// Event is something that happened on GitHub that a watch rule notifies about.
+type Event struct {
+	Rule string
+	// Key identifies the event in the notified store. Together with UpdatedAt it makes sure
+	// the same event is only notified once, even across restarts.
+	Key       string
+	UpdatedAt time.Time
+	Title     string
+	Body      string
+}
+
+// Section is a configured PR or issue section whose results a newResult rule diffs.
+type Section struct {
+	Title string
+	// Query is the search query of the section, with its templates and smart filtering resolved.
+	Query  string
+	Limit  int
+	Issues bool
+}
+
+// Watcher diffs the results of successive polls to find the events matching its rules. Events
+// that happened before the first poll are only recorded, so starting the watcher doesn't send
+// a notification for everything that's already waiting for you.
+type Watcher struct {
+	rules    []config.WatchRuleConfig
+	sections func() []Section
+	store    *data.DoneStore
+
+	fetchNotifications func() ([]data.NotificationData, error)
+	fetchPullRequests  func(query string) ([]data.PullRequestData, error)
+	fetchSection       func(section Section) ([]sectionResult, error)
+	notify             func(title, body string) error
+
+	mu                  sync.Mutex
+	polledNotifications bool
+	// ciStates holds the last seen checks state of each PR, by the filters of the ciFailed
+	// rule that fetched it and then by PR URL.
+	ciStates map[string]map[string]checks.CommitState
+	// sectionResults holds the URLs of the results of each section at the last poll, by the
+	// query of the section.
+	sectionResults map[string]map[string]bool
+}
+
+// New creates a watcher for the rules of cfg. sections returns the sections a newResult rule
+// diffs, and is called on every poll so their queries are resolved again.
+func New(cfg config.WatchConfig, sections func() []Section, store *data.DoneStore) *Watcher {
+	return &Watcher{
+		rules:              cfg.Rules,
+		sections:           sections,
+		store:              store,
+		fetchNotifications: fetchUnreadNotifications,
+		fetchPullRequests:  fetchPullRequests,
+		fetchSection:       fetchSection,
+		notify:             notify,
+		ciStates:           make(map[string]map[string]checks.CommitState),
+		sectionResults:     make(map[string]map[string]bool),
+	}
+}
+
+func fetchUnreadNotifications() ([]data.NotificationData, error) {
+	res, err := data.FetchNotifications(
+		notificationsLimit, nil, data.NotificationStateUnread, nil)
+	if err != nil {
+		return nil, err
+	}
+	return res.Notifications, nil
+}
+
+func fetchPullRequests(query string) ([]data.PullRequestData, error) {
+	res, err := data.FetchPullRequests(query, notificationsLimit, nil)
+	if err != nil {
+		return nil, err
+	}
+	return res.Prs, nil
+}
+
+// sectionResult is a PR or issue in the results of a section.
+type sectionResult struct {
+	Kind      string
+	Number    int
+	Title     string
+	Url       string
+	Repo      string
+	UpdatedAt time.Time
+}
+
+func fetchSection(section Section) ([]sectionResult, error) {
+	results := make([]sectionResult, 0)
+	if section.Issues {
+		res, err := data.FetchIssues(section.Query, section.Limit, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, issue := range res.Issues {
+			results = append(results, sectionResult{
+				Kind: "Issue", Number: issue.Number, Title: issue.Title, Url: issue.Url,
+				Repo: issue.Repository.NameWithOwner, UpdatedAt: issue.UpdatedAt,
+			})
+		}
+		return results, nil
+	}
+
+	res, err := data.FetchPullRequests(section.Query, section.Limit, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, pr := range res.Prs {
+		results = append(results, sectionResult{
+			Kind: "PR", Number: pr.Number, Title: pr.Title, Url: pr.Url,
+			Repo: pr.Repository.NameWithOwner, UpdatedAt: pr.UpdatedAt,
+		})
+	}
+	return results, nil
+}
+
+func notify(title, body string) error {
+	return beeep.Notify(title, body, "")
+}
+
+// Poll fetches the watched notifications and PRs, sends a desktop notification for each new
+// event and returns them. An error fetching one of the sources doesn't stop the others from
+// being watched.
+func (w *Watcher) Poll() ([]Event, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var events []Event
+	var errs []error
+	if w.hasRule(config.WatchEventReviewRequested) || w.hasRule(config.WatchEventMention) {
+		notifications, err := w.fetchNotifications()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("fetching notifications: %w", err))
+		} else {
+			baseline := !w.polledNotifications
+			w.polledNotifications = true
+			events = append(events, w.record(w.notificationEvents(notifications), baseline)...)
+		}
+	}
+
+	for _, rule := range w.rules {
+		if rule.Event != config.WatchEventCIFailed {
+			continue
+		}
+		if rule.Filters == "" {
+			rule.Filters = defaultCIFilters
+		}
+		prs, err := w.fetchPullRequests(rule.Filters)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("fetching PRs %q: %w", rule.Filters, err))
+			continue
+		}
+		events = append(events, w.record(w.ciEvents(rule, prs), false)...)
+	}
+
+	if w.hasRule(config.WatchEventNewResult) && w.sections != nil {
+		for _, section := range w.sections() {
+			results, err := w.fetchSection(section)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("fetching section %q: %w", section.Title, err))
+				continue
+			}
+			events = append(events, w.record(w.sectionEvents(section, results), false)...)
+		}
+	}
+
+	for _, event := range events {
+		if err := w.notify(event.Title, event.Body); err != nil {
+			errs = append(errs, fmt.Errorf("showing notification: %w", err))
+		}
+	}
+	return events, errors.Join(errs...)
+}
+
+func (w *Watcher) hasRule(event string) bool {
+	for _, rule := range w.rules {
+		if rule.Event == event {
+			return true
+		}
+	}
+	return false
+}
+
+// record marks events in the notified store and returns the ones that weren't notified
+// before. A baseline poll only marks them.
+func (w *Watcher) record(events []Event, baseline bool) []Event {
+	fresh := make([]Event, 0, len(events))
+	for _, event := range events {
+		if w.store.IsDone(event.Key, event.UpdatedAt) {
+			continue
+		}
+		w.store.MarkDone(event.Key, event.UpdatedAt)
+		if !baseline {
+			fresh = append(fresh, event)
+		}
+	}
+	return fresh
+}
+
+func (w *Watcher) notificationEvents(notifications []data.NotificationData) []Event {
+	events := make([]Event, 0)
+	for _, n := range notifications {
+		if !n.Unread || data.GetDoneStore().IsDone(n.Id, n.UpdatedAt) {
+			continue
+		}
+		var rule, what string
+		switch n.Reason {
+		case data.ReasonReviewRequested:
+			rule, what = config.WatchEventReviewRequested, "Review requested"
+		case data.ReasonMention, data.ReasonTeamMention:
+			rule, what = config.WatchEventMention, "You were mentioned"
+		}
+		if rule == "" || !w.hasRule(rule) {
+			continue
+		}
+		events = append(events, Event{
+			Rule:      rule,
+			Key:       fmt.Sprintf("%s:%s", rule, n.Id),
+			UpdatedAt: n.UpdatedAt,
+			Title:     fmt.Sprintf("gh-dash: %s", n.Subject.Title),
+			Body:      fmt.Sprintf("%s in %s", what, n.Repository.FullName),
+		})
+	}
+	return events
+}
+
+// ciEvents returns an event for each PR whose checks started failing since the previous poll
+// of the rule.
+func (w *Watcher) ciEvents(rule config.WatchRuleConfig, prs []data.PullRequestData) []Event {
+	previous, polled := w.ciStates[rule.Filters]
+	states := make(map[string]checks.CommitState, len(prs))
+	events := make([]Event, 0)
+	for _, pr := range prs {
+		state := ciState(pr)
+		states[pr.Url] = state
+		before, seen := previous[pr.Url]
+		if !polled || !isFailing(state) || (seen && isFailing(before)) {
+			continue
+		}
+		events = append(events, Event{
+			Rule:      config.WatchEventCIFailed,
+			Key:       fmt.Sprintf("%s:%s", config.WatchEventCIFailed, pr.Url),
+			UpdatedAt: pr.UpdatedAt,
+			Title:     fmt.Sprintf("gh-dash: %s", pr.Title),
+			Body: fmt.Sprintf("PR #%d in %s\n❌ Checks have failed",
+				pr.Number, pr.Repository.NameWithOwner),
+		})
+	}
+	w.ciStates[rule.Filters] = states
+	return events
+}
+
+// sectionEvents returns an event for each result that showed up in the section since its
+// previous poll.
+func (w *Watcher) sectionEvents(section Section, results []sectionResult) []Event {
+	previous, polled := w.sectionResults[section.Query]
+	urls := make(map[string]bool, len(results))
+	events := make([]Event, 0)
+	for _, result := range results {
+		urls[result.Url] = true
+		if !polled || previous[result.Url] {
+			continue
+		}
+		events = append(events, Event{
+			Rule:      config.WatchEventNewResult,
+			Key:       fmt.Sprintf("%s:%s:%s", config.WatchEventNewResult, section.Title, result.Url),
+			UpdatedAt: result.UpdatedAt,
+			Title:     fmt.Sprintf("gh-dash: %s", result.Title),
+			Body: fmt.Sprintf("%s #%d in %s\nNew in %s",
+				result.Kind, result.Number, result.Repo, section.Title),
+		})
+	}
+	w.sectionResults[section.Query] = urls
+	return events
+}
+
+func ciState(pr data.PullRequestData) checks.CommitState {
+	if len(pr.Commits.Nodes) == 0 {
+		return checks.CommitStateUnknown
+	}
+	return checks.CommitState(pr.Commits.Nodes[0].Commit.StatusCheckRollup.State)
+}
+
+func isFailing(state checks.CommitState) bool {
+	return state == checks.CommitStateFailure || state == checks.CommitStateError
+}
+
+// ConfigSections returns the PR and issue sections of the configuration, with their queries
+// resolved like the dashboard does in repo.
+func ConfigSections(cfg *config.Config, repo *repository.Repository) func() []Section {
+	return func() []Section {
+		sections := make([]Section, 0)
+		for _, sCfg := range cfg.PRSections {
+			limit := cfg.Defaults.PrsLimit
+			if sCfg.Limit != nil {
+				limit = *sCfg.Limit
+			}
+			sections = append(sections, Section{
+				Title: sCfg.Title,
+				Query: cfg.ResolveSectionSearchValue(sCfg.ToSectionConfig(), repo),
+				Limit: limit,
+			})
+		}
+		for _, sCfg := range cfg.IssuesSections {
+			limit := cfg.Defaults.IssuesLimit
+			if sCfg.Limit != nil {
+				limit = *sCfg.Limit
+			}
+			sections = append(sections, Section{
+				Title:  sCfg.Title,
+				Query:  cfg.ResolveSectionSearchValue(sCfg.ToSectionConfig(), repo),
+				Limit:  limit,
+				Issues: true,
+			})
+		}
+		return sections
+	}
+}
@@ -0,0 +1,228 @@
+package watcher
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	graphql "github.com/cli/shurcooL-graphql"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+type sentNotification struct {
+	title string
+	body  string
+}
+
+type fakeGitHub struct {
+	notifications []data.NotificationData
+	prs           []data.PullRequestData
+	sections      map[string][]sectionResult
+	err           error
+	queries       []string
+	sent          []sentNotification
+}
+
+func newTestWatcher(t *testing.T, gh *fakeGitHub, rules ...config.WatchRuleConfig) *Watcher {
+	t.Helper()
+	// Stores without a file path are kept in memory only
+	t.Cleanup(data.OverrideDoneStoreForTesting(data.NewDoneStoreForTesting("")))
+
+	sections := func() []Section {
+		return []Section{
+			{Title: "My PRs", Query: "is:pr author:@me"},
+			{Title: "Bugs", Query: "is:issue label:bug", Issues: true},
+		}
+	}
+	w := New(config.WatchConfig{Rules: rules}, sections, data.NewDoneStoreForTesting(""))
+	w.fetchNotifications = func() ([]data.NotificationData, error) {
+		return gh.notifications, gh.err
+	}
+	w.fetchPullRequests = func(query string) ([]data.PullRequestData, error) {
+		gh.queries = append(gh.queries, query)
+		return gh.prs, nil
+	}
+	w.fetchSection = func(section Section) ([]sectionResult, error) {
+		gh.queries = append(gh.queries, section.Query)
+		return gh.sections[section.Title], nil
+	}
+	w.notify = func(title, body string) error {
+		gh.sent = append(gh.sent, sentNotification{title: title, body: body})
+		return nil
+	}
+	return w
+}
+
+func newNotification(id, reason string, updatedAt time.Time) data.NotificationData {
+	n := data.NotificationData{Id: id, Reason: reason, Unread: true, UpdatedAt: updatedAt}
+	n.Subject.Title = "Fix the thing"
+	n.Repository.FullName = "dlvhdr/gh-dash"
+	return n
+}
+
+func newPR(url string, state string) data.PullRequestData {
+	pr := data.PullRequestData{Number: 42, Title: "Add watcher", Url: url}
+	pr.Repository.NameWithOwner = "dlvhdr/gh-dash"
+	pr.Commits.Nodes = make([]struct {
+		Commit struct {
+			StatusCheckRollup struct {
+				State graphql.String
+			}
+		}
+	}, 1)
+	pr.Commits.Nodes[0].Commit.StatusCheckRollup.State = graphql.String(state)
+	return pr
+}
+
+func TestPoll_NotificationsOnlyNotifyAfterTheFirstPoll(t *testing.T) {
+	now := time.Now()
+	gh := &fakeGitHub{notifications: []data.NotificationData{
+		newNotification("1", data.ReasonReviewRequested, now),
+	}}
+	w := newTestWatcher(t, gh,
+		config.WatchRuleConfig{Event: config.WatchEventReviewRequested},
+		config.WatchRuleConfig{Event: config.WatchEventMention},
+	)
+
+	events, err := w.Poll()
+	require.NoError(t, err)
+	require.Empty(t, events, "the first poll only records what is already there")
+
+	gh.notifications = append(gh.notifications,
+		newNotification("2", data.ReasonMention, now),
+		newNotification("3", data.ReasonSubscribed, now),
+	)
+	events, err = w.Poll()
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, config.WatchEventMention, events[0].Rule)
+	require.Equal(t, []sentNotification{{
+		title: "gh-dash: Fix the thing",
+		body:  "You were mentioned in dlvhdr/gh-dash",
+	}}, gh.sent)
+
+	events, err = w.Poll()
+	require.NoError(t, err)
+	require.Empty(t, events, "an event is only notified once")
+
+	gh.notifications[0].UpdatedAt = now.Add(time.Minute)
+	events, err = w.Poll()
+	require.NoError(t, err)
+	require.Len(t, events, 1, "new activity on a notification notifies again")
+	require.Equal(t, config.WatchEventReviewRequested, events[0].Rule)
+}
+
+func TestPoll_SkipsNotificationsWithoutRule(t *testing.T) {
+	gh := &fakeGitHub{}
+	w := newTestWatcher(t, gh, config.WatchRuleConfig{Event: config.WatchEventMention})
+
+	_, err := w.Poll()
+	require.NoError(t, err)
+
+	gh.notifications = []data.NotificationData{
+		newNotification("1", data.ReasonReviewRequested, time.Now()),
+	}
+	events, err := w.Poll()
+	require.NoError(t, err)
+	require.Empty(t, events)
+}
+
+func TestPoll_SkipsDoneNotifications(t *testing.T) {
+	gh := &fakeGitHub{}
+	w := newTestWatcher(t, gh, config.WatchRuleConfig{Event: config.WatchEventMention})
+
+	_, err := w.Poll()
+	require.NoError(t, err)
+
+	n := newNotification("1", data.ReasonMention, time.Now())
+	data.GetDoneStore().MarkDone(n.Id, n.UpdatedAt)
+	gh.notifications = []data.NotificationData{n}
+	events, err := w.Poll()
+	require.NoError(t, err)
+	require.Empty(t, events)
+}
+
+func TestPoll_CIFailed(t *testing.T) {
+	gh := &fakeGitHub{prs: []data.PullRequestData{
+		newPR("https://github.com/dlvhdr/gh-dash/pull/1", "PENDING"),
+		newPR("https://github.com/dlvhdr/gh-dash/pull/2", "FAILURE"),
+	}}
+	w := newTestWatcher(t, gh, config.WatchRuleConfig{
+		Event:   config.WatchEventCIFailed,
+		Filters: "is:open author:@me",
+	})
+
+	events, err := w.Poll()
+	require.NoError(t, err)
+	require.Empty(t, events, "checks that already failed before watching aren't notified")
+
+	gh.prs[0] = newPR("https://github.com/dlvhdr/gh-dash/pull/1", "FAILURE")
+	gh.prs = append(gh.prs, newPR("https://github.com/dlvhdr/gh-dash/pull/3", "ERROR"))
+	events, err = w.Poll()
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, "ciFailed:https://github.com/dlvhdr/gh-dash/pull/1", events[0].Key)
+	require.Equal(t, "ciFailed:https://github.com/dlvhdr/gh-dash/pull/3", events[1].Key)
+	require.Equal(t, "PR #42 in dlvhdr/gh-dash\n❌ Checks have failed", gh.sent[0].body)
+
+	events, err = w.Poll()
+	require.NoError(t, err)
+	require.Empty(t, events, "checks that keep failing are only notified once")
+}
+
+func TestPoll_KeepsWatchingWhenASourceFails(t *testing.T) {
+	failure := errors.New("rate limited")
+	gh := &fakeGitHub{
+		err: failure,
+		prs: []data.PullRequestData{newPR("https://github.com/dlvhdr/gh-dash/pull/1", "SUCCESS")},
+	}
+	w := newTestWatcher(t, gh,
+		config.WatchRuleConfig{Event: config.WatchEventMention},
+		config.WatchRuleConfig{Event: config.WatchEventCIFailed},
+	)
+
+	_, err := w.Poll()
+	require.ErrorIs(t, err, failure)
+	require.Equal(t, []string{"is:open author:@me"}, gh.queries,
+		"a ciFailed rule without filters watches your open PRs")
+
+	gh.prs[0] = newPR("https://github.com/dlvhdr/gh-dash/pull/1", "FAILURE")
+	events, err := w.Poll()
+	require.ErrorIs(t, err, failure)
+	require.Len(t, events, 1)
+}
+
+func TestPoll_NewResult(t *testing.T) {
+	pr := sectionResult{
+		Kind: "PR", Number: 1, Title: "Add watcher", Repo: "dlvhdr/gh-dash",
+		Url: "https://github.com/dlvhdr/gh-dash/pull/1",
+	}
+	gh := &fakeGitHub{sections: map[string][]sectionResult{"My PRs": {pr}}}
+	w := newTestWatcher(t, gh, config.WatchRuleConfig{Event: config.WatchEventNewResult})
+
+	events, err := w.Poll()
+	require.NoError(t, err)
+	require.Empty(t, events, "results that were there before watching aren't notified")
+	require.Equal(t, []string{"is:pr author:@me", "is:issue label:bug"}, gh.queries)
+
+	issue := sectionResult{
+		Kind: "Issue", Number: 7, Title: "Crash", Repo: "dlvhdr/gh-dash",
+		Url: "https://github.com/dlvhdr/gh-dash/issues/7",
+	}
+	gh.sections["Bugs"] = []sectionResult{issue}
+	events, err = w.Poll()
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, "newResult:Bugs:https://github.com/dlvhdr/gh-dash/issues/7", events[0].Key)
+	require.Equal(t, []sentNotification{{
+		title: "gh-dash: Crash",
+		body:  "Issue #7 in dlvhdr/gh-dash\nNew in Bugs",
+	}}, gh.sent)
+
+	events, err = w.Poll()
+	require.NoError(t, err)
+	require.Empty(t, events, "results that stay in a section are only notified once")
+}